@@ -0,0 +1,73 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/docker/buildx/util/buildflags"
+	"github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/exporter/containerimage/exptypes"
+)
+
+// parseAnnotations parses top-level annotations into their buildkit
+// representation. Keys may be prefixed with a comma-separated list of levels,
+// each optionally qualified with a platform, like Docker's "--annotation"
+// flag: "index,manifest[linux/amd64]:org.opencontainers.image.title".
+func parseAnnotations(annotations map[string]string) (map[exptypes.AnnotationKey]string, error) {
+	if len(annotations) == 0 {
+		return nil, nil
+	}
+
+	var multierr error
+	parsed := map[exptypes.AnnotationKey]string{}
+
+	for _, k := range slices.Sorted(maps.Keys(annotations)) {
+		a, err := buildflags.ParseAnnotations([]string{k + "=" + annotations[k]})
+		if err != nil {
+			multierr = errors.Join(multierr, newCheckFailure(err, "annotations[%q]", k))
+			continue
+		}
+		maps.Copy(parsed, a)
+	}
+
+	return parsed, multierr
+}
+
+// applyAnnotations adds annotations to every export capable of carrying them.
+// Annotations configured directly on an export take precedence.
+func applyAnnotations(
+	exports []*buildflags.ExportEntry,
+	annotations map[exptypes.AnnotationKey]string,
+) {
+	for _, e := range exports {
+		switch e.Type {
+		case client.ExporterImage, client.ExporterOCI, client.ExporterDocker:
+		default:
+			continue
+		}
+		if e.Attrs == nil {
+			e.Attrs = map[string]string{}
+		}
+		for k, v := range annotations {
+			if _, ok := e.Attrs[k.String()]; ok {
+				continue
+			}
+			e.Attrs[k.String()] = v
+		}
+	}
+}
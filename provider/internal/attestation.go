@@ -0,0 +1,252 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/distribution/reference"
+	"github.com/docker/buildx/util/buildflags"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+const (
+	// attestTypeSBOM is the buildkit attestation type for SBOMs.
+	attestTypeSBOM = "sbom"
+	// attestTypeProvenance is the buildkit attestation type for SLSA
+	// provenance.
+	attestTypeProvenance = "provenance"
+
+	// Build arguments understood by the Dockerfile frontend which control
+	// what the SBOM generator scans.
+	sbomScanContextArg = "BUILDKIT_SBOM_SCAN_CONTEXT"
+	sbomScanStageArg   = "BUILDKIT_SBOM_SCAN_STAGE"
+)
+
+var (
+	_ fmt.Stringer               = (*SBOMAttestation)(nil)
+	_ fmt.Stringer               = (*ProvenanceAttestation)(nil)
+	_ infer.Annotated            = (*Attestations)(nil)
+	_ infer.Annotated            = (*SBOMAttestation)(nil)
+	_ infer.Annotated            = (*ProvenanceAttestation)(nil)
+	_ infer.Enum[ProvenanceMode] = (*ProvenanceMode)(nil)
+)
+
+// Attestations configures the attestation manifests attached to an image.
+type Attestations struct {
+	SBOM       *SBOMAttestation       `pulumi:"sbom,optional"`
+	Provenance *ProvenanceAttestation `pulumi:"provenance,optional"`
+}
+
+// Annotate sets docstrings on Attestations.
+func (at *Attestations) Annotate(a infer.Annotator) {
+	a.Describe(&at.SBOM, dedent(`
+		Generate a Software Bill of Materials (SBOM) for the image.
+
+		Equivalent to Docker's "--attest type=sbom" flag.
+	`))
+	a.Describe(&at.Provenance, dedent(`
+		Generate SLSA provenance for the build.
+
+		Provenance is disabled unless configured here.
+
+		Equivalent to Docker's "--attest type=provenance" flag.
+	`))
+}
+
+// validate returns buildflags appropriate for passing to builders, and
+// check failures for any invalid configuration.
+func (at *Attestations) validate() (buildflags.Attests, error) {
+	if at == nil {
+		return nil, nil
+	}
+
+	var multierr error
+	attests := buildflags.Attests{}
+
+	if at.SBOM != nil {
+		if at.SBOM.Generator != "" {
+			if _, err := reference.ParseNormalizedNamed(at.SBOM.Generator); err != nil {
+				multierr = errors.Join(multierr,
+					newCheckFailure(err, "attestations.sbom.generator"),
+				)
+			}
+		}
+		attests = append(attests, at.SBOM.attest())
+	}
+
+	if at.Provenance != nil {
+		attests = append(attests, at.Provenance.attest())
+	}
+
+	return attests.Normalize(), multierr
+}
+
+// buildArgs returns build arguments needed by the SBOM generator, if any.
+func (at *Attestations) buildArgs() map[string]string {
+	if at == nil || at.SBOM == nil || at.SBOM.Disabled {
+		return nil
+	}
+	args := map[string]string{}
+	if at.SBOM.ScanContext != nil {
+		args[sbomScanContextArg] = fmt.Sprint(*at.SBOM.ScanContext)
+	}
+	if at.SBOM.ScanStage != nil {
+		args[sbomScanStageArg] = fmt.Sprint(*at.SBOM.ScanStage)
+	}
+	return args
+}
+
+// SBOMAttestation configures an SBOM attestation.
+type SBOMAttestation struct {
+	Generator   string `pulumi:"generator,optional"`
+	ScanContext *bool  `pulumi:"scanContext,optional"`
+	ScanStage   *bool  `pulumi:"scanStage,optional"`
+
+	Disabled bool `pulumi:"disabled,optional"`
+}
+
+// Annotate sets docstrings on SBOMAttestation.
+func (s *SBOMAttestation) Annotate(a infer.Annotator) {
+	a.Describe(&s.Generator, dedent(`
+		The scanner image used to generate the SBOM.
+
+		Defaults to BuildKit's "docker/buildkit-syft-scanner".
+	`))
+	a.Describe(&s.ScanContext, dedent(`
+		Also scan the build context, in addition to the final image.
+
+		Equivalent to setting the "BUILDKIT_SBOM_SCAN_CONTEXT" build argument.
+	`))
+	a.Describe(&s.ScanStage, dedent(`
+		Also scan intermediate build stages, in addition to the final image.
+
+		Equivalent to setting the "BUILDKIT_SBOM_SCAN_STAGE" build argument.
+	`))
+	a.Describe(&s.Disabled, dedent(`
+		When "true" no SBOM will be generated. Defaults to "false".
+	`))
+}
+
+// String returns the CLI-encoded value of this attestation, or an empty string
+// if the receiver is nil.
+func (s *SBOMAttestation) String() string {
+	if s == nil {
+		return ""
+	}
+	return s.attest().String()
+}
+
+func (s *SBOMAttestation) attest() *buildflags.Attest {
+	attest := &buildflags.Attest{
+		Type:     attestTypeSBOM,
+		Disabled: s.Disabled,
+		Attrs:    map[string]string{},
+	}
+	if s.Disabled {
+		return attest
+	}
+	if s.Generator != "" {
+		attest.Attrs["generator"] = s.Generator
+	}
+	return attest
+}
+
+// ProvenanceAttestation configures a SLSA provenance attestation.
+type ProvenanceAttestation struct {
+	Mode       *ProvenanceMode `pulumi:"mode,optional"`
+	BuilderID  string          `pulumi:"builderId,optional"`
+	InlineOnly *bool           `pulumi:"inlineOnly,optional"`
+
+	Disabled bool `pulumi:"disabled,optional"`
+}
+
+// Annotate sets docstrings and defaults on ProvenanceAttestation.
+func (p *ProvenanceAttestation) Annotate(a infer.Annotator) {
+	a.SetDefault(&p.Mode, ProvenanceMin)
+
+	a.Describe(&p.Mode, dedent(`
+		The level of detail to include in the provenance. Defaults to "min".
+	`))
+	a.Describe(&p.BuilderID, dedent(`
+		An explicit builder ID to record in the provenance, for example the
+		URL of the CI job performing the build.
+	`))
+	a.Describe(&p.InlineOnly, dedent(`
+		Only attach provenance to exporters which embed it in the image
+		(e.g. "image" and "registry"). Defaults to "false".
+	`))
+	a.Describe(&p.Disabled, dedent(`
+		When "true" no provenance will be generated. Defaults to "false".
+	`))
+}
+
+// String returns the CLI-encoded value of this attestation, or an empty string
+// if the receiver is nil.
+func (p *ProvenanceAttestation) String() string {
+	if p == nil {
+		return ""
+	}
+	return p.attest().String()
+}
+
+func (p *ProvenanceAttestation) attest() *buildflags.Attest {
+	attest := &buildflags.Attest{
+		Type:     attestTypeProvenance,
+		Disabled: p.Disabled,
+		Attrs:    map[string]string{},
+	}
+	if p.Disabled {
+		return attest
+	}
+	if p.Mode != nil {
+		attest.Attrs["mode"] = string(*p.Mode)
+	}
+	if p.BuilderID != "" {
+		attest.Attrs["builder-id"] = p.BuilderID
+	}
+	if p.InlineOnly != nil {
+		attest.Attrs["inline-only"] = fmt.Sprint(*p.InlineOnly)
+	}
+	return attest
+}
+
+// ProvenanceMode controls how much detail is included in provenance
+// attestations.
+type ProvenanceMode string
+
+const (
+	// ProvenanceMin provenance mode.
+	ProvenanceMin ProvenanceMode = "min"
+	// ProvenanceMax provenance mode.
+	ProvenanceMax ProvenanceMode = "max"
+)
+
+// Values returns all valid ProvenanceMode values for SDK generation.
+func (ProvenanceMode) Values() []infer.EnumValue[ProvenanceMode] {
+	return []infer.EnumValue[ProvenanceMode]{
+		{
+			Value:       ProvenanceMin,
+			Description: "Only include a minimal description of the build.",
+		},
+		{
+			Value: ProvenanceMax,
+			Description: "Include detailed information about the build, " +
+				"including build arguments and source mappings.",
+		},
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/docker/buildx/util/buildflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func TestAttestationString(t *testing.T) {
+	t.Parallel()
+	maxMode := ProvenanceMax
+
+	tests := []struct {
+		name  string
+		given fmt.Stringer
+		want  string
+	}{
+		{
+			name:  "sbom",
+			given: &SBOMAttestation{},
+			want:  "type=sbom",
+		},
+		{
+			name:  "sbom-with-generator",
+			given: &SBOMAttestation{Generator: "docker/buildkit-syft-scanner:edge"},
+			want:  "type=sbom,generator=docker/buildkit-syft-scanner:edge",
+		},
+		{
+			name:  "sbom-disabled",
+			given: &SBOMAttestation{Generator: "ignored", Disabled: true},
+			want:  "type=sbom,disabled=true",
+		},
+		{
+			name: "provenance",
+			given: &ProvenanceAttestation{
+				Mode:       &maxMode,
+				BuilderID:  "https://github.com/pulumi/pulumi-docker-build/actions/runs/1",
+				InlineOnly: pulumi.BoolRef(true),
+			},
+			want: "type=provenance,builder-id=https://github.com/pulumi/pulumi-docker-build/actions/runs/1," +
+				"inline-only=true,mode=max",
+		},
+		{
+			name:  "provenance-disabled",
+			given: &ProvenanceAttestation{Mode: &maxMode, Disabled: true},
+			want:  "type=provenance,disabled=true",
+		},
+		{
+			name:  "nil",
+			given: (*ProvenanceAttestation)(nil),
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := tt.given.String()
+			assert.Equal(t, tt.want, actual)
+
+			if tt.want != "" {
+				// Our output should be parsable by Docker.
+				_, err := buildflags.ParseAttests([]string{actual})
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAttestations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid generator", func(t *testing.T) {
+		t.Parallel()
+		a := &Attestations{SBOM: &SBOMAttestation{Generator: "not a valid:image:ref"}}
+		_, err := a.validate()
+		assert.ErrorContains(t, err, "invalid reference format")
+	})
+
+	t.Run("attests", func(t *testing.T) {
+		t.Parallel()
+		a := &Attestations{
+			SBOM:       &SBOMAttestation{ScanContext: pulumi.BoolRef(true)},
+			Provenance: &ProvenanceAttestation{Disabled: true},
+		}
+		attests, err := a.validate()
+		require.NoError(t, err)
+
+		want := map[string]*string{
+			attestTypeSBOM:       pulumi.StringRef("type=sbom"),
+			attestTypeProvenance: nil,
+		}
+		assert.Equal(t, want, attests.ToMap())
+		assert.Equal(t, map[string]string{sbomScanContextArg: trueLiteral}, a.buildArgs())
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		var a *Attestations
+		attests, err := a.validate()
+		assert.NoError(t, err)
+		assert.Empty(t, attests)
+		assert.Empty(t, a.buildArgs())
+	})
+}
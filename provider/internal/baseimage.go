@@ -0,0 +1,265 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/distribution/reference"
+	"github.com/moby/buildkit/frontend/dockerfile/instructions"
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+	"github.com/moby/buildkit/frontend/dockerfile/shell"
+	"github.com/opencontainers/go-digest"
+
+	provider "github.com/pulumi/pulumi-go-provider"
+)
+
+// baseImage is an external image a Dockerfile stage is built on, or copies
+// files from.
+type baseImage struct {
+	ref  reference.Named // Nil if the image isn't a valid reference.
+	name string          // The image as written in the Dockerfile.
+	line int
+	copy bool // True for "COPY --from" images.
+}
+
+// argEnv exposes build arguments to the shell lexer.
+type argEnv map[string]string
+
+func (e argEnv) Get(key string) (string, bool) {
+	v, ok := e[key]
+	return v, ok
+}
+
+func (e argEnv) Keys() []string {
+	return slices.Collect(maps.Keys(e))
+}
+
+// globalArgs returns the values of the Dockerfile's global ARGs, taken from
+// "buildArgs" or their defaults. ARGs without either are omitted.
+func (ia *ImageArgs) globalArgs(lex *shell.Lex, metaArgs []instructions.ArgCommand) (argEnv, error) {
+	env := argEnv{}
+	for _, a := range metaArgs {
+		for _, kv := range a.Args {
+			if v, ok := ia.BuildArgs[kv.Key]; ok {
+				env[kv.Key] = v
+				continue
+			}
+			if kv.Value == nil {
+				continue
+			}
+			v, _, err := lex.ProcessWord(*kv.Value, env)
+			if err != nil {
+				return nil, err
+			}
+			env[kv.Key] = v
+		}
+	}
+	return env, nil
+}
+
+// baseImages returns the normalized external images the Dockerfile's stages
+// are built on or copy from, after substituting global ARGs. References to
+// other stages, "scratch", and named contexts are skipped. Images which don't
+// resolve to a valid reference, for example because of an unset ARG, are
+// returned with a nil ref.
+func (ia *ImageArgs) baseImages() ([]baseImage, error) {
+	stages, metaArgs, err := ia.Dockerfile.parse()
+	if err != nil || len(stages) == 0 {
+		// Parse errors are already reported by validate.
+		return nil, nil
+	}
+
+	lex := shell.NewLex('\\')
+	env, err := ia.globalArgs(lex, metaArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	referenced := map[string]bool{}
+	if ia.Context != nil {
+		for name := range ia.Context.Named {
+			referenced[name] = true
+		}
+	}
+
+	images := []baseImage{}
+	add := func(word string, loc []parser.Range, copy bool) {
+		line := 0
+		if len(loc) > 0 {
+			line = loc[0].Start.Line
+		}
+		name, _, err := lex.ProcessWord(word, env)
+		if err != nil {
+			images = append(images, baseImage{name: word, line: line, copy: copy})
+			return
+		}
+		if strings.EqualFold(name, "scratch") {
+			return
+		}
+		if _, ok := stageIndex(stages, name); ok {
+			return
+		}
+		if _, err := strconv.Atoi(name); err == nil {
+			return
+		}
+		if isReferenced(referenced, name) {
+			return
+		}
+		ref, _ := normalizeReference(name)
+		images = append(images, baseImage{ref: ref, name: word, line: line, copy: copy})
+	}
+
+	for _, s := range stages {
+		add(s.BaseName, s.Location, false)
+		for _, cmd := range s.Commands {
+			if c, ok := cmd.(*instructions.CopyCommand); ok && c.From != "" {
+				add(c.From, c.Location(), true)
+			}
+		}
+	}
+	return images, nil
+}
+
+// digestResolver looks up the digest an image reference currently points to.
+type digestResolver func(ctx context.Context, ref string) (string, error)
+
+// baseImageDigests resolves each external "FROM" image to the digest it
+// currently points to, keyed by its normalized reference. Pinned images
+// resolve to their own digest. Images which can't be resolved, or all
+// unpinned images if resolve is nil, are omitted.
+func (ia *ImageArgs) baseImageDigests(ctx context.Context, resolve digestResolver) map[string]string {
+	images, err := ia.baseImages()
+	if err != nil {
+		provider.GetLogger(ctx).Warning("unable to determine base images: " + err.Error())
+		return nil
+	}
+
+	digests := map[string]string{}
+	for _, img := range images {
+		if img.ref == nil {
+			continue
+		}
+		key := img.ref.String()
+		if _, ok := digests[key]; ok || img.copy {
+			continue
+		}
+		if canonical, ok := img.ref.(reference.Canonical); ok {
+			digests[key] = canonical.Digest().String()
+			continue
+		}
+		if resolve == nil {
+			continue
+		}
+		dgst, err := resolve(ctx, key)
+		if err != nil {
+			provider.GetLogger(ctx).Warning(fmt.Sprintf("unable to resolve base image %q: %s", key, err))
+			continue
+		}
+		digests[key] = dgst
+	}
+	if len(digests) == 0 {
+		return nil
+	}
+	return digests
+}
+
+// checkBaseImages enforces the provider's and the image's base image
+// policies. Failures point at the offending instruction.
+//
+// When pinned images are required, resolve (if non-nil) is used to suggest a
+// digest for each unpinned image.
+func (ia *ImageArgs) checkBaseImages(ctx context.Context, cfg Config, resolve digestResolver) error {
+	requirePinned := ia.RequirePinnedBaseImages
+	if requirePinned == nil {
+		requirePinned = cfg.RequirePinnedBaseImages
+	}
+	pinned := requirePinned != nil && *requirePinned
+	if !pinned && len(cfg.AllowedBaseImages) == 0 && len(ia.AllowedBaseImages) == 0 {
+		return nil
+	}
+
+	images, err := ia.baseImages()
+	if err != nil {
+		return errors.Join(newCheckFailure(err, "dockerfile"))
+	}
+
+	failures := []error{}
+	for _, img := range images {
+		if img.ref == nil {
+			// We can't tell what this is, so it can't satisfy either policy.
+			// "COPY --from" images are only subject to pinning.
+			if !img.copy || pinned {
+				failures = append(failures, newCheckFailure(
+					fmt.Errorf("image %q on line %d doesn't resolve to a valid reference", img.name, img.line),
+					"dockerfile",
+				))
+			}
+			continue
+		}
+		for _, allowed := range [][]string{cfg.AllowedBaseImages, ia.AllowedBaseImages} {
+			if img.copy || len(allowed) == 0 || allowedBaseImage(allowed, img.ref) {
+				continue
+			}
+			failures = append(failures, newCheckFailure(
+				fmt.Errorf("base image %q on line %d isn't allowed by allowedBaseImages %q", img.ref, img.line, allowed),
+				"dockerfile",
+			))
+			break
+		}
+
+		if !pinned || isPinned(img.ref) {
+			continue
+		}
+		hint := `append "@sha256:<digest>"`
+		if resolve != nil {
+			if dgst, err := resolve(ctx, img.ref.String()); err == nil && dgst != "" {
+				hint = fmt.Sprintf("use %q", img.ref.String()+"@"+dgst)
+			}
+		}
+		failures = append(failures, newCheckFailure(
+			fmt.Errorf("image %q on line %d isn't pinned to a digest; %s", img.ref, img.line, hint),
+			"dockerfile",
+		))
+	}
+	return errors.Join(failures...)
+}
+
+// isPinned returns true if the reference includes a sha256 digest.
+func isPinned(ref reference.Named) bool {
+	canonical, ok := ref.(reference.Canonical)
+	return ok && canonical.Digest().Algorithm() == digest.SHA256
+}
+
+// allowedBaseImage returns true if the reference, or its repository, matches
+// any of the given glob patterns.
+func allowedBaseImage(patterns []string, ref reference.Named) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, ref.String()); ok {
+			return true
+		}
+		if ok, _ := path.Match(p, ref.Name()); ok {
+			return true
+		}
+	}
+	return false
+}
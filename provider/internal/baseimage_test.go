@@ -0,0 +1,239 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckBaseImages(t *testing.T) {
+	t.Parallel()
+
+	dockerfile := `
+ARG REGISTRY=ghcr.io/pulumi
+ARG BASE=${REGISTRY}/base:1.0
+FROM $BASE AS base
+FROM golang:1.26 AS build
+FROM base
+COPY --from=build / /
+FROM scratch
+FROM tools
+`
+
+	tests := []struct {
+		name         string
+		config       Config
+		args         ImageArgs
+		wantFailures []string
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name: "allowed",
+			args: ImageArgs{
+				AllowedBaseImages: []string{"ghcr.io/pulumi/*", "docker.io/library/golang"},
+				Context: &BuildContext{Named: NamedContexts{
+					"tools": {Location: "docker-image://evil.example.com/tools"},
+				}},
+			},
+		},
+		{
+			name: "denied",
+			args: ImageArgs{
+				AllowedBaseImages: []string{"docker.io/library/golang:*"},
+				BuildArgs:         map[string]string{"REGISTRY": "evil.example.com"},
+			},
+			wantFailures: []string{
+				`dockerfile: base image "evil.example.com/base:1.0" on line 4 isn't allowed by allowedBaseImages ["docker.io/library/golang:*"]`,
+				`dockerfile: base image "docker.io/library/tools:latest" on line 9 isn't allowed by allowedBaseImages ["docker.io/library/golang:*"]`,
+			},
+		},
+		{
+			name:   "provider policy",
+			config: Config{AllowedBaseImages: []string{"ghcr.io/pulumi/*"}},
+			args: ImageArgs{
+				AllowedBaseImages: []string{"*/*/*"},
+				BuildArgs:         map[string]string{"BASE": "ghcr.io/pulumi/base@sha256:0000000000000000000000000000000000000000000000000000000000000000"},
+			},
+			wantFailures: []string{
+				`dockerfile: base image "docker.io/library/golang:1.26" on line 5 isn't allowed by allowedBaseImages ["ghcr.io/pulumi/*"]`,
+				`dockerfile: base image "docker.io/library/tools:latest" on line 9 isn't allowed by allowedBaseImages ["ghcr.io/pulumi/*"]`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.args.Dockerfile = &Dockerfile{Inline: dockerfile}
+
+			err := tt.args.checkBaseImages(t.Context(), tt.config, nil)
+
+			var failures []string
+			if err != nil {
+				for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+					cf := e.(checkFailure)
+					failures = append(failures, cf.Property+": "+cf.Reason)
+				}
+			}
+			assert.Equal(t, tt.wantFailures, failures)
+		})
+	}
+}
+
+func TestCheckUnresolvedBaseImages(t *testing.T) {
+	t.Parallel()
+	enabled := true
+
+	dockerfile := `
+ARG REGISTRY
+FROM ${REGISTRY}/app
+COPY --from=${REGISTRY}/tools /bin /bin
+`
+
+	tests := []struct {
+		name         string
+		args         ImageArgs
+		wantFailures []string
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name: "allowed",
+			args: ImageArgs{AllowedBaseImages: []string{"*"}},
+			wantFailures: []string{
+				`dockerfile: image "${REGISTRY}/app" on line 3 doesn't resolve to a valid reference`,
+			},
+		},
+		{
+			name: "pinned",
+			args: ImageArgs{RequirePinnedBaseImages: &enabled},
+			wantFailures: []string{
+				`dockerfile: image "${REGISTRY}/app" on line 3 doesn't resolve to a valid reference`,
+				`dockerfile: image "${REGISTRY}/tools" on line 4 doesn't resolve to a valid reference`,
+			},
+		},
+		{
+			name: "set",
+			args: ImageArgs{
+				AllowedBaseImages: []string{"ghcr.io/pulumi/*"},
+				BuildArgs:         map[string]string{"REGISTRY": "ghcr.io/pulumi"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.args.Dockerfile = &Dockerfile{Inline: dockerfile}
+
+			err := tt.args.checkBaseImages(t.Context(), Config{}, nil)
+
+			var failures []string
+			if err != nil {
+				for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+					cf := e.(checkFailure)
+					failures = append(failures, cf.Property+": "+cf.Reason)
+				}
+			}
+			assert.Equal(t, tt.wantFailures, failures)
+		})
+	}
+}
+
+func TestCheckPinnedBaseImages(t *testing.T) {
+	t.Parallel()
+	enabled, disabled := true, false
+	pinned := "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+	dockerfile := `
+ARG GO=golang:1.26
+FROM alpine@` + pinned + ` AS base
+FROM $GO AS build
+FROM base
+COPY --from=build /bin /bin
+COPY --from=ghcr.io/pulumi/tools:1 /bin /bin
+COPY --from=0 / /
+`
+
+	resolve := func(_ context.Context, ref string) (string, error) {
+		if ref == "docker.io/library/golang:1.26" {
+			return "sha256:1111111111111111111111111111111111111111111111111111111111111111", nil
+		}
+		return "", errors.New("unauthorized")
+	}
+
+	tests := []struct {
+		name         string
+		config       Config
+		required     *bool
+		buildArgs    map[string]string
+		wantFailures []string
+	}{
+		{
+			name: "not required",
+		},
+		{
+			name:     "required",
+			required: &enabled,
+			wantFailures: []string{
+				`dockerfile: image "docker.io/library/golang:1.26" on line 4 isn't pinned to a digest; ` +
+					`use "docker.io/library/golang:1.26@sha256:1111111111111111111111111111111111111111111111111111111111111111"`,
+				`dockerfile: image "ghcr.io/pulumi/tools:1" on line 7 isn't pinned to a digest; append "@sha256:<digest>"`,
+			},
+		},
+		{
+			name:      "provider default",
+			config:    Config{RequirePinnedBaseImages: &enabled},
+			buildArgs: map[string]string{"GO": "golang@" + pinned},
+			wantFailures: []string{
+				`dockerfile: image "ghcr.io/pulumi/tools:1" on line 7 isn't pinned to a digest; append "@sha256:<digest>"`,
+			},
+		},
+		{
+			name:     "override",
+			config:   Config{RequirePinnedBaseImages: &enabled},
+			required: &disabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ia := ImageArgs{
+				BuildArgs:               tt.buildArgs,
+				Dockerfile:              &Dockerfile{Inline: dockerfile},
+				RequirePinnedBaseImages: tt.required,
+			}
+
+			err := ia.checkBaseImages(t.Context(), tt.config, resolve)
+
+			var failures []string
+			if err != nil {
+				for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+					cf := e.(checkFailure)
+					failures = append(failures, cf.Property+": "+cf.Reason)
+				}
+			}
+			assert.Equal(t, tt.wantFailures, failures)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+
+	"github.com/distribution/reference"
+	"github.com/docker/buildx/util/urlutil"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+var _ infer.Annotated = (*BaseImageLock)(nil)
+
+// BaseImageLock pins an image's base images to the digests they resolved to
+// when the lock was created.
+type BaseImageLock struct {
+	Path    string `pulumi:"path,optional"`
+	Refresh string `pulumi:"refresh,optional"`
+}
+
+// Annotate sets docstrings on BaseImageLock.
+func (l *BaseImageLock) Annotate(a infer.Annotator) {
+	a.Describe(&l.Path, dedent(`
+		A lockfile to record digests in, like "Dockerfile.lock". Relative
+		paths are resolved from the Dockerfile's directory, or the context's
+		for inline Dockerfiles.
+
+		Digests are recorded in the resource's state if this isn't set.
+	`))
+	a.Describe(&l.Refresh, dedent(`
+		An arbitrary value, like a date, which re-resolves every base image
+		when it changes.
+	`))
+}
+
+// lockfile is the on-disk format of a BaseImageLock.
+type lockfile struct {
+	Refresh string            `json:"refresh,omitempty"`
+	Images  map[string]string `json:"images"`
+}
+
+// lockPath returns where the image's base image lock is stored on disk, or an
+// empty string if it's kept in state.
+func (ia *ImageArgs) lockPath() string {
+	if ia.BaseImageLock == nil || ia.BaseImageLock.Path == "" {
+		return ""
+	}
+	p := ia.BaseImageLock.Path
+	if filepath.IsAbs(p) {
+		return p
+	}
+	dir := "."
+	if ia.Dockerfile != nil && ia.Dockerfile.Location != "" && !urlutil.IsRemoteURL(ia.Dockerfile.Location) {
+		dir = filepath.Dir(ia.Dockerfile.Location)
+	} else if ia.Context != nil && ia.Context.Location != "" {
+		dir = ia.Context.Location
+	}
+	return filepath.Join(dir, p)
+}
+
+// lockBaseImages returns the digest of every external "FROM" and "COPY
+// --from" image, keyed by normalized reference.
+//
+// Digests are reused from the previous lock -- the lockfile, or olds if the
+// lock is kept in state -- unless its refresh value has changed. Anything
+// else is resolved and, unless this is a preview, saved to the lockfile.
+// Images which are already pinned aren't locked.
+func (ia *ImageArgs) lockBaseImages(
+	ctx context.Context,
+	resolve digestResolver,
+	olds *ImageState,
+	preview bool,
+) (map[string]string, error) {
+	prior := lockfile{}
+	path := ia.lockPath()
+	if path != "" {
+		b, err := os.ReadFile(filepath.Clean(path))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if err == nil {
+			if err := json.Unmarshal(b, &prior); err != nil {
+				return nil, fmt.Errorf("reading %q: %w", path, err)
+			}
+		}
+	} else if olds != nil && olds.BaseImageLock != nil {
+		prior = lockfile{Refresh: olds.BaseImageLock.Refresh, Images: olds.LockedBaseImages}
+	}
+	if prior.Refresh != ia.BaseImageLock.Refresh {
+		prior.Images = nil
+	}
+
+	images, err := ia.baseImages()
+	if err != nil {
+		return nil, err
+	}
+
+	locked := map[string]string{}
+	for _, img := range images {
+		if img.ref == nil {
+			continue
+		}
+		key := img.ref.String()
+		if _, ok := img.ref.(reference.Canonical); ok {
+			continue
+		}
+		if dgst, ok := prior.Images[key]; ok {
+			locked[key] = dgst
+			continue
+		}
+		if preview {
+			continue
+		}
+		dgst, err := resolve(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("resolving %q: %w", key, err)
+		}
+		locked[key] = dgst
+	}
+
+	if path != "" && !preview && !maps.Equal(locked, prior.Images) {
+		b, err := json.MarshalIndent(lockfile{Refresh: ia.BaseImageLock.Refresh, Images: locked}, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		// Lockfiles are committed alongside the Dockerfile, so they should be
+		// readable by anyone who can read the Dockerfile.
+		//nolint:gosec // G306: lockfiles only contain public digests.
+		if err := os.WriteFile(path, append(b, '\n'), 0o644); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(locked) == 0 {
+		return nil, nil
+	}
+	return locked, nil
+}
+
+// withLockedImages returns a copy of the args whose build context has a named
+// context pinning each locked image to its digest.
+func (ia ImageArgs) withLockedImages(locked map[string]string) ImageArgs {
+	if len(locked) == 0 {
+		return ia
+	}
+	bc := BuildContext{}
+	if ia.Context != nil {
+		bc = *ia.Context
+	}
+	bc.Named = maps.Clone(bc.Named)
+	if bc.Named == nil {
+		bc.Named = NamedContexts{}
+	}
+	for ref, dgst := range locked {
+		bc.Named[ref] = Context{Location: "docker-image://" + ref + "@" + dgst}
+	}
+	ia.Context = &bc
+	return ia
+}
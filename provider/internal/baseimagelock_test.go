@@ -0,0 +1,214 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+func TestLockBaseImages(t *testing.T) {
+	t.Parallel()
+	old := "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	moved := "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	pinned := "sha256:3333333333333333333333333333333333333333333333333333333333333333"
+
+	dockerfile := "FROM alpine:3.20 AS base\n" +
+		"FROM golang:1.26@" + pinned + "\n" +
+		"COPY --from=busybox /bin/sh /sh\n" +
+		"COPY --from=base / /\n"
+
+	resolve := func(_ context.Context, ref string) (string, error) {
+		switch ref {
+		case "docker.io/library/alpine:3.20", "docker.io/library/busybox:latest":
+			return moved, nil
+		}
+		return "", errors.New("unexpected " + ref)
+	}
+
+	tests := []struct {
+		name    string
+		lock    BaseImageLock
+		olds    *ImageState
+		preview bool
+
+		want map[string]string
+	}{
+		{
+			name: "first build",
+			want: map[string]string{
+				"docker.io/library/alpine:3.20":    moved,
+				"docker.io/library/busybox:latest": moved,
+			},
+		},
+		{
+			name: "reuses state",
+			olds: &ImageState{
+				ImageArgs:        ImageArgs{BaseImageLock: &BaseImageLock{}},
+				LockedBaseImages: map[string]string{"docker.io/library/alpine:3.20": old},
+			},
+			want: map[string]string{
+				"docker.io/library/alpine:3.20":    old,
+				"docker.io/library/busybox:latest": moved,
+			},
+		},
+		{
+			name: "refreshed",
+			lock: BaseImageLock{Refresh: "2026-10-17"},
+			olds: &ImageState{
+				ImageArgs:        ImageArgs{BaseImageLock: &BaseImageLock{}},
+				LockedBaseImages: map[string]string{"docker.io/library/alpine:3.20": old},
+			},
+			want: map[string]string{
+				"docker.io/library/alpine:3.20":    moved,
+				"docker.io/library/busybox:latest": moved,
+			},
+		},
+		{
+			name: "not previously locked",
+			olds: &ImageState{
+				LockedBaseImages: map[string]string{"docker.io/library/alpine:3.20": old},
+			},
+			want: map[string]string{
+				"docker.io/library/alpine:3.20":    moved,
+				"docker.io/library/busybox:latest": moved,
+			},
+		},
+		{
+			name:    "preview",
+			preview: true,
+			olds: &ImageState{
+				ImageArgs:        ImageArgs{BaseImageLock: &BaseImageLock{}},
+				LockedBaseImages: map[string]string{"docker.io/library/alpine:3.20": old},
+			},
+			want: map[string]string{"docker.io/library/alpine:3.20": old},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			args := ImageArgs{
+				BaseImageLock: &tt.lock,
+				Dockerfile:    &Dockerfile{Inline: dockerfile},
+			}
+			got, err := args.lockBaseImages(t.Context(), resolve, tt.olds, tt.preview)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("unresolved", func(t *testing.T) {
+		t.Parallel()
+		args := ImageArgs{
+			BaseImageLock: &BaseImageLock{},
+			Dockerfile:    &Dockerfile{Inline: "FROM private/base\n"},
+		}
+		_, err := args.lockBaseImages(t.Context(), resolve, nil, false)
+		assert.ErrorContains(t, err, `resolving "docker.io/private/base:latest"`)
+	})
+}
+
+func TestLockBaseImagesFile(t *testing.T) {
+	t.Parallel()
+	old := "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	moved := "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+
+	dir := t.TempDir()
+	dockerfile := filepath.Join(dir, "Dockerfile")
+	require.NoError(t, os.WriteFile(dockerfile, []byte("FROM alpine:3.20\n"), 0o600))
+
+	current := old
+	resolve := func(context.Context, string) (string, error) { return current, nil }
+	args := ImageArgs{
+		BaseImageLock: &BaseImageLock{Path: "Dockerfile.lock"},
+		Dockerfile:    &Dockerfile{Location: dockerfile},
+	}
+	want := map[string]string{"docker.io/library/alpine:3.20": old}
+
+	// The first build records the digest.
+	got, err := args.lockBaseImages(t.Context(), resolve, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	b, err := os.ReadFile(filepath.Join(dir, "Dockerfile.lock"))
+	require.NoError(t, err)
+	var lf lockfile
+	require.NoError(t, json.Unmarshal(b, &lf))
+	assert.Equal(t, want, lf.Images)
+
+	// Later builds keep it, even if the image moves.
+	current = moved
+	got, err = args.lockBaseImages(t.Context(), resolve, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	// Until the lock is refreshed.
+	args.BaseImageLock.Refresh = "1"
+	got, err = args.lockBaseImages(t.Context(), resolve, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"docker.io/library/alpine:3.20": moved}, got)
+}
+
+func TestUpdateBaseImageLock(t *testing.T) {
+	t.Parallel()
+	old := "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+
+	ctrl := gomock.NewController(t)
+	c := NewMockClient(ctrl)
+	c.EXPECT().BuildKitEnabled().Return(true, nil)
+	c.EXPECT().SupportsMultipleExports().Return(true)
+	c.EXPECT().Build(gomock.Any(), gomock.AssignableToTypeOf(&build{})).DoAndReturn(
+		func(_ context.Context, b Build) (*BuildResult, error) {
+			assert.Equal(t,
+				map[string]string{
+					"docker.io/library/alpine:3.20": "docker-image://docker.io/library/alpine:3.20@" + old,
+				},
+				b.BuildOptions().NamedContexts,
+			)
+			return &BuildResult{}, nil
+		},
+	)
+
+	args := ImageArgs{
+		BaseImageLock: &BaseImageLock{},
+		Context:       &BuildContext{Context: Context{Location: testdataNoop}},
+		Dockerfile:    &Dockerfile{Inline: "FROM alpine:3.20\n"},
+		Exports:       []Export{{CacheOnly: &ExportCacheOnly{}}},
+	}
+	i := &Image{clientF: mockClientF(c)}
+	resp, err := i.Update(t.Context(), infer.UpdateRequest[ImageArgs, ImageState]{
+		ID:     "locked",
+		Inputs: args,
+		State: ImageState{
+			ImageArgs:        args,
+			LockedBaseImages: map[string]string{"docker.io/library/alpine:3.20": old},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"docker.io/library/alpine:3.20": old}, resp.Output.LockedBaseImages)
+	assert.Nil(t, resp.Output.BaseImageDigests)
+	assert.Nil(t, resp.Output.Context.Named)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/docker/buildx/util/progress"
+	"github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/solver/errdefs"
+)
+
+const (
+	// stepLogLines is the number of lines of a failed step's output included
+	// in its error.
+	stepLogLines = 20
+
+	// stepLogLimit is the number of bytes of output we retain for each step.
+	stepLogLimit = 64 * 1024
+)
+
+var _ progress.Writer = (*stepLogWriter)(nil)
+
+// stepLogWriter wraps a progress.Writer and records the most recent output of
+// every step (vertex) it sees.
+type stepLogWriter struct {
+	progress.Writer
+
+	mu   sync.Mutex
+	logs map[string][]byte
+}
+
+func newStepLogWriter(w progress.Writer) *stepLogWriter {
+	return &stepLogWriter{Writer: w, logs: map[string][]byte{}}
+}
+
+// Write records each step's output before forwarding the status.
+func (w *stepLogWriter) Write(s *client.SolveStatus) {
+	w.mu.Lock()
+	for _, l := range s.Logs {
+		if l == nil {
+			continue
+		}
+		b := append(w.logs[string(l.Vertex)], l.Data...)
+		if len(b) > stepLogLimit {
+			b = b[len(b)-stepLogLimit:]
+		}
+		w.logs[string(l.Vertex)] = b
+	}
+	w.mu.Unlock()
+
+	w.Writer.Write(s)
+}
+
+// tail returns up to the last n lines of a step's output.
+func (w *stepLogWriter) tail(vertex string, n int) []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	out := strings.TrimRight(string(w.logs[vertex]), "\n")
+	if out == "" {
+		return nil
+	}
+	lines := strings.Split(out, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines
+}
+
+// buildError is a failed build's error, annotated with the Dockerfile lines
+// responsible and the failing step's output.
+type buildError struct {
+	err     error
+	sources []*errdefs.Source
+	output  []string
+}
+
+// newBuildError annotates err with the source locations buildkit attached to
+// it, and the tail of the failing step's output. err is returned unchanged if
+// there's nothing to add.
+func newBuildError(err error, steps *stepLogWriter) error {
+	sources := errdefs.Sources(err)
+
+	var output []string
+	var verr *errdefs.VertexError
+	if errors.As(err, &verr) && verr.Vertex != nil && steps != nil {
+		output = steps.tail(verr.Digest, stepLogLines)
+	}
+
+	if len(sources) == 0 && len(output) == 0 {
+		return err
+	}
+	return &buildError{err: err, sources: sources, output: output}
+}
+
+func (e *buildError) Error() string {
+	b := &strings.Builder{}
+	b.WriteString(e.err.Error())
+
+	for _, s := range e.sources {
+		b.WriteString("\n\n")
+		_ = s.Print(b)
+	}
+
+	if len(e.output) > 0 {
+		fmt.Fprintf(b, "\n\nLast %d line(s) of the step's output:\n", len(e.output))
+		for _, l := range e.output {
+			b.WriteString(l + "\n")
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func (e *buildError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,99 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/solver/errdefs"
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepLogWriter(t *testing.T) {
+	t.Parallel()
+
+	w := newStepLogWriter(nopWriter{})
+	for i := range 30 {
+		w.Write(&client.SolveStatus{Logs: []*client.VertexLog{
+			{Vertex: "sha256:a", Data: fmt.Appendf(nil, "a%d\n", i)},
+		}})
+	}
+	w.Write(&client.SolveStatus{Logs: []*client.VertexLog{
+		{Vertex: "sha256:b", Data: []byte("b1\nb2")},
+		nil,
+	}})
+
+	got := w.tail("sha256:a", 3)
+	assert.Equal(t, []string{"a27", "a28", "a29"}, got)
+	assert.Equal(t, []string{"b1", "b2"}, w.tail("sha256:b", 3))
+	assert.Nil(t, w.tail("sha256:c", 3))
+}
+
+func TestNewBuildError(t *testing.T) {
+	t.Parallel()
+
+	steps := newStepLogWriter(nopWriter{})
+	steps.Write(&client.SolveStatus{Logs: []*client.VertexLog{
+		{Vertex: "sha256:ok", Data: []byte("unrelated\n")},
+		{Vertex: "sha256:failed", Data: []byte("hello\n/bin/sh: badcmd: not found\n")},
+	}})
+
+	cause := errors.New(`process "/bin/sh -c badcmd" did not complete successfully: exit code: 127`)
+	src := &errdefs.Source{
+		Info: &pb.SourceInfo{
+			Filename: "Dockerfile",
+			Data:     []byte("FROM alpine\nRUN echo hello\nRUN badcmd\n"),
+		},
+		Ranges: []*pb.Range{{
+			Start: &pb.Position{Line: 3},
+			End:   &pb.Position{Line: 3},
+		}},
+	}
+
+	t.Run("source and output", func(t *testing.T) {
+		t.Parallel()
+		err := newBuildError(src.WrapError(errdefs.WrapVertex(cause, "sha256:failed")), steps)
+
+		var be *buildError
+		require.ErrorAs(t, err, &be)
+		assert.ErrorIs(t, err, cause)
+
+		msg := err.Error()
+		assert.Contains(t, msg, cause.Error())
+		assert.Contains(t, msg, "Dockerfile:3")
+		assert.Contains(t, msg, "   2 |     RUN echo hello")
+		assert.Contains(t, msg, "   3 | >>> RUN badcmd")
+		assert.Contains(t, msg, "Last 2 line(s) of the step's output:\nhello\n/bin/sh: badcmd: not found")
+		assert.NotContains(t, msg, "unrelated")
+	})
+
+	t.Run("source only", func(t *testing.T) {
+		t.Parallel()
+		err := newBuildError(src.WrapError(cause), steps)
+		assert.Contains(t, err.Error(), "   3 | >>> RUN badcmd")
+		assert.NotContains(t, err.Error(), "output")
+	})
+
+	t.Run("unannotated", func(t *testing.T) {
+		t.Parallel()
+		err := newBuildError(cause, steps)
+		assert.Equal(t, cause, err)
+	})
+}
@@ -0,0 +1,127 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	provider "github.com/pulumi/pulumi-go-provider"
+)
+
+var (
+	missingSecretRe = regexp.MustCompile(`secret (\S+): not found`)
+	missingTargetRe = regexp.MustCompile(`target stage "([^"]*)" could not be found`)
+	missingSSHRe    = regexp.MustCompile(`no SSH key "([^"]*)" forwarded from the client`)
+)
+
+// buildFailure is a failed build attributed to a specific input property.
+type buildFailure struct {
+	checkFailure
+	err error
+}
+
+func (bf *buildFailure) Error() string {
+	return fmt.Sprintf("%s: %s: %s", bf.Property, bf.Reason, bf.err)
+}
+
+func (bf *buildFailure) Unwrap() error {
+	return bf.err
+}
+
+func newBuildFailure(err error, reason, format string, args ...any) error {
+	return &buildFailure{
+		checkFailure: checkFailure{
+			provider.CheckFailure{Property: fmt.Sprintf(format, args...), Reason: reason},
+		},
+		err: err,
+	}
+}
+
+// classifyBuildError attributes common BuildKit and registry errors to the
+// input property most likely responsible for them, with a hint on how to fix
+// it. Errors which can't be attributed are returned unchanged.
+func classifyBuildError(err error, args ImageArgs) error {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+
+	if m := missingSecretRe.FindStringSubmatch(msg); m != nil {
+		return newBuildFailure(err, fmt.Sprintf(
+			"secret %q is required by the Dockerfile but wasn't provided; add it to secrets", m[1],
+		), "secrets")
+	}
+
+	if m := missingTargetRe.FindStringSubmatch(msg); m != nil {
+		return newBuildFailure(err, fmt.Sprintf(
+			"stage %q doesn't exist; set target to a stage defined with FROM ... AS <name>", m[1],
+		), "target")
+	}
+
+	if m := missingSSHRe.FindStringSubmatch(msg); m != nil {
+		return newBuildFailure(err, fmt.Sprintf(
+			"SSH key %q is required by the Dockerfile but wasn't forwarded; add an entry with this ID to ssh", m[1],
+		), "ssh")
+	}
+
+	for idx, s := range args.SSH {
+		if len(s.Paths) == 0 && strings.Contains(msg, "invalid empty ssh agent socket") {
+			return newBuildFailure(err,
+				"no SSH agent socket was found; set paths or SSH_AUTH_SOCK",
+				"ssh[%d]", idx,
+			)
+		}
+		for _, p := range s.Paths {
+			if p != "" && strings.Contains(msg, p) {
+				return newBuildFailure(err, fmt.Sprintf(
+					"unable to read SSH agent socket or key %q; check that it exists and is readable", p,
+				), "ssh[%d]", idx)
+			}
+		}
+	}
+
+	for idx, c := range args.CacheFrom {
+		for _, ref := range cacheFromRefs(c) {
+			if strings.Contains(msg, ref) {
+				return newBuildFailure(err, fmt.Sprintf(
+					"unable to import cache from %q; check that it exists and the builder can access it", ref,
+				), "cacheFrom[%d]", idx)
+			}
+		}
+	}
+
+	return err
+}
+
+// cacheFromRefs returns the locations a cache import may be reported under,
+// preferring the fully-qualified form of registry references.
+func cacheFromRefs(c CacheFrom) []string {
+	var refs []string
+	for _, field := range strings.Split(c.String(), ",") {
+		k, v, ok := strings.Cut(field, "=")
+		if !ok || v == "" || (k != "ref" && k != "src") {
+			continue
+		}
+		if k == "ref" {
+			if named, err := normalizeReference(v); err == nil && named.String() != v {
+				refs = append(refs, named.String())
+			}
+		}
+		refs = append(refs, v)
+	}
+	return refs
+}
@@ -0,0 +1,116 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyBuildError(t *testing.T) {
+	t.Parallel()
+
+	args := ImageArgs{
+		CacheFrom: []CacheFrom{
+			{Local: &CacheFromLocal{Src: "/tmp/cache"}},
+			{Registry: &CacheFromRegistry{Ref: "pulumi/cache:latest"}},
+		},
+		SSH: []SSH{
+			{ID: "default"},
+			{ID: "git", Paths: []string{"/home/me/.ssh/id_git"}},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		err          error
+		args         ImageArgs
+		wantProperty string
+		wantReason   string
+	}{
+		{
+			name:         "missing secret",
+			err:          errors.New("failed to solve: secret password: not found"),
+			wantProperty: "secrets",
+			wantReason:   `secret "password" is required`,
+		},
+		{
+			name:         "unknown target",
+			err:          errors.New(`failed to solve: target stage "prod" could not be found`),
+			wantProperty: "target",
+			wantReason:   `stage "prod" doesn't exist`,
+		},
+		{
+			name:         "ssh not forwarded",
+			err:          errors.New(`failed to solve: no SSH key "github" forwarded from the client`),
+			wantProperty: "ssh",
+			wantReason:   `SSH key "github" is required`,
+		},
+		{
+			name:         "ssh key path",
+			err:          errors.New("failed to open /home/me/.ssh/id_git: permission denied"),
+			args:         args,
+			wantProperty: "ssh[1]",
+			wantReason:   `unable to read SSH agent socket or key "/home/me/.ssh/id_git"`,
+		},
+		{
+			name:         "ssh agent socket",
+			err:          errors.New("invalid empty ssh agent socket: make sure SSH_AUTH_SOCK is set"),
+			args:         args,
+			wantProperty: "ssh[0]",
+			wantReason:   "no SSH agent socket was found",
+		},
+		{
+			name:         "local cache",
+			err:          errors.New("failed to solve: open /tmp/cache/index.json: no such file or directory"),
+			args:         args,
+			wantProperty: "cacheFrom[0]",
+			wantReason:   `unable to import cache from "/tmp/cache"`,
+		},
+		{
+			name: "normalized registry cache",
+			err: &buildRefError{ref: "ref", err: errors.New(
+				"failed to solve: docker.io/pulumi/cache:latest: failed to authorize",
+			)},
+			args:         args,
+			wantProperty: "cacheFrom[1]",
+			wantReason:   `unable to import cache from "docker.io/pulumi/cache:latest"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := classifyBuildError(tt.err, tt.args)
+
+			var bf *buildFailure
+			require.ErrorAs(t, err, &bf)
+			assert.Equal(t, tt.wantProperty, bf.Property)
+			assert.Contains(t, bf.Reason, tt.wantReason)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Contains(t, err.Error(), tt.err.Error())
+		})
+	}
+
+	t.Run("unclassified", func(t *testing.T) {
+		t.Parallel()
+		err := errors.New("process did not complete successfully: exit code: 1")
+		assert.Equal(t, err, classifyBuildError(err, args))
+		assert.NoError(t, classifyBuildError(nil, args))
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+)
+
+// unsafeFilenameChars matches characters we don't want in build log names.
+var unsafeFilenameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// defaultBuildLogPath returns where to write an image's build log when only
+// the provider's "buildLogDir" is configured. Logs are named after the image's
+// first tag so they're stable across updates, falling back to name.
+func defaultBuildLogPath(dir, name string, tags []string) string {
+	if len(tags) > 0 && tags[0] != "" {
+		name = tags[0]
+	}
+	return filepath.Join(dir, unsafeFilenameChars.ReplaceAllString(name, "_")+".log")
+}
+
+// writeBuildLog writes a build's full output, followed by anything it wrote
+// to stderr, to path. Parent directories are created as needed.
+func writeBuildLog(path string, stdout, stderr []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(path, slices.Concat(stdout, stderr), 0o600)
+}
@@ -0,0 +1,92 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/moby/buildkit/exporter/containerimage/exptypes"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// buildRefKey is the exporter response key buildx uses to record the build's
+// history reference.
+const buildRefKey = "buildx.build.ref"
+
+var _ infer.Annotated = (*BuildMetadata)(nil)
+
+// BuildMetadata is a subset of the exporter response returned by buildkit.
+type BuildMetadata struct {
+	BuildRef            string `pulumi:"buildRef,optional"`
+	ConfigDigest        string `pulumi:"configDigest,optional"`
+	DescriptorMediaType string `pulumi:"descriptorMediaType,optional"`
+	DescriptorSize      int    `pulumi:"descriptorSize,optional"`
+	ImageName           string `pulumi:"imageName,optional"`
+}
+
+// Annotate sets docstrings on BuildMetadata.
+func (m *BuildMetadata) Annotate(a infer.Annotator) {
+	a.Describe(&m.BuildRef, dedent(`
+		The buildx history reference for the build, formatted as
+		"<builder>/<node>/<ref>".
+
+		This can be passed to "docker buildx history inspect" to debug the
+		build.
+	`))
+	a.Describe(&m.ConfigDigest, "The digest of the image's config blob.")
+	a.Describe(&m.DescriptorMediaType, dedent(`
+		The media type of the exported image's top-level manifest or index.
+	`))
+	a.Describe(&m.DescriptorSize, dedent(`
+		The size in bytes of the exported image's top-level manifest or index.
+	`))
+	a.Describe(&m.ImageName, dedent(`
+		The comma-separated names the image was exported with.
+	`))
+}
+
+// newBuildMetadata extracts BuildMetadata from an exporter response. Returns
+// nil if the response contains none of the fields we care about.
+func newBuildMetadata(resp map[string]string) *BuildMetadata {
+	m := BuildMetadata{
+		BuildRef:     resp[buildRefKey],
+		ConfigDigest: resp[exptypes.ExporterImageConfigDigestKey],
+		ImageName:    resp[exptypes.ExporterImageNameKey],
+	}
+	if m.ConfigDigest == "" {
+		m.ConfigDigest = resp[exptypes.ExporterConfigDigestKey]
+	}
+
+	// The descriptor is base64-encoded JSON.
+	if d, ok := resp[exptypes.ExporterImageDescriptorKey]; ok {
+		var desc struct {
+			MediaType string `json:"mediaType"`
+			Size      int    `json:"size"`
+		}
+		if raw, err := base64.StdEncoding.DecodeString(d); err == nil {
+			if err := json.Unmarshal(raw, &desc); err == nil {
+				m.DescriptorMediaType = desc.MediaType
+				m.DescriptorSize = desc.Size
+			}
+		}
+	}
+
+	if m == (BuildMetadata{}) {
+		return nil
+	}
+	return &m
+}
@@ -0,0 +1,79 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/moby/buildkit/exporter/containerimage/exptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBuildMetadata(t *testing.T) {
+	t.Parallel()
+	const (
+		configDigest = "sha256:98ea6e4f216f2fb4b69fff9b3a44842c38686ca685f3f55dc48c5d3fb1107be4"
+		indexType    = "application/vnd.oci.image.index.v1+json"
+	)
+	descriptor := base64.StdEncoding.EncodeToString(
+		[]byte(`{"mediaType":"` + indexType + `","digest":"sha256:abc","size":856}`),
+	)
+
+	tests := []struct {
+		name string
+		resp map[string]string
+		want *BuildMetadata
+	}{
+		{
+			name: "image exporter",
+			resp: map[string]string{
+				buildRefKey:                           "default/default/ixk2w1fqr0b4lzl5r9cpmy3cm",
+				exptypes.ExporterImageConfigDigestKey: configDigest,
+				exptypes.ExporterImageDescriptorKey:   descriptor,
+				exptypes.ExporterImageNameKey:         "docker.io/pulumi/pulumitest:latest",
+			},
+			want: &BuildMetadata{
+				BuildRef:            "default/default/ixk2w1fqr0b4lzl5r9cpmy3cm",
+				ConfigDigest:        configDigest,
+				DescriptorMediaType: indexType,
+				DescriptorSize:      856,
+				ImageName:           "docker.io/pulumi/pulumitest:latest",
+			},
+		},
+		{
+			name: "oci exporter config digest",
+			resp: map[string]string{exptypes.ExporterConfigDigestKey: configDigest},
+			want: &BuildMetadata{ConfigDigest: configDigest},
+		},
+		{
+			name: "malformed descriptor",
+			resp: map[string]string{exptypes.ExporterImageDescriptorKey: "{not base64"},
+			want: nil,
+		},
+		{
+			name: "empty",
+			resp: nil,
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, newBuildMetadata(tt.resp))
+		})
+	}
+}
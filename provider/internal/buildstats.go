@@ -0,0 +1,153 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/docker/buildx/util/progress"
+	"github.com/moby/buildkit/client"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// slowestStepsLimit is the number of steps reported in BuildStats.SlowestSteps.
+const slowestStepsLimit = 5
+
+var (
+	_ infer.Annotated = (*BuildStats)(nil)
+	_ infer.Annotated = (*BuildStep)(nil)
+	_ progress.Writer = (*statsWriter)(nil)
+)
+
+// BuildStats summarizes the steps performed by a build.
+type BuildStats struct {
+	Duration      float64     `pulumi:"duration"`
+	Steps         int         `pulumi:"steps"`
+	CachedSteps   int         `pulumi:"cachedSteps"`
+	CacheHitRatio float64     `pulumi:"cacheHitRatio"`
+	SlowestSteps  []BuildStep `pulumi:"slowestSteps,optional"`
+}
+
+// Annotate sets docstrings on BuildStats.
+func (s *BuildStats) Annotate(a infer.Annotator) {
+	a.Describe(&s.Duration, "Wall-clock duration of the build in seconds.")
+	a.Describe(&s.Steps, "The number of steps (vertices) solved by the build.")
+	a.Describe(&s.CachedSteps, "The number of steps which were cached.")
+	a.Describe(&s.CacheHitRatio, dedent(`
+		The fraction of steps which were cached, between 0 and 1.
+	`))
+	a.Describe(&s.SlowestSteps, dedent(`
+		The slowest uncached steps, in descending order of duration.
+	`))
+}
+
+// BuildStep is a single step performed by a build.
+type BuildStep struct {
+	Name     string  `pulumi:"name"`
+	Duration float64 `pulumi:"duration"`
+}
+
+// Annotate sets docstrings on BuildStep.
+func (s *BuildStep) Annotate(a infer.Annotator) {
+	a.Describe(&s.Name, `The step's name, for example "[2/3] RUN make".`)
+	a.Describe(&s.Duration, "The step's duration in seconds.")
+}
+
+// String returns a one-line summary of the build.
+func (s BuildStats) String() string {
+	return fmt.Sprintf("build finished in %.1fs: %d steps, %d cached (%.0f%%)",
+		s.Duration, s.Steps, s.CachedSteps, s.CacheHitRatio*100)
+}
+
+// statsWriter wraps a progress.Writer and records every vertex it sees.
+type statsWriter struct {
+	progress.Writer
+
+	mu       sync.Mutex
+	vertices map[string]client.Vertex
+}
+
+func newStatsWriter(w progress.Writer) *statsWriter {
+	return &statsWriter{Writer: w, vertices: map[string]client.Vertex{}}
+}
+
+// Write records the latest state of each vertex before forwarding the status.
+func (w *statsWriter) Write(s *client.SolveStatus) {
+	w.mu.Lock()
+	for _, v := range s.Vertexes {
+		if v == nil {
+			continue
+		}
+		w.vertices[string(v.Digest)] = *v
+	}
+	w.mu.Unlock()
+
+	w.Writer.Write(s)
+}
+
+// stats summarizes the vertices seen so far. Returns nil if there were none.
+func (w *statsWriter) stats() *BuildStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.vertices) == 0 {
+		return nil
+	}
+
+	var start, end time.Time
+	stats := BuildStats{Steps: len(w.vertices)}
+	steps := []BuildStep{}
+
+	for _, v := range w.vertices {
+		if v.Cached {
+			stats.CachedSteps++
+		}
+		if v.Started == nil || v.Completed == nil {
+			continue
+		}
+		if start.IsZero() || v.Started.Before(start) {
+			start = *v.Started
+		}
+		if v.Completed.After(end) {
+			end = *v.Completed
+		}
+		if !v.Cached {
+			steps = append(steps, BuildStep{
+				Name:     v.Name,
+				Duration: v.Completed.Sub(*v.Started).Seconds(),
+			})
+		}
+	}
+
+	stats.Duration = end.Sub(start).Seconds()
+	stats.CacheHitRatio = float64(stats.CachedSteps) / float64(stats.Steps)
+
+	slices.SortFunc(steps, func(a, b BuildStep) int {
+		return cmp.Or(cmp.Compare(b.Duration, a.Duration), cmp.Compare(a.Name, b.Name))
+	})
+	if len(steps) > slowestStepsLimit {
+		steps = steps[:slowestStepsLimit]
+	}
+	if len(steps) > 0 {
+		stats.SlowestSteps = steps
+	}
+
+	return &stats
+}
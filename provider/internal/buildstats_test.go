@@ -0,0 +1,66 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/buildx/util/progress"
+	"github.com/moby/buildkit/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nopWriter struct{ progress.Writer }
+
+func (nopWriter) Write(*client.SolveStatus) {}
+
+func TestBuildStats(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(s int) *time.Time {
+		ts := start.Add(time.Duration(s) * time.Second)
+		return &ts
+	}
+
+	w := newStatsWriter(nopWriter{})
+	assert.Nil(t, w.stats())
+
+	w.Write(&client.SolveStatus{Vertexes: []*client.Vertex{
+		{Digest: "sha256:a", Name: "[1/3] FROM alpine", Started: at(0)},
+		{Digest: "sha256:b", Name: "[2/3] COPY . .", Started: at(0), Completed: at(0), Cached: true},
+	}})
+	// Later updates for the same vertex replace earlier ones.
+	w.Write(&client.SolveStatus{Vertexes: []*client.Vertex{
+		{Digest: "sha256:a", Name: "[1/3] FROM alpine", Started: at(0), Completed: at(2)},
+		{Digest: "sha256:c", Name: "[3/3] RUN make", Started: at(2), Completed: at(10)},
+	}})
+
+	stats := w.stats()
+	require.NotNil(t, stats)
+	assert.Equal(t, &BuildStats{
+		Duration:      10,
+		Steps:         3,
+		CachedSteps:   1,
+		CacheHitRatio: 1.0 / 3,
+		SlowestSteps: []BuildStep{
+			{Name: "[3/3] RUN make", Duration: 8},
+			{Name: "[1/3] FROM alpine", Duration: 2},
+		},
+	}, stats)
+	assert.Equal(t, "build finished in 10.0s: 3 steps, 1 cached (33%)", stats.String())
+}
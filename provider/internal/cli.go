@@ -20,6 +20,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -27,6 +28,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/docker/buildx/commands"
@@ -35,7 +37,6 @@ import (
 	"github.com/docker/cli/cli/config/credentials"
 	cfgtypes "github.com/docker/cli/cli/config/types"
 	"github.com/docker/cli/cli/streams"
-	"github.com/moby/buildkit/client"
 	cp "github.com/otiai10/copy"
 	"github.com/regclient/regclient"
 	"github.com/regclient/regclient/config"
@@ -71,6 +72,8 @@ type cli struct {
 	r, w     *os.File     // stdout
 	err      bytes.Buffer // stderr
 	dumplogs bool         // if true then tail() will re-log status messages
+	progress ProgressMode // how tail() reports status messages
+	logPath  string       // if set then tail() will write its output here
 	builder  Builder      // for mocking build daemon responses
 }
 
@@ -147,7 +150,7 @@ func wrap(host *host, registries ...Registry) (*cli, error) {
 // credentials and disables any credential helpers/stores, so auth lookups can
 // never reach the host. wrap() applies this once at construction. It must be
 // re-applied after buildx's NewRootCmd runs dockerCli.Initialize() (see
-// manifestCmd), which reloads the host config and would otherwise discard the
+// buildxCmd), which reloads the host config and would otherwise discard the
 // sandbox.
 func (c *cli) applyAuthSandbox() {
 	cfg := c.ConfigFile()
@@ -156,7 +159,8 @@ func (c *cli) applyAuthSandbox() {
 	cfg.CredentialsStore = ""
 }
 
-// manifestCmd builds the buildx imagetools root command for the given args.
+// buildxCmd builds the buildx root command for the given args, for example
+// "imagetools create" or "history export".
 //
 // buildx v0.31+ makes NewRootCmd's non-plugin PersistentPreRunE call
 // dockerCli.Initialize(), which unconditionally reloads the host's Docker
@@ -166,7 +170,7 @@ func (c *cli) applyAuthSandbox() {
 // of the resource's scoped registries. We wrap PersistentPreRunE to re-apply
 // the sandbox after Initialize runs, restoring wrap()'s isolation before any
 // auth is resolved.
-func (c *cli) manifestCmd(args []string) *cobra.Command {
+func (c *cli) buildxCmd(args []string) *cobra.Command {
 	cmd := commands.NewRootCmd(os.Args[0], false, c.dockerCli)
 
 	initialize := cmd.PersistentPreRunE
@@ -222,20 +226,35 @@ func (c *cli) tail(ctx context.Context) {
 	s := bufio.NewScanner(c.r)
 	for s.Scan() {
 		text := s.Text()
-		provider.GetLogger(ctx).InfoStatus(text)
+		switch c.progress {
+		case ProgressQuiet:
+			// Only surfaced if the build fails.
+		case ProgressRawJSON:
+			// Each line is a status event, persist them for later parsing.
+			provider.GetLogger(ctx).Info(text)
+		default:
+			provider.GetLogger(ctx).InfoStatus(text)
+		}
 		_, _ = b.WriteString(text + "\n")
 	}
 	provider.GetLogger(ctx).InfoStatus("") // clear confusing "DONE" statements.
 
 	if c.dumplogs {
-		// Persist the full Docker output on error for easier debugging.
-		if b.Len() > 0 {
+		// Persist the full Docker output on error for easier debugging. Raw
+		// JSON output has already been logged.
+		if b.Len() > 0 && c.progress != ProgressRawJSON {
 			provider.GetLogger(ctx).Info(b.String())
 		}
 		if c.err.Len() > 0 {
 			provider.GetLogger(ctx).Error(c.err.String())
 		}
 	}
+
+	if c.logPath != "" {
+		if err := writeBuildLog(c.logPath, b.Bytes(), c.err.Bytes()); err != nil {
+			provider.GetLogger(ctx).Warning("unable to write build log: " + err.Error())
+		}
+	}
 }
 
 // close flushes any outstanding logs and cleans up resources.
@@ -246,7 +265,7 @@ func (c *cli) Close() error {
 // execBuild performs a build by os.Exec'ing the docker-buildx binary.
 // Credentials are communicated to docker-buildx via a temporary directory.
 // Secrets are communicated via dynamic environment variables.
-func (c *cli) execBuild(ctx context.Context, b Build) (*client.SolveResponse, error) {
+func (c *cli) execBuild(ctx context.Context, b Build) (*BuildResult, error) {
 	// Setup a temporary directory for auth, and clean it up when we're done.
 	tmp, err := os.MkdirTemp("", "pulumi-docker-")
 	if err != nil {
@@ -284,7 +303,15 @@ func (c *cli) execBuild(ctx context.Context, b Build) (*client.SolveResponse, er
 	env := []string{
 		"DOCKER_CONFIG=" + tmp,
 		"BUILDX_CONFIG=" + filepath.Join(hostConfigDir, "buildx"),
+		"BUILDX_METADATA_WARNINGS=1", // Needed for "warningsAsErrors".
+	}
+
+	// Let docker-buildx join our trace, if we're tracing.
+	endpoint := ""
+	if c.host.config != nil {
+		endpoint = c.host.config.OTLPEndpoint
 	}
+	env = append(env, tracingEnv(ctx, endpoint)...)
 
 	// We need to write to this file in order to recover information about the
 	// build, like the digest.
@@ -292,16 +319,20 @@ func (c *cli) execBuild(ctx context.Context, b Build) (*client.SolveResponse, er
 	args := []string{
 		buildxName,
 		"build",
-		"--progress", "plain",
+		"--progress", string(c.progress.displayMode()),
 		"--metadata-file", metadata,
 		"--builder", builder.name,
 	}
 
-	// TODO: --allow
-	// TODO: --annotation
-	// TODO: --attest
-	// TODO: --cgroup-parent
-
+	// Annotations are already applied to the relevant "--output" entries.
+	// "--annotation" would also add them to exporters which don't support
+	// them, like "local" and "tar".
+	for _, a := range opts.Allow {
+		args = append(args, "--allow", a)
+	}
+	for _, a := range opts.Attests {
+		args = append(args, "--attest", a.String())
+	}
 	for k, v := range opts.BuildArgs {
 		args = append(args, "--build-arg", fmt.Sprintf("%s=%s", k, v))
 	}
@@ -314,6 +345,9 @@ func (c *cli) execBuild(ctx context.Context, b Build) (*client.SolveResponse, er
 	for _, c := range opts.CacheTo {
 		args = append(args, "--cache-to", attrcsv(c.Type, c.Attrs))
 	}
+	if opts.CgroupParent != "" {
+		args = append(args, "--cgroup-parent", opts.CgroupParent)
+	}
 	if opts.ExportLoad {
 		args = append(args, "--load")
 	}
@@ -344,6 +378,9 @@ func (c *cli) execBuild(ctx context.Context, b Build) (*client.SolveResponse, er
 	if opts.Pull {
 		args = append(args, "--pull")
 	}
+	if opts.ShmSize > 0 {
+		args = append(args, "--shm-size", strconv.FormatInt(opts.ShmSize, 10))
+	}
 	for _, ssh := range opts.SSH {
 		s := ssh.ID
 		if len(ssh.Paths) > 0 {
@@ -357,6 +394,9 @@ func (c *cli) execBuild(ctx context.Context, b Build) (*client.SolveResponse, er
 	if opts.Target != "" {
 		args = append(args, "--target", opts.Target)
 	}
+	for _, u := range opts.Ulimits {
+		args = append(args, "--ulimit", u)
+	}
 	if opts.DockerfileName != "" {
 		args = append(args, "-f", opts.DockerfileName)
 	}
@@ -388,7 +428,8 @@ func (c *cli) execBuild(ctx context.Context, b Build) (*client.SolveResponse, er
 	}
 
 	// Read the metadata file and transform it back into the map[string]string
-	// structure originally returned by the exporter.
+	// structure originally returned by the exporter. buildx decodes JSON
+	// values, so we re-encode those as base64.
 	_, err = os.Stat(metadata)
 	if err != nil {
 		return nil, fmt.Errorf("missing metadata: %w", err)
@@ -412,11 +453,11 @@ func (c *cli) execBuild(ctx context.Context, b Build) (*client.SolveResponse, er
 			if err != nil {
 				continue
 			}
-			resp[k] = string(out)
+			resp[k] = base64.StdEncoding.EncodeToString(out)
 		}
 	}
 
-	return &client.SolveResponse{ExporterResponse: resp}, nil
+	return &BuildResult{ExporterResponse: resp, Warnings: buildWarnings(resp)}, nil
 }
 
 // exec invokes a Docker plugin binary. The first argument should be the name
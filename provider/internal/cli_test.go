@@ -123,7 +123,7 @@ func TestWrappedAuth(t *testing.T) {
 // TestManifestCreateAuthSandbox guards the isolation regression from buildx
 // v0.31+: NewRootCmd's PersistentPreRunE calls dockerCli.Initialize(), which
 // reloads the host's Docker config and would discard wrap()'s sandboxed
-// credentials before an `imagetools create` push authenticates. manifestCmd
+// credentials before an `imagetools create` push authenticates. buildxCmd
 // must re-apply the sandbox so the host's ambient credentials never leak.
 func TestManifestCreateAuthSandbox(t *testing.T) {
 	// Not parallel: mutates the process-global docker config dir to simulate a
@@ -157,7 +157,7 @@ func TestManifestCreateAuthSandbox(t *testing.T) {
 
 	// Run the manifest command's PersistentPreRunE: Initialize() reloads the
 	// host config, then the sandbox must be re-applied.
-	cmd := c.manifestCmd([]string{"imagetools", "create", "--dry-run", "--tag", "example.com/x"})
+	cmd := c.buildxCmd([]string{"imagetools", "create", "--dry-run", "--tag", "example.com/x"})
 	require.NoError(t, cmd.PersistentPreRunE(cmd, nil))
 
 	got := c.ConfigFile().AuthConfigs
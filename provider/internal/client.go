@@ -21,6 +21,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"strings"
 
 	"github.com/containerd/errdefs"
@@ -34,15 +36,19 @@ import (
 	"github.com/docker/buildx/util/progress"
 	"github.com/docker/cli/cli/command"
 	"github.com/docker/cli/cli/flags"
+	dockeropts "github.com/docker/cli/opts"
 	"github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/exporter/containerimage/exptypes"
+	"github.com/moby/buildkit/identity"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/session/auth/authprovider"
-	"github.com/moby/buildkit/util/progress/progressui"
 	mobyclient "github.com/moby/moby/client"
 	"github.com/regclient/regclient/types/descriptor"
 	"github.com/regclient/regclient/types/errs"
 	"github.com/regclient/regclient/types/manifest"
+	v1 "github.com/regclient/regclient/types/oci/v1"
 	"github.com/regclient/regclient/types/ref"
+	"go.opentelemetry.io/otel/attribute"
 
 	provider "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
@@ -50,10 +56,13 @@ import (
 
 // Client handles all our Docker API calls.
 type Client interface {
-	Build(ctx context.Context, b Build) (*client.SolveResponse, error)
+	Build(ctx context.Context, b Build) (*BuildResult, error)
 	BuildKitEnabled() (bool, error)
 	Inspect(ctx context.Context, id string) ([]descriptor.Descriptor, error)
+	ImageConfig(ctx context.Context, id string) (v1.Image, error)
+	Layers(ctx context.Context, id string) ([]descriptor.Descriptor, error)
 	Delete(ctx context.Context, id string) error
+	ExportHistory(ctx context.Context, builderName, ref, dest string) error
 
 	ManifestCreate(ctx context.Context, push bool, target string, refs ...string) error
 	ManifestInspect(ctx context.Context, target string) (string, error)
@@ -62,6 +71,17 @@ type Client interface {
 	SupportsMultipleExports() bool
 }
 
+// BuildResult is the outcome of a successful build.
+type BuildResult struct {
+	// ExporterResponse is the exporter's response, which captures the build's
+	// digest and tags (if any).
+	ExporterResponse map[string]string
+	// Stats summarizes the steps the build performed, if they were observed.
+	Stats *BuildStats
+	// Warnings are any warnings the build produced.
+	Warnings []client.VertexWarning
+}
+
 // registryGetter is something that can return a list of [Registry].
 type registryGetter interface {
 	GetRegistries() []Registry
@@ -99,10 +119,14 @@ func mockClientF(c Client) clientF {
 // replaced the proto sub-messages) so the rest of the provider is insulated
 // from buildx's internal churn.
 type BuildOptions struct {
+	Allow          []string
+	Annotations    map[exptypes.AnnotationKey]string
+	Attests        buildflags.Attests
 	BuildArgs      map[string]string
 	Builder        string
 	CacheFrom      []*buildflags.CacheOptionsEntry
 	CacheTo        []*buildflags.CacheOptionsEntry
+	CgroupParent   string
 	ContextPath    string
 	DockerfileName string
 	ExportLoad     bool
@@ -110,15 +134,19 @@ type BuildOptions struct {
 	Exports        []*buildflags.ExportEntry
 	ExtraHosts     []string
 	Labels         map[string]string
+	LogPath        string
 	NamedContexts  map[string]string
 	NetworkMode    string
 	NoCache        bool
 	Platforms      []string
+	Progress       string
 	Pull           bool
 	Secrets        []*buildflags.Secret
+	ShmSize        int64
 	SSH            []*buildflags.SSH
 	Tags           []string
 	Target         string
+	Ulimits        []string
 }
 
 // Build encapsulates all of the user-provider build parameters and options.
@@ -153,20 +181,42 @@ func newDockerCLI(config *Config, ops ...command.CLIOption) (*command.DockerCli,
 	return cli, nil
 }
 
-// Build performs a BuildKit build. Returns a map of target names (or one name,
-// "default", if no targets were specified) to SolveResponses, which capture
-// the build's digest and tags (if any).
+// Build performs a BuildKit build and returns the exporter's response along
+// with the stats and warnings we observed.
 func (c *cli) Build(
 	ctx context.Context,
 	build Build,
-) (*client.SolveResponse, error) {
+) (resp *BuildResult, err error) {
 	opts := build.BuildOptions()
 
-	go c.tail(ctx)
-	defer contract.IgnoreClose(c)
+	ctx, span := startSpan(ctx, "cli.Build",
+		attribute.String("builder", opts.Builder),
+		attribute.Bool("exec", build.ShouldExec()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	c.progress = ProgressMode(opts.Progress)
+	c.logPath = opts.LogPath
+
+	tailed := make(chan struct{})
+	go func() {
+		defer close(tailed)
+		c.tail(ctx)
+	}()
+	defer func() {
+		// Wait for tail to drain our output so logs are complete by the time
+		// we return.
+		_ = c.w.Close()
+		<-tailed
+		contract.IgnoreClose(c)
+	}()
 
 	if build.ShouldExec() {
-		return c.execBuild(ctx, build)
+		resp, err := c.execBuild(ctx, build)
+		if err != nil {
+			c.dumplogs = true
+		}
+		return resp, err
 	}
 
 	b, err := c.host.builderFor(ctx, build)
@@ -174,7 +224,7 @@ func (c *cli) Build(
 		return nil, err
 	}
 	printer, err := progress.NewPrinter(ctx, c.w,
-		progressui.PlainMode,
+		c.progress.displayMode(),
 		progress.WithDesc(
 			fmt.Sprintf("building with %q instance using %s driver", b.name, b.driver),
 			fmt.Sprintf("%s:%s", b.driver, b.name),
@@ -201,6 +251,11 @@ func (c *cli) Build(
 			}
 			provider.GetLogger(ctx).Warning(b.String())
 		}
+
+		// Record warnings so "warningsAsErrors" can be enforced.
+		if resp != nil {
+			resp.Warnings = printer.Warnings()
+		}
 	}()
 
 	cacheFrom := []client.CacheOptionsEntry{}
@@ -252,12 +307,34 @@ func (c *cli) Build(
 		return nil, err
 	}
 
+	ulimits := dockeropts.NewUlimitOpt(nil)
+	for _, u := range opts.Ulimits {
+		if err := ulimits.Set(u); err != nil {
+			return nil, err
+		}
+	}
+
+	// buildx attaches minimal provenance by default. Keep it disabled unless
+	// the user explicitly asked for it, otherwise every image would gain an
+	// "unknown/unknown" attestation manifest.
+	attests := map[string]*string{attestTypeProvenance: nil}
+	maps.Copy(attests, opts.Attests.ToMap())
+
 	// buildx's default build target name (unrelated to NetworkMode.Default).
 	const defaultTarget = "default"
 	target := opts.Target
 	if target == "" {
 		target = defaultTarget
 	}
+	// Choose our own build ref, rather than letting buildx pick one, so we
+	// can locate the build's history record even if it fails.
+	ref := identity.NewID()
+	node := ""
+	if len(b.nodes) > 0 {
+		node = b.nodes[0].Name
+	}
+	buildRef := fmt.Sprintf("%s/%s/%s", b.name, node, ref)
+
 	payload := map[string]buildx.Options{
 		target: {
 			Inputs: buildx.Inputs{
@@ -267,22 +344,25 @@ func (c *cli) Build(
 				NamedContexts:    namedContexts,
 				InStream:         buildx.NewSyncMultiReader(strings.NewReader("")),
 			},
-			// Disable default provenance for now. Docker's `manifest create`
-			// doesn't handle manifests with provenance included; more reason
-			// to use imagetools instead.
-			Attests:     map[string]*string{"provenance": nil},
-			BuildArgs:   opts.BuildArgs,
-			CacheFrom:   cacheFrom,
-			CacheTo:     cacheTo,
-			Exports:     exports,
-			ExtraHosts:  opts.ExtraHosts,
-			NetworkMode: opts.NetworkMode,
-			NoCache:     opts.NoCache,
-			Labels:      opts.Labels,
-			Platforms:   platforms,
-			Pull:        opts.Pull,
-			Tags:        opts.Tags,
-			Target:      opts.Target,
+			Ref:          ref,
+			Allow:        opts.Allow,
+			Annotations:  opts.Annotations,
+			Attests:      attests,
+			BuildArgs:    opts.BuildArgs,
+			CacheFrom:    cacheFrom,
+			CacheTo:      cacheTo,
+			CgroupParent: opts.CgroupParent,
+			Exports:      exports,
+			ExtraHosts:   opts.ExtraHosts,
+			NetworkMode:  opts.NetworkMode,
+			NoCache:      opts.NoCache,
+			Labels:       opts.Labels,
+			Platforms:    platforms,
+			Pull:         opts.Pull,
+			ShmSize:      dockeropts.MemBytes(opts.ShmSize),
+			Tags:         opts.Tags,
+			Target:       opts.Target,
+			Ulimits:      ulimits,
 
 			Session: []session.Attachable{
 				ssh,
@@ -299,6 +379,11 @@ func (c *cli) Build(
 	resultC := make(chan map[string]*client.SolveResponse)
 	errC := make(chan error)
 
+	// Record every vertex the printer sees so we can report build stats, and
+	// each step's output so we can report it if the step fails.
+	steps := newStepLogWriter(printer)
+	stats := newStatsWriter(steps)
+
 	// buildx.Build doesn't handle context cancellation, so we monitor it in a
 	// goroutine. cli.Close cleans up our file descriptors, so if we do exit
 	// early the remote build should terminate as soon as it sees the pipe has
@@ -312,7 +397,7 @@ func (c *cli) Build(
 			payload,
 			dockerutil.NewClient(c),
 			confutil.NewConfig(c),
-			printer,
+			stats,
 		)
 		if err != nil {
 			errC <- err
@@ -323,10 +408,17 @@ func (c *cli) Build(
 
 	select {
 	case results := <-resultC:
-		return results[target], nil
+		result := &BuildResult{Stats: stats.stats()}
+		if r := results[target]; r != nil {
+			result.ExporterResponse = r.ExporterResponse
+		}
+		return result, nil
 	case err := <-errC:
-		c.dumplogs = true
-		return nil, err
+		// Prefer pointing at the failing step over dumping the full log.
+		err = newBuildError(err, steps)
+		var be *buildError
+		c.dumplogs = !errors.As(err, &be)
+		return nil, &buildRefError{ref: buildRef, err: err}
 	case <-ctx.Done():
 		return nil, ctx.Err()
 	}
@@ -337,7 +429,10 @@ func (c *cli) BuildKitEnabled() (bool, error) {
 	return c.Cli.BuildKitEnabled()
 }
 
-func (c *cli) ManifestCreate(ctx context.Context, push bool, target string, refs ...string) error {
+func (c *cli) ManifestCreate(ctx context.Context, push bool, target string, refs ...string) (err error) {
+	ctx, span := startSpan(ctx, "cli.ManifestCreate", attribute.String("ref", target))
+	defer func() { endSpan(span, err) }()
+
 	go c.tail(ctx)
 	defer contract.IgnoreClose(c)
 
@@ -355,7 +450,7 @@ func (c *cli) ManifestCreate(ctx context.Context, push bool, target string, refs
 
 	args = append(args, refs...)
 
-	cmd := c.manifestCmd(args)
+	cmd := c.buildxCmd(args)
 	cmd.SetErr(c.Err())
 	cmd.SetOut(c.Out())
 
@@ -363,7 +458,10 @@ func (c *cli) ManifestCreate(ctx context.Context, push bool, target string, refs
 	return cmd.ExecuteContext(ctx)
 }
 
-func (c *cli) ManifestInspect(ctx context.Context, target string) (string, error) {
+func (c *cli) ManifestInspect(ctx context.Context, target string) (_ string, err error) {
+	ctx, span := startSpan(ctx, "cli.ManifestInspect", attribute.String("ref", target))
+	defer func() { endSpan(span, err) }()
+
 	rc := c.rc()
 
 	ref, err := ref.New(target)
@@ -382,7 +480,10 @@ func (c *cli) ManifestInspect(ctx context.Context, target string) (string, error
 	return string(m.GetDescriptor().Digest), nil
 }
 
-func (c *cli) ManifestDelete(ctx context.Context, target string) error {
+func (c *cli) ManifestDelete(ctx context.Context, target string) (err error) {
+	ctx, span := startSpan(ctx, "cli.ManifestDelete", attribute.String("ref", target))
+	defer func() { endSpan(span, err) }()
+
 	rc := c.rc()
 
 	ref, err := ref.New(target)
@@ -406,7 +507,10 @@ func (c *cli) ManifestDelete(ctx context.Context, target string) error {
 }
 
 // Inspect inspects an image.
-func (c *cli) Inspect(ctx context.Context, r string) ([]descriptor.Descriptor, error) {
+func (c *cli) Inspect(ctx context.Context, r string) (_ []descriptor.Descriptor, err error) {
+	ctx, span := startSpan(ctx, "cli.Inspect", attribute.String("ref", r))
+	defer func() { endSpan(span, err) }()
+
 	ref, err := ref.New(r)
 	if err != nil {
 		return nil, err
@@ -425,9 +529,78 @@ func (c *cli) Inspect(ctx context.Context, r string) ([]descriptor.Descriptor, e
 	return []descriptor.Descriptor{m.GetDescriptor()}, nil
 }
 
+// ImageConfig fetches the config blob of the image with the given ref. For
+// multi-platform images ref should include a platform-specific digest.
+func (c *cli) ImageConfig(ctx context.Context, r string) (_ v1.Image, err error) {
+	ctx, span := startSpan(ctx, "cli.ImageConfig", attribute.String("ref", r))
+	defer func() { endSpan(span, err) }()
+
+	ref, err := ref.New(r)
+	if err != nil {
+		return v1.Image{}, err
+	}
+
+	cfg, err := c.rc().ImageConfig(ctx, ref)
+	if err != nil {
+		return v1.Image{}, err
+	}
+
+	return cfg.GetConfig(), nil
+}
+
+// Layers returns the layers of the image manifest with the given ref. For
+// multi-platform images ref should include a platform-specific digest.
+func (c *cli) Layers(ctx context.Context, r string) (_ []descriptor.Descriptor, err error) {
+	ctx, span := startSpan(ctx, "cli.Layers", attribute.String("ref", r))
+	defer func() { endSpan(span, err) }()
+
+	ref, err := ref.New(r)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := c.rc().ManifestGet(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	mi, ok := m.(manifest.Imager)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an image manifest", r)
+	}
+
+	return mi.GetLayers()
+}
+
+// ExportHistory exports the history record of the build with the given ref to
+// dest as a Docker Desktop ".dockerbuild" bundle.
+func (c *cli) ExportHistory(ctx context.Context, builderName, ref, dest string) (err error) {
+	ctx, span := startSpan(ctx, "cli.ExportHistory", attribute.String("ref", ref))
+	defer func() { endSpan(span, err) }()
+
+	args := []string{
+		"history",
+		"export",
+		"--builder", builderName,
+		"--finalize",
+		"--output", dest,
+		ref,
+	}
+
+	cmd := c.buildxCmd(args)
+	cmd.SetErr(c.Err())
+	cmd.SetOut(io.Discard)
+
+	provider.GetLogger(ctx).Debug(fmt.Sprint("exporting build record with args", args))
+	return cmd.ExecuteContext(ctx)
+}
+
 // Delete attempts to delete an image with the given ref. Many registries don't
 // support the DELETE API yet, so this operation is not guaranteed to work.
-func (c *cli) Delete(ctx context.Context, r string) error {
+func (c *cli) Delete(ctx context.Context, r string) (err error) {
+	ctx, span := startSpan(ctx, "cli.Delete", attribute.String("ref", r))
+	defer func() { endSpan(span, err) }()
+
 	// Attempt to delete the ref locally if it exists.
 	_, _ = c.Client().ImageRemove(ctx, r, mobyclient.ImageRemoveOptions{
 		Force: true, // Needed in case the image has multiple tags.
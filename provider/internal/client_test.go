@@ -34,6 +34,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
 func TestAuth(t *testing.T) {
@@ -89,6 +91,7 @@ func TestBuild(t *testing.T) {
 
 	tmpdir := t.TempDir()
 	Max := Max
+	maxProvenance := ProvenanceMax
 
 	exampleContext := &BuildContext{Context: Context{Location: exampleAppContext}}
 
@@ -260,6 +263,40 @@ func TestBuild(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "attestations",
+			args: ImageArgs{
+				Context: exampleContext,
+				Attestations: &Attestations{
+					SBOM:       &SBOMAttestation{ScanStage: pulumi.BoolRef(true)},
+					Provenance: &ProvenanceAttestation{Mode: &maxProvenance},
+				},
+				Exports: []Export{{OCI: &ExportOCI{
+					ExportDocker: ExportDocker{Dest: filepath.Join(tmpdir, "attestations.tar")},
+				}}},
+			},
+		},
+		{
+			name: "annotations",
+			args: ImageArgs{
+				Context: exampleContext,
+				Annotations: map[string]string{
+					"org.opencontainers.image.title":                 fooName,
+					"index,manifest:org.opencontainers.image.vendor": barName,
+				},
+				Exports: []Export{{OCI: &ExportOCI{
+					ExportDocker: ExportDocker{Dest: filepath.Join(tmpdir, "annotations.tar")},
+				}}},
+			},
+		},
+		{
+			name: "resourceLimits",
+			args: ImageArgs{
+				Context: exampleContext,
+				ShmSize: "128m",
+				Ulimits: []Ulimit{{Name: "nofile", Soft: 1024, Hard: pulumi.IntRef(2048)}},
+			},
+		},
 		{
 			name: "dockerLoad",
 			args: ImageArgs{
@@ -417,7 +454,8 @@ func TestBuildExecError(t *testing.T) {
 		Dockerfile: &Dockerfile{
 			Inline: "FROM alpine\nRUN echo hello\nRUN badcmd",
 		},
-		Exec: true,
+		BuildLogPath: filepath.Join(t.TempDir(), "build.log"),
+		Exec:         true,
 	}
 
 	ctx := context.Background()
@@ -435,8 +473,12 @@ func TestBuildExecError(t *testing.T) {
 		`process "/bin/sh -c badcmd" did not complete successfully: exit code: 127`,
 	}
 
+	log, err := os.ReadFile(args.BuildLogPath)
+	require.NoError(t, err)
+
 	for _, want := range want {
 		assert.Contains(t, cli.err.String(), want)
+		assert.Contains(t, string(log), want)
 	}
 }
 
@@ -15,7 +15,6 @@
 package internal
 
 import (
-	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -53,7 +52,8 @@ type Context struct {
 // BuildContext represents Docker's named and unamed contexts.
 type BuildContext struct {
 	Context
-	Named NamedContexts `pulumi:"named,optional"`
+	Named    NamedContexts    `pulumi:"named,optional"`
+	HashMode *ContextHashMode `pulumi:"hashMode,optional"`
 }
 
 func (bc *BuildContext) namedMap() map[string]string {
@@ -158,40 +158,83 @@ func (bc *BuildContext) Annotate(a infer.Annotator) {
 
 		Values can be local paths, HTTP URLs, or  "docker-image://" images.
 	`))
+	a.Describe(&bc.HashMode, dedent(`
+		Which files in local contexts contribute to the "contextHash" used to
+		decide whether the image needs to be rebuilt.
+
+		With "referenced", only the paths copied by "COPY" and "ADD"
+		instructions are hashed, so changes to other files don't trigger a
+		rebuild. Every file is hashed if those paths can't be determined
+		statically -- for example when the Dockerfile is remote or a "RUN"
+		instruction bind-mounts a context.
+
+		Defaults to "all".
+	`))
 }
 
 // hashFile hashes a file's contents and accumulates it into the provider Hash.
+// Prefetched contents are used if provided, otherwise the file is read from
+// fs. If digest is true, a truncated digest of the file's contents is
+// returned. Skipped files are reported as false.
 func hashFile(
 	h hash.Hash,
 	fs fsutil.FS,
 	relativePath string,
 	fileMode gofs.FileMode,
-) error {
+	prefetched *fileContents,
+	digest bool,
+) (string, bool, error) {
 	if fileMode.IsDir() {
-		return nil
+		return "", false, nil
 	}
 	if !fileMode.IsRegular() && fileMode.Type() != os.ModeSymlink {
-		return nil
+		return "", false, nil
 	}
 
-	f, err := fs.Open(relativePath)
-	if err != nil {
-		return fmt.Errorf("could not open %q: %w", relativePath, err)
+	var w io.Writer = h
+	var content hash.Hash
+	if digest {
+		content = sha256.New()
+		w = io.MultiWriter(h, content)
 	}
-	defer contract.IgnoreClose(f)
 
-	_, err = io.Copy(h, f)
+	var err error
+	if prefetched != nil {
+		err = prefetched.err
+		if err == nil {
+			_, _ = w.Write(prefetched.data)
+		}
+	} else {
+		err = copyFile(w, fs, relativePath)
+	}
 	if errors.Is(err, syscall.EISDIR) {
 		// Ignore symlinks to directories.
-		return nil
+		return "", false, nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not copy %q to hash: %w", relativePath, err)
+		return "", false, err
 	}
 
 	h.Write([]byte(filepath.ToSlash(path.Clean(relativePath))))
 	h.Write([]byte(fileMode.String()))
 
+	if !digest {
+		return "", true, nil
+	}
+	return hex.EncodeToString(content.Sum(nil)[:contextFileDigestLen]), true, nil
+}
+
+// copyFile copies a file's contents to w.
+func copyFile(w io.Writer, fs fsutil.FS, relativePath string) error {
+	f, err := fs.Open(relativePath)
+	if err != nil {
+		return fmt.Errorf("could not open %q: %w", relativePath, err)
+	}
+	defer contract.IgnoreClose(f)
+
+	if _, err := io.Copy(w, f); err != nil {
+		return fmt.Errorf("could not copy %q to hash: %w", relativePath, err)
+	}
 	return nil
 }
 
@@ -203,6 +246,27 @@ func hashBuildContext(
 	contextPath, dockerfilePath string,
 	namedContexts map[string]string,
 ) (string, error) {
+	return hashBuildContextWith(contextPath, dockerfilePath, namedContexts, hashOptions{})
+}
+
+// hashOptions customize how hashBuildContextWith hashes a build context.
+type hashOptions struct {
+	// paths limits which files are hashed. All files are hashed if nil.
+	paths contextPaths
+	// manifest records each hashed file, if non-nil.
+	manifest contextManifest
+	// cacheDir persists file hashes between runs, if set.
+	cacheDir string
+}
+
+// hashBuildContextWith is like hashBuildContext but with additional options.
+// The options don't affect the resulting hash for the files which are hashed.
+func hashBuildContextWith(
+	contextPath, dockerfilePath string,
+	namedContexts map[string]string,
+	opts hashOptions,
+) (string, error) {
+	paths, manifest := opts.paths, opts.manifest
 	h := sha256.New()
 	fs := afero.NewOsFs()
 
@@ -217,19 +281,20 @@ func hashBuildContext(
 	}
 
 	if isLocalFile(fs, dockerfilePath) {
-		err := hashDockerfile(h, dockerfilePath)
+		err := hashDockerfile(h, dockerfilePath, manifest)
 		if err != nil {
 			return "", nil
 		}
 	}
 
-	if isLocalDir(fs, contextPath) {
+	if includes, ok := paths.includes(""); ok && isLocalDir(fs, contextPath) {
 		// Hash our context if it's on-disk.
-		fs, err := rootFS(contextPath, excludes)
+		fs, err := rootFS(contextPath, includes, excludes)
 		if err != nil {
 			return "", err
 		}
-		if _, err := hashPath(h, fs); err != nil {
+		cache := opts.cacheFile(contextPath, includes, excludes)
+		if err := hashPath(h, fs, contextPath, manifest, cache); err != nil {
 			return "", err
 		}
 	}
@@ -239,50 +304,26 @@ func hashBuildContext(
 	slices.Sort(keys)
 	for _, key := range keys {
 		namedContext := namedContexts[key]
-		if isLocalDir(fs, namedContext) {
-			fs, err := rootFS(namedContext, excludes)
+		if includes, ok := paths.includes(key); ok && isLocalDir(fs, namedContext) {
+			fs, err := rootFS(namedContext, includes, excludes)
 			if err != nil {
 				return "", err
 			}
-			if _, err := hashPath(h, fs); err != nil {
+			named := manifest.scope()
+			cache := opts.cacheFile(namedContext, includes, excludes)
+			if err := hashPath(h, fs, namedContext, named, cache); err != nil {
 				return "", err
 			}
+			manifest.merge(key, named)
 		}
 	}
 
 	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
-// hashPath hashes all paths within the provided FS.
-func hashPath(h hash.Hash, fs fsutil.FS) (string, error) {
-	err := fs.Walk(
-		context.Background(),
-		"/",
-		func(filePath string, dir gofs.DirEntry, err error) error {
-			if err != nil {
-				return err
-			}
-			if dir.IsDir() {
-				return nil
-			}
-			// fsutil.Walk makes filePath relative to the root, we join it back to get an absolute path to
-			// the file to hash.
-			fi, err := dir.Info()
-			if err != nil {
-				return err
-			}
-			return hashFile(h, fs, filePath, fi.Mode())
-		},
-	)
-	if err != nil {
-		return "", fmt.Errorf("unable to hash build context: %w", err)
-	}
-	// create a hash of the entire input of the hash accumulator
-	return hex.EncodeToString(h.Sum(nil)), nil
-}
-
-// hashDockerfile hashes the contents of a Dockerfile.
-func hashDockerfile(h hash.Hash, path string) error {
+// hashDockerfile hashes the contents of a Dockerfile and records it in the
+// manifest, if one is provided.
+func hashDockerfile(h hash.Hash, path string, manifest contextManifest) error {
 	// The Dockerfile might be capture by .dockerignore, so we explicitly hash
 	// its content (but not filename -- to match Docker) in order to detect
 	// changes in it.
@@ -294,6 +335,10 @@ func hashDockerfile(h hash.Hash, path string) error {
 	if err != nil {
 		return fmt.Errorf("error hashing dockerfile %q: %w", path, err)
 	}
+	if manifest != nil {
+		content := sha256.Sum256(df)
+		manifest[dockerfileEntry] = contextFile{digest: hex.EncodeToString(content[:contextFileDigestLen])}
+	}
 	return nil
 }
 
@@ -347,11 +392,14 @@ func isLocalFile(fs afero.Fs, path string) bool {
 }
 
 // rootFS returns a new fsutil.FS scoped to the given root and with the given
-// exclusions.
-func rootFS(root string, excludes []string) (fsutil.FS, error) {
+// inclusions and exclusions. Everything is included if includes is empty.
+func rootFS(root string, includes, excludes []string) (fsutil.FS, error) {
 	fs, err := fsutil.NewFS(root)
 	if err != nil {
 		return nil, err
 	}
-	return fsutil.NewFilterFS(fs, &fsutil.FilterOpt{ExcludePatterns: excludes})
+	return fsutil.NewFilterFS(fs, &fsutil.FilterOpt{
+		IncludePatterns: includes,
+		ExcludePatterns: excludes,
+	})
 }
@@ -0,0 +1,227 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/docker/buildx/util/urlutil"
+	"github.com/moby/buildkit/frontend/dockerfile/instructions"
+	"github.com/moby/buildkit/frontend/dockerfile/shell"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// ContextHashMode controls which files contribute to an image's context hash.
+type ContextHashMode string
+
+const (
+	// ContextHashAll hashes every file in the context which isn't ignored.
+	ContextHashAll ContextHashMode = "all"
+	// ContextHashReferenced hashes only the files the Dockerfile copies.
+	ContextHashReferenced ContextHashMode = "referenced"
+)
+
+// Values returns all valid ContextHashMode values for SDK generation.
+func (ContextHashMode) Values() []infer.EnumValue[ContextHashMode] {
+	return []infer.EnumValue[ContextHashMode]{
+		{
+			Value:       ContextHashAll,
+			Description: "Hash every file in the context which isn't excluded by \".dockerignore\".",
+		},
+		{
+			Value: ContextHashReferenced,
+			Description: "Hash only the files reachable from \"COPY\" and \"ADD\" sources, " +
+				"falling back to every file when they can't be determined statically.",
+		},
+	}
+}
+
+// contextPaths maps a context -- "" for the main context, otherwise the name
+// of a named context -- to the patterns of the files the Dockerfile reads
+// from it. A nil slice means every file, and contexts which aren't read from
+// are absent.
+type contextPaths map[string][]string
+
+// add records a source path read from the given context.
+func (cp contextPaths) add(context, src string) {
+	p := strings.TrimPrefix(path.Clean("/"+src), "/")
+	patterns, ok := cp[context]
+	switch {
+	case ok && patterns == nil:
+		// Already reading everything.
+	case p == "":
+		cp[context] = nil
+	case !slices.Contains(patterns, p):
+		cp[context] = append(patterns, p)
+	}
+}
+
+// includes returns the patterns to hash in the given context, and false if
+// nothing should be hashed. Everything is hashed if cp is nil.
+func (cp contextPaths) includes(context string) ([]string, bool) {
+	if cp == nil {
+		return nil, true
+	}
+	patterns, ok := cp[context]
+	return patterns, ok
+}
+
+// referencedPaths returns the context paths the Dockerfile's "COPY" and "ADD"
+// instructions read from, across all stages, plus the whole of any named
+// context a stage is based on. It returns false if they can't be determined
+// statically, for example because of a remote Dockerfile, an unset variable,
+// or a "RUN --mount=type=bind" of a context.
+func (ia *ImageArgs) referencedPaths() (contextPaths, bool) {
+	stages, metaArgs, err := ia.Dockerfile.parse()
+	if err != nil || len(stages) == 0 {
+		return nil, false
+	}
+
+	lex := shell.NewLex('\\')
+	global, err := ia.globalArgs(lex, metaArgs)
+	if err != nil {
+		return nil, false
+	}
+
+	// contextFor returns the context a "--from" flag refers to, and false if
+	// it isn't a context at all.
+	contextFor := func(from string) (string, bool) {
+		if from == "" {
+			return "", true
+		}
+		if _, ok := stageIndex(stages, from); ok {
+			return "", false
+		}
+		if _, err := strconv.Atoi(from); err == nil {
+			return "", false
+		}
+		if ia.Context != nil {
+			for _, name := range slices.Sorted(maps.Keys(ia.Context.Named)) {
+				if isReferenced(map[string]bool{name: true}, from) {
+					return name, true
+				}
+			}
+		}
+		return "", false
+	}
+
+	paths := contextPaths{}
+	for _, s := range stages {
+		env := maps.Clone(global)
+		expand := func(word string) (string, bool) {
+			res, err := lex.ProcessWordWithMatches(word, env)
+			return res.Result, err == nil && len(res.Unmatched) == 0
+		}
+
+		// A stage based on a named context reads all of it.
+		base, ok := expand(s.BaseName)
+		if !ok {
+			return nil, false
+		}
+		if base != "" {
+			if context, ok := contextFor(base); ok {
+				paths.add(context, "")
+			}
+		}
+
+		for _, cmd := range s.Commands {
+			switch c := cmd.(type) {
+			case *instructions.ArgCommand:
+				for _, kv := range c.Args {
+					v, ok := ia.BuildArgs[kv.Key]
+					switch {
+					case ok:
+					case kv.Value != nil:
+						if v, ok = expand(*kv.Value); !ok {
+							return nil, false
+						}
+					default:
+						// Inherit the global default, if any.
+						v = global[kv.Key]
+					}
+					env[kv.Key] = v
+				}
+			case *instructions.EnvCommand:
+				for _, kv := range c.Env {
+					v, ok := expand(kv.Value)
+					if !ok {
+						return nil, false
+					}
+					env[kv.Key] = v
+				}
+			case *instructions.CopyCommand:
+				from, ok := expand(c.From)
+				if !ok {
+					return nil, false
+				}
+				context, ok := contextFor(from)
+				if !ok {
+					continue
+				}
+				for _, src := range c.SourcePaths {
+					src, ok := expand(src)
+					if !ok {
+						return nil, false
+					}
+					paths.add(context, src)
+				}
+			case *instructions.AddCommand:
+				for _, src := range c.SourcePaths {
+					src, ok := expand(src)
+					if !ok {
+						return nil, false
+					}
+					if urlutil.IsRemoteURL(src) {
+						continue
+					}
+					paths.add("", src)
+				}
+			case *instructions.RunCommand:
+				for _, m := range runMounts(c) {
+					if m.Type != instructions.MountTypeBind {
+						continue
+					}
+					from, ok := expand(m.From)
+					if !ok {
+						return nil, false
+					}
+					if _, ok := contextFor(from); ok {
+						// Bind mounts can read anything from the context.
+						return nil, false
+					}
+				}
+			}
+		}
+	}
+	return paths, true
+}
+
+// contextPaths returns the paths to hash for the args' hash mode, or nil if
+// the whole context should be hashed.
+func (ia *ImageArgs) contextPaths() contextPaths {
+	if ia.Context == nil || ia.Context.HashMode == nil || *ia.Context.HashMode != ContextHashReferenced {
+		return nil
+	}
+	paths, ok := ia.referencedPaths()
+	if !ok {
+		return nil
+	}
+	return paths
+}
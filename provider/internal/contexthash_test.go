@@ -0,0 +1,177 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferencedPaths(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		dockerfile string
+		buildArgs  map[string]string
+		named      NamedContexts
+
+		want   contextPaths
+		wantOK bool
+	}{
+		{
+			name: "copy and add",
+			dockerfile: "FROM alpine\n" +
+				"COPY go.mod go.sum ./\n" +
+				"COPY ./cmd/ /src/cmd\n" +
+				"ADD https://example.com/file.tar.gz /tmp/\n" +
+				"ADD vendor.tar.gz /vendor\n",
+			want:   contextPaths{"": {"go.mod", "go.sum", "cmd", "vendor.tar.gz"}},
+			wantOK: true,
+		},
+		{
+			name:       "whole context",
+			dockerfile: "FROM alpine\nCOPY app.py /\nCOPY . /src\n",
+			want:       contextPaths{"": nil},
+			wantOK:     true,
+		},
+		{
+			name:       "nothing copied",
+			dockerfile: "FROM alpine\nRUN echo hi\n",
+			want:       contextPaths{},
+			wantOK:     true,
+		},
+		{
+			name: "stages and named contexts",
+			dockerfile: "FROM golang AS build\n" +
+				"COPY main.go .\n" +
+				"FROM alpine\n" +
+				"COPY --from=build /out /out\n" +
+				"COPY --from=0 /out /out\n" +
+				"COPY --from=busybox /bin/sh /sh\n" +
+				"COPY --from=assets img/ /img\n",
+			named: NamedContexts{"assets": {Location: "../assets"}},
+			want: contextPaths{
+				"":       {"main.go"},
+				"assets": {"img"},
+			},
+			wantOK: true,
+		},
+		{
+			name: "variables",
+			dockerfile: "ARG DIR=app\n" +
+				"FROM alpine\n" +
+				"ARG DIR\n" +
+				"ARG VERSION=1\n" +
+				"ENV CONFIG=config-${VERSION}.yaml\n" +
+				"COPY $DIR/ ${CONFIG} /src/\n",
+			buildArgs: map[string]string{"DIR": "web"},
+			want:      contextPaths{"": {"web", "config-1.yaml"}},
+			wantOK:    true,
+		},
+		{
+			name: "inherited global default",
+			dockerfile: "ARG APP=backend\n" +
+				"FROM golang\n" +
+				"ARG APP\n" +
+				"COPY ${APP}/main.go /src/\n",
+			want:   contextPaths{"": {"backend/main.go"}},
+			wantOK: true,
+		},
+		{
+			name: "named context as base",
+			dockerfile: "ARG BASE=app\n" +
+				"FROM ${BASE}\n" +
+				"COPY config.yaml /etc/\n",
+			named: NamedContexts{"app": {Location: "./app"}},
+			want: contextPaths{
+				"":    {"config.yaml"},
+				"app": nil,
+			},
+			wantOK: true,
+		},
+		{
+			name:       "unset variable",
+			dockerfile: "FROM alpine\nCOPY $SRC /src\n",
+		},
+		{
+			name:       "bind mount",
+			dockerfile: "FROM alpine\nRUN --mount=type=bind,target=/src make\n",
+		},
+		{
+			name:       "bind mount from stage",
+			dockerfile: "FROM alpine AS build\nFROM alpine\nRUN --mount=type=bind,from=build,target=/src make\n",
+			want:       contextPaths{},
+			wantOK:     true,
+		},
+		{
+			name:       "custom syntax",
+			dockerfile: "# syntax=example.com/frontend\nFROM alpine\nCOPY app /\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			args := ImageArgs{
+				BuildArgs:  tt.buildArgs,
+				Context:    &BuildContext{Named: tt.named},
+				Dockerfile: &Dockerfile{Inline: tt.dockerfile},
+			}
+			got, ok := args.referencedPaths()
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHashReferencedPaths(t *testing.T) {
+	t.Parallel()
+	referenced := ContextHashReferenced
+
+	dir := t.TempDir()
+	dockerfile := filepath.Join(dir, "Dockerfile")
+	require.NoError(t, os.WriteFile(dockerfile, []byte("FROM scratch\nCOPY src/ /src\n"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "src", "pkg"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "pkg", "main.go"), []byte("package main"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("hello"), 0o600))
+
+	args := ImageArgs{
+		Context:    &BuildContext{Context: Context{Location: dir}, HashMode: &referenced},
+		Dockerfile: &Dockerfile{Location: dockerfile},
+	}
+	hash := func() string {
+		h, err := hashBuildContextWith(dir, dockerfile, nil, hashOptions{paths: args.contextPaths()})
+		require.NoError(t, err)
+		return h
+	}
+
+	baseline := hash()
+	full, err := hashBuildContext(dir, dockerfile, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, full, baseline)
+
+	// Unreferenced files don't affect the hash.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("goodbye"), 0o600))
+	assert.Equal(t, baseline, hash())
+
+	// Referenced ones do.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "pkg", "main.go"), []byte("package pkg"), 0o600))
+	assert.NotEqual(t, baseline, hash())
+}
@@ -0,0 +1,159 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	gofs "io/fs"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxContextManifestFiles caps how many files are recorded in state.
+	// Larger contexts are hashed as usual, but changes aren't explained.
+	maxContextManifestFiles = 5000
+
+	// contextFileDigestLen is how many bytes of each file's SHA256 digest
+	// are kept. This only needs to detect changes, not resist collisions.
+	contextFileDigestLen = 8
+
+	// dockerfileEntry records the Dockerfile, which isn't necessarily part
+	// of the context.
+	dockerfileEntry = "(Dockerfile)"
+)
+
+// contextFile is a file's entry in a contextManifest.
+type contextFile struct {
+	mode   gofs.FileMode
+	digest string
+}
+
+// contextManifest records the mode and a truncated content digest of every
+// file contributing to a context hash, keyed by slash-separated path. Files
+// in named contexts are prefixed with "<name>:".
+type contextManifest map[string]contextFile
+
+// scope returns a manifest for a named context, or nil if m is nil.
+func (m contextManifest) scope() contextManifest {
+	if m == nil {
+		return nil
+	}
+	return contextManifest{}
+}
+
+// merge adds the files of a named context's manifest.
+func (m contextManifest) merge(name string, named contextManifest) {
+	for p, f := range named {
+		m[name+":"+p] = f
+	}
+}
+
+// encode serializes the manifest as gzipped, base64-encoded lines of
+// "<digest> <mode> <path>", sorted by path. An empty string is returned if
+// the manifest has too many files.
+func (m contextManifest) encode() (string, error) {
+	if len(m) == 0 || len(m) > maxContextManifestFiles {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	for _, p := range slices.Sorted(maps.Keys(m)) {
+		f := m[p]
+		if _, err := fmt.Fprintf(zw, "%s %o %s\n", f.digest, uint32(f.mode), p); err != nil {
+			return "", err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// decodeContextManifest parses a manifest serialized by encode.
+func decodeContextManifest(s string) (contextManifest, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	m := contextManifest{}
+	scanner := bufio.NewScanner(io.LimitReader(zr, 64<<20))
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), " ", 3)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("malformed manifest entry %q", scanner.Text())
+		}
+		mode, err := strconv.ParseUint(fields[1], 8, 32)
+		if err != nil {
+			return nil, err
+		}
+		m[fields[2]] = contextFile{mode: gofs.FileMode(mode), digest: fields[0]}
+	}
+	return m, scanner.Err()
+}
+
+// explain summarizes how the files in the newer manifest differ from m, like
+// "app/main.go modified, 2 files added".
+func (m contextManifest) explain(newer contextManifest) string {
+	var added, removed, modified, chmodded []string
+	for _, p := range slices.Sorted(maps.Keys(newer)) {
+		old, ok := m[p]
+		switch {
+		case !ok:
+			added = append(added, p)
+		case old.digest != newer[p].digest:
+			modified = append(modified, p)
+		case old.mode != newer[p].mode:
+			chmodded = append(chmodded, p)
+		}
+	}
+	for _, p := range slices.Sorted(maps.Keys(m)) {
+		if _, ok := newer[p]; !ok {
+			removed = append(removed, p)
+		}
+	}
+
+	changes := []string{}
+	describe := func(paths []string, what string) {
+		switch len(paths) {
+		case 0:
+		case 1:
+			name := paths[0]
+			if name == dockerfileEntry {
+				name = "Dockerfile"
+			}
+			changes = append(changes, name+" "+what)
+		default:
+			changes = append(changes, fmt.Sprintf("%d files %s", len(paths), what))
+		}
+	}
+	describe(modified, "modified")
+	describe(added, "added")
+	describe(removed, "removed")
+	describe(chmodded, "changed mode")
+	return strings.Join(changes, ", ")
+}
@@ -0,0 +1,122 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextManifest(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	named := t.TempDir()
+	dockerfile := filepath.Join(dir, "Dockerfile")
+	require.NoError(t, os.WriteFile(dockerfile, []byte("FROM scratch\n"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "app"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app", "main.go"), []byte("package main"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(named, "logo.png"), []byte("png"), 0o600))
+
+	manifest := contextManifest{}
+	hash, err := hashBuildContextWith(dir, dockerfile, map[string]string{"assets": named}, hashOptions{manifest: manifest})
+	require.NoError(t, err)
+
+	// Recording a manifest doesn't affect the hash.
+	want, err := hashBuildContext(dir, dockerfile, map[string]string{"assets": named})
+	require.NoError(t, err)
+	assert.Equal(t, want, hash)
+
+	assert.ElementsMatch(t,
+		[]string{dockerfileEntry, "Dockerfile", "app/main.go", "assets:logo.png"},
+		slices.Collect(maps.Keys(manifest)),
+	)
+
+	encoded, err := manifest.encode()
+	require.NoError(t, err)
+	decoded, err := decodeContextManifest(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, decoded)
+}
+
+func TestContextManifestEncodeCap(t *testing.T) {
+	t.Parallel()
+	m := contextManifest{}
+	for i := range maxContextManifestFiles + 1 {
+		m[fmt.Sprintf("file%d", i)] = contextFile{mode: 0o644, digest: "0011223344556677"}
+	}
+	encoded, err := m.encode()
+	require.NoError(t, err)
+	assert.Empty(t, encoded)
+}
+
+func TestContextManifestExplain(t *testing.T) {
+	t.Parallel()
+	old := contextManifest{
+		dockerfileEntry: {digest: "00"},
+		"app/main.go":   {mode: 0o644, digest: "11"},
+		"app/util.go":   {mode: 0o644, digest: "22"},
+		"run.sh":        {mode: 0o644, digest: "33"},
+		"README.md":     {mode: 0o644, digest: "44"},
+	}
+
+	tests := []struct {
+		name  string
+		newer contextManifest
+		want  string
+	}{
+		{
+			name:  "unchanged",
+			newer: old,
+			want:  "",
+		},
+		{
+			name: "modified and added",
+			newer: contextManifest{
+				dockerfileEntry: {digest: "00"},
+				"app/main.go":   {mode: 0o644, digest: "12"},
+				"app/util.go":   {mode: 0o644, digest: "22"},
+				"app/new.go":    {mode: 0o644, digest: "55"},
+				"app/other.go":  {mode: 0o644, digest: "66"},
+				"run.sh":        {mode: 0o644, digest: "33"},
+				"README.md":     {mode: 0o644, digest: "44"},
+			},
+			want: "app/main.go modified, 2 files added",
+		},
+		{
+			name: "removed and mode",
+			newer: contextManifest{
+				dockerfileEntry: {digest: "01"},
+				"app/main.go":   {mode: 0o644, digest: "11"},
+				"run.sh":        {mode: 0o755, digest: "33"},
+			},
+			want: "Dockerfile modified, 2 files removed, run.sh changed mode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, old.explain(tt.newer))
+		})
+	}
+}
@@ -75,14 +75,14 @@ func (d *Dockerfile) validate(preview bool, c *Context) error {
 		if err != nil {
 			return newCheckFailure(err, "dockerfile.location")
 		}
-		if err := parseDockerfile(f); err != nil {
+		if _, _, err := parseDockerfile(f); err != nil {
 			return newCheckFailure(err, "dockerfile.location")
 		}
 		return nil
 	}
 
 	if d.Inline != "" {
-		err := parseDockerfile(strings.NewReader(d.Inline))
+		_, _, err := parseDockerfile(strings.NewReader(d.Inline))
 		if err != nil {
 			return newCheckFailure(err, "dockerfile.inline")
 		}
@@ -96,27 +96,68 @@ func (d *Dockerfile) validate(preview bool, c *Context) error {
 	return nil
 }
 
-func parseDockerfile(r io.Reader) error {
-	df, _ := io.ReadAll(r)
+// contents returns the contents of a local or inline Dockerfile. Nothing is
+// returned for remote Dockerfiles or those using a custom syntax.
+func (d *Dockerfile) contents() ([]byte, error) {
+	if d == nil {
+		return nil, nil
+	}
+	var df []byte
+	switch {
+	case d.Inline != "":
+		df = []byte(d.Inline)
+	case d.Location == "" || urlutil.IsRemoteURL(d.Location):
+		return nil, nil
+	default:
+		var err error
+		df, err = os.ReadFile(filepath.Clean(d.Location))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if customSyntax(df) {
+		return nil, nil
+	}
+	return df, nil
+}
+
+// parse returns the stages and global ARGs of a local or inline Dockerfile.
+// Nothing is returned for remote Dockerfiles or those using a custom syntax.
+func (d *Dockerfile) parse() ([]instructions.Stage, []instructions.ArgCommand, error) {
+	df, err := d.contents()
+	if err != nil || df == nil {
+		return nil, nil, err
+	}
+	return parseDockerfile(bytes.NewReader(df))
+}
+
+// customSyntax returns true if the Dockerfile is handled by a frontend other
+// than the default one.
+func customSyntax(df []byte) bool {
 	syntax, _, _, _ := parser.DetectSyntax(df)
 	if syntax == "" {
 		syntax = os.Getenv("BUILDKIT_SYNTAX")
 	}
+	return syntax != "" && syntax != "docker/dockerfile:1"
+}
+
+func parseDockerfile(r io.Reader) ([]instructions.Stage, []instructions.ArgCommand, error) {
+	df, _ := io.ReadAll(r)
 
 	// Disable validation if this uses a custom syntax.
-	if syntax != "" && syntax != "docker/dockerfile:1" {
-		return nil
+	if customSyntax(df) {
+		return nil, nil, nil
 	}
 
 	parsed, err := parser.Parse(bytes.NewReader(df))
 	if err != nil {
-		return newCheckFailure(err, "dockerfile")
+		return nil, nil, newCheckFailure(err, "dockerfile")
 	}
 
-	_, _, err = instructions.Parse(parsed.AST, nil)
+	stages, metaArgs, err := instructions.Parse(parsed.AST, nil)
 	if err != nil {
-		return newCheckFailure(err, "dockerfile")
+		return nil, nil, newCheckFailure(err, "dockerfile")
 	}
 
-	return nil
+	return stages, metaArgs, nil
 }
@@ -0,0 +1,353 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/instructions"
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+	"github.com/moby/buildkit/frontend/dockerfile/shell"
+)
+
+// builtinArgs are ARGs BuildKit sets automatically. They never need to be
+// provided and are never reported as unused.
+var builtinArgs = []string{
+	"BUILDPLATFORM", "BUILDOS", "BUILDARCH", "BUILDVARIANT",
+	"TARGETPLATFORM", "TARGETOS", "TARGETARCH", "TARGETVARIANT", "TARGETSTAGE",
+	"HTTP_PROXY", "HTTPS_PROXY", "FTP_PROXY", "NO_PROXY", "ALL_PROXY",
+	"SOURCE_DATE_EPOCH",
+}
+
+func isBuiltinArg(key string) bool {
+	return strings.HasPrefix(key, "BUILDKIT_") ||
+		slices.Contains(builtinArgs, strings.ToUpper(key))
+}
+
+// crossCheck compares the Dockerfile's instructions against the image's
+// inputs. It returns check failures for mistakes which would fail or silently
+// break the build, and warnings for inputs the Dockerfile never uses.
+//
+// Dockerfiles which can't be inspected (remote or using a custom syntax) are
+// not checked.
+func (ia *ImageArgs) crossCheck() ([]string, error) {
+	stages, metaArgs, err := ia.Dockerfile.parse()
+	if err != nil || len(stages) == 0 {
+		// Parse errors are already reported by validate.
+		return nil, nil
+	}
+
+	var failures []error
+	var warnings []string
+
+	target := len(stages) - 1
+	if ia.Target != "" {
+		idx, ok := stageIndex(stages, ia.Target)
+		if !ok {
+			names := []string{}
+			for _, s := range stages {
+				if s.Name != "" {
+					names = append(names, s.Name)
+				}
+			}
+			failures = append(failures, newCheckFailure(
+				fmt.Errorf("stage %q not found in the Dockerfile; available stages are %q", ia.Target, names),
+				"target",
+			))
+		}
+		target = idx
+	}
+
+	// ARGs without a value expand to an empty string, which is only a
+	// problem if a stage we build needs them to name its base image.
+	lex := shell.NewLex('\\')
+	env, err := ia.globalArgs(lex, metaArgs)
+	if err != nil {
+		return nil, nil
+	}
+	deps := stageDependencies(stages, target)
+
+	declared := map[string]bool{}
+	defaults := map[string]bool{}
+	for _, a := range metaArgs {
+		for _, kv := range a.Args {
+			declared[kv.Key] = true
+			if kv.Value != nil {
+				defaults[kv.Key] = true
+				continue
+			}
+			if _, ok := ia.BuildArgs[kv.Key]; ok || isBuiltinArg(kv.Key) {
+				continue
+			}
+			if from, ok := ia.needsArg(stages, deps, lex, env, kv.Key); ok {
+				failures = append(failures, newCheckFailure(
+					fmt.Errorf("ARG %q%s has no default and isn't set, but FROM%s needs it", kv.Key, line(&a), from),
+					"buildArgs",
+				))
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf(
+				"ARG %q%s has no default and isn't set, so it will be empty", kv.Key, line(&a),
+			))
+		}
+	}
+
+	for _, idx := range deps {
+		for _, cmd := range stages[idx].Commands {
+			switch c := cmd.(type) {
+			case *instructions.ArgCommand:
+				for _, kv := range c.Args {
+					if kv.Value != nil || defaults[kv.Key] || isBuiltinArg(kv.Key) {
+						continue
+					}
+					if _, ok := ia.BuildArgs[kv.Key]; !ok {
+						warnings = append(warnings, fmt.Sprintf(
+							"ARG %q%s has no default and isn't set, so it will be empty", kv.Key, line(c),
+						))
+					}
+				}
+			case *instructions.RunCommand:
+				for _, m := range runMounts(c) {
+					switch m.Type {
+					case instructions.MountTypeSecret:
+						id := secretMountID(m)
+						if _, ok := ia.Secrets[id]; ok || strings.Contains(id, "$") {
+							continue
+						}
+						if m.Required {
+							failures = append(failures, newCheckFailure(
+								fmt.Errorf("secret %q is required%s but not provided", id, line(c)),
+								"secrets",
+							))
+						} else {
+							warnings = append(warnings, fmt.Sprintf(
+								"secret %q is mounted%s but not provided, so it won't be available", id, line(c),
+							))
+						}
+					case instructions.MountTypeSSH:
+						id := m.CacheID
+						if id == "" {
+							id = "default"
+						}
+						if slices.ContainsFunc(ia.SSH, func(s SSH) bool { return s.ID == id }) ||
+							strings.Contains(id, "$") {
+							continue
+						}
+						if m.Required {
+							failures = append(failures, newCheckFailure(
+								fmt.Errorf("SSH key %q is required%s but not provided", id, line(c)),
+								"ssh",
+							))
+						} else {
+							warnings = append(warnings, fmt.Sprintf(
+								"SSH key %q is mounted%s but not provided, so it won't be available", id, line(c),
+							))
+						}
+					}
+				}
+			}
+		}
+	}
+
+	referenced := map[string]bool{}
+	for _, s := range stages {
+		referenced[s.BaseName] = true
+		for _, cmd := range s.Commands {
+			switch c := cmd.(type) {
+			case *instructions.ArgCommand:
+				for _, kv := range c.Args {
+					declared[kv.Key] = true
+				}
+			case *instructions.CopyCommand:
+				referenced[c.From] = true
+			case *instructions.RunCommand:
+				for _, m := range runMounts(c) {
+					referenced[m.From] = true
+				}
+			}
+		}
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(ia.BuildArgs)) {
+		if !declared[k] && !isBuiltinArg(k) {
+			warnings = append(warnings, fmt.Sprintf(
+				"buildArgs[%q] isn't declared by any ARG in the Dockerfile and will be ignored", k,
+			))
+		}
+	}
+
+	if ia.Context != nil {
+		for _, k := range slices.Sorted(maps.Keys(ia.Context.Named)) {
+			if !isReferenced(referenced, k) {
+				warnings = append(warnings, fmt.Sprintf(
+					"context.named[%q] isn't referenced by any FROM, COPY --from or RUN --mount in the Dockerfile", k,
+				))
+			}
+		}
+	}
+
+	return warnings, errors.Join(failures...)
+}
+
+// needsArg returns the location of the first FROM, among the stages we build,
+// whose base image is invalid when the given global ARG is empty.
+func (ia *ImageArgs) needsArg(
+	stages []instructions.Stage,
+	deps []int,
+	lex *shell.Lex,
+	env argEnv,
+	key string,
+) (string, bool) {
+	named := map[string]bool{}
+	if ia.Context != nil {
+		for name := range ia.Context.Named {
+			named[name] = true
+		}
+	}
+	for _, idx := range deps {
+		s := stages[idx]
+		res, err := lex.ProcessWordWithMatches(s.BaseName, env)
+		if err != nil {
+			continue
+		}
+		if _, ok := res.Unmatched[key]; !ok {
+			continue
+		}
+		name := res.Result
+		if name != "" {
+			if _, ok := stageIndex(stages, name); ok || strings.EqualFold(name, "scratch") || isReferenced(named, name) {
+				continue
+			}
+			if _, err := normalizeReference(name); err == nil {
+				continue
+			}
+		}
+		loc := ""
+		if len(s.Location) > 0 {
+			loc = fmt.Sprintf(" on line %d", s.Location[0].Start.Line)
+		}
+		return loc, true
+	}
+	return "", false
+}
+
+// stageIndex returns the index of the stage with the given name.
+func stageIndex(stages []instructions.Stage, name string) (int, bool) {
+	for idx, s := range stages {
+		if strings.EqualFold(s.Name, name) {
+			return idx, true
+		}
+	}
+	return -1, false
+}
+
+// stageDependencies returns the indices of the target stage and every stage
+// it (transitively) depends on -- that is, the stages BuildKit will build.
+func stageDependencies(stages []instructions.Stage, target int) []int {
+	if target < 0 {
+		return nil
+	}
+	seen := map[int]bool{}
+	var visit func(ref string)
+	visit = func(ref string) {
+		idx, ok := stageIndex(stages, ref)
+		if !ok {
+			n, err := strconv.Atoi(ref)
+			if err != nil || n < 0 || n >= len(stages) {
+				return
+			}
+			idx = n
+		}
+		if seen[idx] {
+			return
+		}
+		seen[idx] = true
+		s := stages[idx]
+		visit(s.BaseName)
+		for _, cmd := range s.Commands {
+			switch c := cmd.(type) {
+			case *instructions.CopyCommand:
+				visit(c.From)
+			case *instructions.RunCommand:
+				for _, m := range runMounts(c) {
+					visit(m.From)
+				}
+			}
+		}
+	}
+	visit(strconv.Itoa(target))
+
+	deps := []int{}
+	for idx := range stages {
+		if seen[idx] {
+			deps = append(deps, idx)
+		}
+	}
+	return deps
+}
+
+// runMounts returns the "--mount" flags of a RUN instruction. Variables are
+// left unexpanded since we don't know their values.
+func runMounts(c *instructions.RunCommand) []*instructions.Mount {
+	if err := c.Expand(func(word string) (string, error) { return word, nil }); err != nil {
+		return nil
+	}
+	return instructions.GetMounts(c)
+}
+
+// secretMountID returns the ID of the secret a mount consumes, which defaults
+// to the basename of its target.
+func secretMountID(m *instructions.Mount) string {
+	if m.CacheID != "" {
+		return m.CacheID
+	}
+	if m.Source != "" {
+		return m.Source
+	}
+	return path.Base(m.Target)
+}
+
+// isReferenced returns true if a named context is used by the Dockerfile,
+// either verbatim or as a normalized image reference.
+func isReferenced(referenced map[string]bool, name string) bool {
+	if referenced[name] {
+		return true
+	}
+	named, err := normalizeReference(name)
+	if err != nil {
+		return false
+	}
+	for ref := range referenced {
+		if n, err := normalizeReference(ref); err == nil && n.String() == named.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// line formats the Dockerfile line of an instruction for use in messages.
+func line(cmd interface{ Location() []parser.Range }) string {
+	loc := cmd.Location()
+	if len(loc) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" on line %d", loc[0].Start.Line)
+}
@@ -0,0 +1,149 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrossCheck(t *testing.T) {
+	t.Parallel()
+
+	dockerfile := `
+ARG BASE=alpine
+ARG REQUIRED
+ARG TAG
+FROM ${BASE}:${TAG} AS deps
+ARG VERSION
+RUN --mount=type=secret,id=token,required=true --mount=type=ssh echo $VERSION
+
+FROM alpine AS unused
+RUN --mount=type=secret,id=other,required true
+
+FROM deps AS final
+ARG BASE
+ARG TARGETARCH
+COPY --from=extra / /
+RUN --mount=type=secret,target=/run/secrets/npmrc true
+`
+
+	tests := []struct {
+		name         string
+		args         ImageArgs
+		wantFailures []string
+		wantWarnings []string
+	}{
+		{
+			name: "satisfied",
+			args: ImageArgs{
+				BuildArgs: map[string]string{"REQUIRED": "1", "TAG": "3", "VERSION": "2"},
+				Secrets:   map[string]string{"token": "t", "npmrc": "n"},
+				SSH:       []SSH{{ID: "default"}},
+				Context: &BuildContext{Named: NamedContexts{
+					"extra":              {Location: "."},
+					"docker.io/alpine:3": {Location: "docker-image://alpine:3.20"},
+				}},
+			},
+			wantWarnings: []string{
+				`context.named["docker.io/alpine:3"] isn't referenced by any FROM, COPY --from or RUN --mount in the Dockerfile`,
+			},
+		},
+		{
+			name: "missing inputs",
+			args: ImageArgs{
+				BuildArgs: map[string]string{"UNDECLARED": "x", "BUILDKIT_INLINE_CACHE": "1"},
+				Context: &BuildContext{Named: NamedContexts{
+					"docker.io/library/alpine:latest": {Location: "docker-image://alpine:3.20"},
+				}},
+			},
+			wantFailures: []string{
+				`buildArgs: ARG "TAG" on line 4 has no default and isn't set, but FROM on line 5 needs it`,
+				`secrets: secret "token" is required on line 7 but not provided`,
+			},
+			wantWarnings: []string{
+				`ARG "REQUIRED" on line 3 has no default and isn't set, so it will be empty`,
+				`ARG "VERSION" on line 6 has no default and isn't set, so it will be empty`,
+				`SSH key "default" is mounted on line 7 but not provided, so it won't be available`,
+				`secret "npmrc" is mounted on line 16 but not provided, so it won't be available`,
+				`buildArgs["UNDECLARED"] isn't declared by any ARG in the Dockerfile and will be ignored`,
+			},
+		},
+		{
+			name: "target",
+			args: ImageArgs{
+				Target:    "unused",
+				BuildArgs: map[string]string{"REQUIRED": "1"},
+			},
+			wantFailures: []string{
+				`secrets: secret "other" is required on line 10 but not provided`,
+			},
+			wantWarnings: []string{
+				`ARG "TAG" on line 4 has no default and isn't set, so it will be empty`,
+			},
+		},
+		{
+			name: "unknown target",
+			args: ImageArgs{
+				Target:    "prod",
+				BuildArgs: map[string]string{"REQUIRED": "1", "TAG": "3"},
+			},
+			wantFailures: []string{
+				`target: stage "prod" not found in the Dockerfile; available stages are ["deps" "unused" "final"]`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.args.Dockerfile = &Dockerfile{Inline: dockerfile}
+
+			warnings, err := tt.args.crossCheck()
+
+			var failures []string
+			if err != nil {
+				for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+					cf := e.(checkFailure)
+					failures = append(failures, cf.Property+": "+cf.Reason)
+				}
+			}
+			assert.Equal(t, tt.wantFailures, failures)
+			assert.Equal(t, tt.wantWarnings, warnings)
+		})
+	}
+
+	t.Run("unset ARGs in FROM", func(t *testing.T) {
+		t.Parallel()
+		ia := ImageArgs{
+			Dockerfile: &Dockerfile{Inline: "ARG SUFFIX\nARG IMAGE\nFROM alpine${SUFFIX}\nFROM ${IMAGE}\n"},
+		}
+		warnings, err := ia.crossCheck()
+		assert.ErrorContains(t, err, `ARG "IMAGE" on line 2 has no default and isn't set, but FROM on line 4 needs it`)
+		assert.Equal(t, []string{`ARG "SUFFIX" on line 1 has no default and isn't set, so it will be empty`}, warnings)
+	})
+
+	t.Run("custom syntax", func(t *testing.T) {
+		t.Parallel()
+		ia := ImageArgs{
+			Target:     "missing",
+			Dockerfile: &Dockerfile{Inline: "# syntax=example.com/custom\nFROM alpine\n"},
+		}
+		warnings, err := ia.crossCheck()
+		assert.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+}
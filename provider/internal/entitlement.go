@@ -0,0 +1,62 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"github.com/docker/buildx/util/buildflags"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+var _ = (infer.Enum[Entitlement])((*Entitlement)(nil))
+
+// Entitlement is an extra privilege granted to a build.
+type Entitlement string
+
+const (
+	// EntitlementNetworkHost allows builds to use host networking.
+	EntitlementNetworkHost Entitlement = "network.host"
+	// EntitlementSecurityInsecure allows builds to run privileged containers.
+	EntitlementSecurityInsecure Entitlement = "security.insecure"
+)
+
+// Values returns all valid Entitlement values for SDK generation.
+func (Entitlement) Values() []infer.EnumValue[Entitlement] {
+	return []infer.EnumValue[Entitlement]{
+		{
+			Value:       EntitlementNetworkHost,
+			Description: `Allow "RUN --network=host" and the "host" network mode.`,
+		},
+		{
+			Value:       EntitlementSecurityInsecure,
+			Description: `Allow "RUN --security=insecure" to run privileged containers.`,
+		},
+	}
+}
+
+func (e Entitlement) String() string {
+	return string(e)
+}
+
+func (e Entitlement) validate(preview bool) (string, error) {
+	if preview && e == "" {
+		// Unknown entitlement during preview -- nothing to do.
+		return "", nil
+	}
+	if _, _, err := buildflags.ParseEntitlements([]string{string(e)}); err != nil {
+		return "", err
+	}
+	return string(e), nil
+}
@@ -0,0 +1,410 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	gofs "io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tonistiigi/fsutil"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+const (
+	// maxPrefetchSize is the largest file read ahead of the hasher. Larger
+	// files are streamed.
+	maxPrefetchSize = 1 << 20
+
+	// checkpointBytes and checkpointFiles control how often the hash's state
+	// is cached. Resuming from a checkpoint re-reads at most this much.
+	checkpointBytes = 4 << 20
+	checkpointFiles = 256
+
+	// racyWindow is how recently a file can have been modified and still
+	// be cached. A file modified again within its mtime's granularity would
+	// otherwise look unchanged.
+	racyWindow = 2 * time.Second
+
+	hashCacheVersion = 1
+)
+
+// contextHashCacheDir returns the provider's context hash cache directory, if
+// any.
+func (i *Image) contextHashCacheDir() string {
+	if i.config == nil {
+		return ""
+	}
+	return i.config.ContextHashCacheDir
+}
+
+// hashedFile is a file visited while hashing a context.
+type hashedFile struct {
+	Path    string        `json:"path"`
+	Mode    gofs.FileMode `json:"mode"`
+	Size    int64         `json:"size"`
+	ModTime int64         `json:"modTime,omitempty"`
+	Inode   uint64        `json:"inode,omitempty"`
+
+	// Digest is a truncated digest of the file's contents, or empty if the
+	// file wasn't hashed.
+	Digest string `json:"digest,omitempty"`
+	// State is the context hash's state after this file, if checkpointed.
+	State []byte `json:"state,omitempty"`
+}
+
+// unchanged returns true if the file looks the same as a cached one.
+func (f hashedFile) unchanged(cached hashedFile) bool {
+	return f.ModTime != 0 &&
+		f.Path == cached.Path &&
+		f.Mode == cached.Mode &&
+		f.Size == cached.Size &&
+		f.ModTime == cached.ModTime &&
+		f.Inode == cached.Inode
+}
+
+// hashCache records the files of a context and periodic checkpoints of the
+// context hash's state, so unchanged files needn't be read again.
+//
+// The context hash is a single stream over every file, so a cached
+// checkpoint is only valid if the hash started from the same state and every
+// file before it is unchanged. Hashing resumes from the last checkpoint
+// before the first changed file.
+type hashCache struct {
+	Version int          `json:"version"`
+	Start   []byte       `json:"start"`
+	Files   []hashedFile `json:"files"`
+}
+
+// cacheFile returns where the hash cache for a context is stored, or an
+// empty string if caching is disabled.
+func (o hashOptions) cacheFile(root string, includes, excludes []string) string {
+	if o.cacheDir == "" {
+		return ""
+	}
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return ""
+	}
+	key := sha256.Sum256([]byte(strings.Join([]string{
+		abs,
+		strings.Join(includes, "\n"),
+		strings.Join(excludes, "\n"),
+	}, "\x00")))
+	return filepath.Join(o.cacheDir, hex.EncodeToString(key[:16])+".json")
+}
+
+// loadHashCache reads a hash cache, returning nil if it's missing or
+// unreadable.
+func loadHashCache(path string) *hashCache {
+	if path == "" {
+		return nil
+	}
+	b, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil
+	}
+	var c hashCache
+	if err := json.Unmarshal(b, &c); err != nil || c.Version != hashCacheVersion {
+		return nil
+	}
+	return &c
+}
+
+// save writes the cache atomically, so concurrent hashes of the same context
+// don't observe partial writes.
+func (c *hashCache) save(path string) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+	return err
+}
+
+// hashPath hashes all paths within the provided FS, in walk order. Files are
+// read in parallel ahead of the hash. Each hashed file is recorded in the
+// manifest, if one is provided.
+//
+// If cacheFile is set, hashing resumes from the cached state of any unchanged
+// files and the cache is updated afterwards. Failing to update the cache
+// isn't an error.
+func hashPath(
+	h hash.Hash,
+	fs fsutil.FS,
+	root string,
+	manifest contextManifest,
+	cacheFile string,
+) error {
+	marshaler, canCache := h.(interface {
+		encoding.BinaryMarshaler
+		encoding.BinaryUnmarshaler
+	})
+	if !canCache {
+		cacheFile = ""
+	}
+
+	files, err := walkFiles(fs, root, cacheFile != "")
+	if err != nil {
+		return fmt.Errorf("unable to hash build context: %w", err)
+	}
+
+	var start []byte
+	resume := 0
+	if cacheFile != "" {
+		if start, err = marshaler.MarshalBinary(); err != nil {
+			return err
+		}
+		if cached := loadHashCache(cacheFile); cached != nil && bytes.Equal(cached.Start, start) {
+			if resume, err = cached.restore(files, marshaler); err != nil {
+				// Start over from scratch if the checkpoint is unusable.
+				resume = 0
+				if err := marshaler.UnmarshalBinary(start); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	digest := manifest != nil || cacheFile != ""
+	if err := hashFiles(h, fs, files, resume, digest, marshaler, cacheFile != ""); err != nil {
+		return fmt.Errorf("unable to hash build context: %w", err)
+	}
+
+	if manifest != nil {
+		for _, f := range files {
+			if f.Digest != "" {
+				manifest[filepath.ToSlash(filepath.Clean(f.Path))] = contextFile{mode: f.Mode, digest: f.Digest}
+			}
+		}
+	}
+
+	if cacheFile != "" {
+		_ = (&hashCache{Version: hashCacheVersion, Start: start, Files: files}).save(cacheFile)
+	}
+	return nil
+}
+
+// restore copies digests and checkpoints for the unchanged files leading up
+// to the last usable checkpoint, and restores the hash to it. It returns the
+// index of the first file which still needs to be hashed.
+func (c *hashCache) restore(files []hashedFile, h encoding.BinaryUnmarshaler) (int, error) {
+	matched := 0
+	for matched < len(files) && matched < len(c.Files) && files[matched].unchanged(c.Files[matched]) {
+		matched++
+	}
+	for i := matched - 1; i >= 0; i-- {
+		if c.Files[i].State == nil {
+			continue
+		}
+		if err := h.UnmarshalBinary(c.Files[i].State); err != nil {
+			return 0, err
+		}
+		for j := 0; j <= i; j++ {
+			files[j].Digest = c.Files[j].Digest
+			files[j].State = c.Files[j].State
+		}
+		return i + 1, nil
+	}
+	return 0, nil
+}
+
+// walkFiles lists the files in fs in walk order. If stat is true, files are
+// also stat'd (following symlinks) to detect changes.
+func walkFiles(fs fsutil.FS, root string, stat bool) ([]hashedFile, error) {
+	now := time.Now()
+	files := []hashedFile{}
+	err := fs.Walk(
+		context.Background(),
+		"/",
+		func(filePath string, dir gofs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if dir.IsDir() {
+				return nil
+			}
+			fi, err := dir.Info()
+			if err != nil {
+				return err
+			}
+			f := hashedFile{Path: filePath, Mode: fi.Mode(), Size: fi.Size()}
+			if stat {
+				if target, err := os.Stat(filepath.Join(root, filePath)); err == nil {
+					f.Size = target.Size()
+					f.Inode = fileInode(target)
+					if now.Sub(target.ModTime()) > racyWindow {
+						f.ModTime = target.ModTime().UnixNano()
+					}
+				}
+			}
+			files = append(files, f)
+			return nil
+		},
+	)
+	return files, err
+}
+
+// fileContents is a prefetched file.
+type fileContents struct {
+	data []byte
+	err  error
+}
+
+// hashFiles hashes files in order, starting at resume. Small regular files
+// are read in parallel ahead of the hash. Each file's digest is recorded if
+// digest is true, and if checkpoint is true the hash's state is periodically
+// recorded too.
+func hashFiles(
+	h hash.Hash,
+	fs fsutil.FS,
+	files []hashedFile,
+	resume int,
+	digest bool,
+	marshaler encoding.BinaryMarshaler,
+	checkpoint bool,
+) error {
+	done := make(chan struct{})
+	defer close(done)
+	results, release := prefetch(fs, files[resume:], done)
+
+	var bytesSince int64
+	filesSince := 0
+	for i := resume; i < len(files); i++ {
+		f := &files[i]
+		var prefetched *fileContents
+		if r := results[i-resume]; r != nil {
+			contents := <-r
+			release()
+			prefetched = &contents
+		}
+		dgst, hashed, err := hashFile(h, fs, f.Path, f.Mode, prefetched, digest)
+		if err != nil {
+			return err
+		}
+		if hashed {
+			f.Digest = dgst
+			bytesSince += f.Size
+			filesSince++
+		}
+		if !checkpoint {
+			continue
+		}
+		if bytesSince >= checkpointBytes || filesSince >= checkpointFiles || i == len(files)-1 {
+			state, err := marshaler.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			f.State = state
+			bytesSince, filesSince = 0, 0
+		}
+	}
+	return nil
+}
+
+// prefetch reads small regular files in parallel. The returned channels yield
+// each file's contents; files with a nil channel should be read by the caller.
+// release must be called after each prefetched file is received, and closing
+// done abandons any outstanding reads.
+func prefetch(
+	fs fsutil.FS,
+	files []hashedFile,
+	done <-chan struct{},
+) (results []chan fileContents, release func()) {
+	workers := max(4, runtime.GOMAXPROCS(0))
+	window := make(chan struct{}, 4*workers) // Bounds unconsumed files.
+	sem := make(chan struct{}, workers)      // Bounds concurrent reads.
+
+	results = make([]chan fileContents, len(files))
+	for i, f := range files {
+		if f.Mode.IsRegular() && f.Size <= maxPrefetchSize {
+			results[i] = make(chan fileContents, 1)
+		}
+	}
+
+	var wg sync.WaitGroup
+	go func() {
+		for i, r := range results {
+			if r == nil {
+				continue
+			}
+			select {
+			case window <- struct{}{}:
+			case <-done:
+				return
+			}
+			select {
+			case sem <- struct{}{}:
+			case <-done:
+				return
+			}
+			wg.Add(1)
+			go func(p string) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				data, err := readFile(fs, p)
+				r <- fileContents{data: data, err: err}
+			}(files[i].Path)
+		}
+		wg.Wait()
+	}()
+
+	return results, func() { <-window }
+}
+
+// readFile reads a file's contents.
+func readFile(fs fsutil.FS, relativePath string) ([]byte, error) {
+	f, err := fs.Open(relativePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not open %q: %w", relativePath, err)
+	}
+	defer contract.IgnoreClose(f)
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("could not copy %q to hash: %w", relativePath, err)
+	}
+	return data, nil
+}
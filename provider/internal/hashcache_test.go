@@ -0,0 +1,112 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeContext writes n files to a new context, backdated so they're
+// eligible for caching.
+func writeContext(t *testing.T, n int) string {
+	t.Helper()
+	dir := t.TempDir()
+	past := time.Now().Add(-time.Hour)
+	for i := range n {
+		name := filepath.Join(dir, fmt.Sprintf("dir%d", i%7), fmt.Sprintf("file%04d", i))
+		require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o750))
+		require.NoError(t, os.WriteFile(name, []byte(fmt.Sprintf("contents %d", i)), 0o600))
+		require.NoError(t, os.Chtimes(name, past, past))
+	}
+	big := filepath.Join(dir, "big")
+	require.NoError(t, os.WriteFile(big, make([]byte, 2*maxPrefetchSize), 0o600))
+	require.NoError(t, os.Chtimes(big, past, past))
+	require.NoError(t, os.Symlink("big", filepath.Join(dir, "link")))
+	require.NoError(t, os.Symlink("dir0", filepath.Join(dir, "dirlink")))
+	return dir
+}
+
+func TestHashBuildContextCache(t *testing.T) {
+	t.Parallel()
+	dir := writeContext(t, 3*checkpointFiles)
+	cacheDir := t.TempDir()
+
+	want, err := hashBuildContext(dir, "", nil)
+	require.NoError(t, err)
+	wantManifest := contextManifest{}
+	_, err = hashBuildContextWith(dir, "", nil, hashOptions{manifest: wantManifest})
+	require.NoError(t, err)
+
+	hash := func() (string, contextManifest) {
+		manifest := contextManifest{}
+		got, err := hashBuildContextWith(dir, "", nil, hashOptions{manifest: manifest, cacheDir: cacheDir})
+		require.NoError(t, err)
+		return got, manifest
+	}
+
+	// Cold and warm caches produce the same hash and manifest.
+	for range 2 {
+		got, manifest := hash()
+		assert.Equal(t, want, got)
+		assert.Equal(t, wantManifest, manifest)
+	}
+
+	// Unchanged files aren't re-read: rewriting a file without changing
+	// its size, mtime or inode goes unnoticed.
+	name := filepath.Join(dir, "dir3", "file0010")
+	fi, err := os.Stat(name)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(name, []byte("contents 99"), 0o600))
+	require.NoError(t, os.Chtimes(name, fi.ModTime(), fi.ModTime()))
+	got, _ := hash()
+	assert.Equal(t, want, got)
+
+	// Changed files are.
+	past := time.Now().Add(-time.Minute)
+	require.NoError(t, os.WriteFile(name, []byte("changed"), 0o600))
+	require.NoError(t, os.Chtimes(name, past, past))
+	want, err = hashBuildContext(dir, "", nil)
+	require.NoError(t, err)
+	got, manifest := hash()
+	assert.Equal(t, want, got)
+	assert.Equal(t, "dir3/file0010 modified", wantManifest.explain(manifest))
+}
+
+func TestHashBuildContextCacheCorrupt(t *testing.T) {
+	t.Parallel()
+	dir := writeContext(t, 10)
+	cacheDir := t.TempDir()
+	opts := hashOptions{cacheDir: cacheDir}
+
+	want, err := hashBuildContext(dir, "", nil)
+	require.NoError(t, err)
+	_, err = hashBuildContextWith(dir, "", nil, opts)
+	require.NoError(t, err)
+
+	cacheFile := opts.cacheFile(dir, nil, []string{})
+	require.FileExists(t, cacheFile)
+	require.NoError(t, os.WriteFile(cacheFile, []byte("{"), 0o600))
+
+	got, err := hashBuildContextWith(dir, "", nil, opts)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
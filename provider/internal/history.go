@@ -0,0 +1,76 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// buildRefError annotates a failed build with its buildx history reference,
+// so the build's record can still be exported.
+type buildRefError struct {
+	ref string
+	err error
+}
+
+func (e *buildRefError) Error() string {
+	return e.err.Error()
+}
+
+func (e *buildRefError) Unwrap() error {
+	return e.err
+}
+
+// buildRefFor returns the history reference, formatted as
+// "<builder>/<node>/<ref>", of a successful or failed build. Returns an empty
+// string if the reference isn't known.
+func buildRefFor(result *BuildResult, err error) string {
+	var refErr *buildRefError
+	if errors.As(err, &refErr) {
+		return refErr.ref
+	}
+	if result == nil {
+		return ""
+	}
+	return result.ExporterResponse[buildRefKey]
+}
+
+// exportBuildRecord exports the history record of the build with the given
+// reference to dir as a ".dockerbuild" bundle, and returns the bundle's path.
+func exportBuildRecord(ctx context.Context, cli Client, buildRef, dir string) (string, error) {
+	if buildRef == "" {
+		return "", errors.New("the build's history reference is unknown")
+	}
+	parts := strings.Split(buildRef, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return "", fmt.Errorf("invalid build reference %q", buildRef)
+	}
+	builder, ref := parts[0], parts[2]
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, ref+".dockerbuild")
+
+	if err := cli.ExportHistory(ctx, builder, ref, path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBuildRefFor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		result *BuildResult
+		err    error
+		want   string
+	}{
+		{
+			name: "success",
+			result: &BuildResult{
+				ExporterResponse: map[string]string{buildRefKey: "default/default/abc"},
+			},
+			want: "default/default/abc",
+		},
+		{
+			name: "failure",
+			err:  &buildRefError{ref: "default/default/abc", err: errors.New("boom")},
+			want: "default/default/abc",
+		},
+		{
+			name: "unknown",
+			err:  errors.New("boom"),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, buildRefFor(tt.result, tt.err))
+		})
+	}
+}
+
+func TestExportBuildRecord(t *testing.T) {
+	t.Parallel()
+
+	t.Run("exported", func(t *testing.T) {
+		t.Parallel()
+		dir := filepath.Join(t.TempDir(), "records")
+		want := filepath.Join(dir, "abc.dockerbuild")
+
+		ctrl := gomock.NewController(t)
+		c := NewMockClient(ctrl)
+		c.EXPECT().ExportHistory(gomock.Any(), "mybuilder", "abc", want).Return(nil)
+
+		path, err := exportBuildRecord(t.Context(), c, "mybuilder/mybuilder0/abc", dir)
+		require.NoError(t, err)
+		assert.Equal(t, want, path)
+		assert.DirExists(t, dir)
+	})
+
+	t.Run("export error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		c := NewMockClient(ctrl)
+		c.EXPECT().ExportHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(errors.New("no record found"))
+
+		path, err := exportBuildRecord(t.Context(), c, "default/default/abc", t.TempDir())
+		assert.ErrorContains(t, err, "no record found")
+		assert.Empty(t, path)
+	})
+
+	for _, ref := range []string{"", "abc", "default/abc", "/default/abc"} {
+		t.Run("invalid "+ref, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			c := NewMockClient(ctrl)
+
+			_, err := exportBuildRecord(t.Context(), c, ref, t.TempDir())
+			assert.Error(t, err)
+		})
+	}
+}
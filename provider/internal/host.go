@@ -28,6 +28,7 @@ import (
 	"github.com/docker/buildx/store/storeutil"
 	"github.com/docker/cli/cli/command"
 	cfgtypes "github.com/docker/cli/cli/config/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // host contains a host-level Docker CLI as well as a cache of initialized
@@ -70,12 +71,15 @@ func newHost(_ context.Context, config *Config) (*host, error) {
 //
 // If the build doesn't specify a builder by name, we will iterate through all
 // available builders until we find one that we can connect to.
-func (h *host) builderFor(ctx context.Context, build Build) (*cachedBuilder, error) {
+func (h *host) builderFor(ctx context.Context, build Build) (_ *cachedBuilder, err error) {
+	opts := build.BuildOptions()
+
+	ctx, span := startSpan(ctx, "host.builderFor", attribute.String("builder", opts.Builder))
+	defer func() { endSpan(span, err) }()
+
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	opts := build.BuildOptions()
-
 	if b, ok := h.builders[opts.Builder]; ok {
 		return b, nil
 	}
@@ -172,6 +176,10 @@ func (h *host) builderFor(ctx context.Context, build Build) (*cachedBuilder, err
 	// Attempt to load nodes in order to determine the builder's driver. Ignore
 	// errors for "exec" builds because it's possible to request builders with
 	// drivers that are unknown to us.
+	//
+	// This creates each node's BuildKit client. Clients created under a traced
+	// context propagate the trace context of every later call, including the
+	// solve, so this must stay within our span.
 	nodes, err := b.LoadNodes(ctx, builder.WithData())
 	if err != nil && !build.ShouldExec() {
 		if strings.Contains(err.Error(), "failed to find driver") {
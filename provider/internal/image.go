@@ -32,10 +32,13 @@ import (
 	"github.com/containerd/errdefs"
 	"github.com/distribution/reference"
 	"github.com/docker/buildx/util/buildflags"
+	dockeropts "github.com/docker/cli/opts"
 	"github.com/moby/buildkit/exporter/containerimage/exptypes"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/session/secrets/secretsprovider"
+	"github.com/regclient/regclient/types/descriptor"
 	"github.com/regclient/regclient/types/errs"
+	v1 "github.com/regclient/regclient/types/oci/v1"
 	"github.com/regclient/regclient/types/ref"
 
 	provider "github.com/pulumi/pulumi-go-provider"
@@ -89,27 +92,42 @@ func (i *Image) Annotate(a infer.Annotator) {
 // ImageArgs instantiates a new Image.
 type ImageArgs struct {
 	AddHosts                       []string          `pulumi:"addHosts,optional"`
+	Allow                          []Entitlement     `pulumi:"allow,optional"`
+	AllowedBaseImages              []string          `pulumi:"allowedBaseImages,optional"`
+	Annotations                    map[string]string `pulumi:"annotations,optional"`
+	Attestations                   *Attestations     `pulumi:"attestations,optional"`
+	BaseImageLock                  *BaseImageLock    `pulumi:"baseImageLock,optional"`
 	BuildArgs                      map[string]string `pulumi:"buildArgs,optional"`
+	BuildLogPath                   string            `pulumi:"buildLogPath,optional"`
 	BuildOnPreview                 *bool             `pulumi:"buildOnPreview,optional"`
+	BuildRecordDir                 string            `pulumi:"buildRecordDir,optional"`
 	Builder                        *BuilderConfig    `pulumi:"builder,optional"`
 	CacheFrom                      []CacheFrom       `pulumi:"cacheFrom,optional"`
 	CacheTo                        []CacheTo         `pulumi:"cacheTo,optional"`
+	CgroupParent                   string            `pulumi:"cgroupParent,optional"`
 	Context                        *BuildContext     `pulumi:"context,optional"`
 	Dockerfile                     *Dockerfile       `pulumi:"dockerfile,optional"`
 	Exports                        []Export          `pulumi:"exports,optional"`
+	IgnoreWarnings                 []string          `pulumi:"ignoreWarnings,optional"`
 	Labels                         map[string]string `pulumi:"labels,optional"`
+	Lint                           *Lint             `pulumi:"lint,optional"`
 	Load                           bool              `pulumi:"load,optional"`
 	Network                        *NetworkMode      `pulumi:"network,optional"`
 	NoCache                        bool              `pulumi:"noCache,optional"`
 	Platforms                      []Platform        `pulumi:"platforms,optional"`
+	Progress                       *ProgressMode     `pulumi:"progress,optional"`
 	Pull                           bool              `pulumi:"pull,optional"`
 	Push                           bool              `pulumi:"push"`
 	Registries                     []Registry        `pulumi:"registries,optional"`
+	RequirePinnedBaseImages        *bool             `pulumi:"requirePinnedBaseImages,optional"`
 	Secrets                        map[string]string `pulumi:"secrets,optional" provider:"secret"`
 	IgnoreSecretsInDiffCalculation []string          `pulumi:"ignoreSecretsInDiffCalculation,optional"`
+	ShmSize                        string            `pulumi:"shmSize,optional"`
 	SSH                            []SSH             `pulumi:"ssh,optional"`
 	Tags                           []string          `pulumi:"tags,optional"`
 	Target                         string            `pulumi:"target,optional"`
+	Ulimits                        []Ulimit          `pulumi:"ulimits,optional"`
+	WarningsAsErrors               *bool             `pulumi:"warningsAsErrors,optional"`
 	Exec                           bool              `pulumi:"exec,optional"`
 }
 
@@ -120,6 +138,59 @@ func (ia *ImageArgs) Annotate(a infer.Annotator) {
 
 		Equivalent to Docker's "--add-host" flag.
 	`))
+	a.Describe(&ia.Allow, dedent(`
+		Extra privileges to grant to the build.
+
+		The builder must also be configured to permit these entitlements,
+		for example with "--allow-insecure-entitlement".
+
+		Equivalent to Docker's "--allow" flag.
+	`))
+	a.Describe(&ia.AllowedBaseImages, dedent(`
+		Glob patterns, like "docker.io/library/*" or
+		"ghcr.io/my-org/base:*", that every "FROM" image must match. Patterns
+		are matched against fully-qualified references such as
+		"docker.io/library/alpine:latest" and their repository names, and "*"
+		doesn't match "/".
+
+		ARGs are substituted from "buildArgs". References to other stages and
+		to "named" contexts are not restricted.
+
+		Images must also match the provider's "allowedBaseImages", if set.
+	`))
+	a.Describe(&ia.Annotations, dedent(`
+		Attach arbitrary key/value annotations to the image's manifests.
+
+		Keys may be prefixed with the level to annotate -- "manifest",
+		"index", "manifest-descriptor" or "index-descriptor" -- optionally
+		qualified with a platform, for example
+		"manifest[linux/amd64]:org.opencontainers.image.title". Multiple
+		levels can be separated by commas. Defaults to "manifest".
+
+		Annotations are applied to every "image", "registry", "oci" and
+		"docker" export. Annotations set directly on an export take
+		precedence.
+
+		Equivalent to Docker's "--annotation" flag.
+	`))
+	a.Describe(&ia.Attestations, dedent(`
+		Attestations to attach to the image, such as an SBOM or SLSA
+		provenance.
+
+		Attestations are only exported with "image", "registry" or "oci"
+		exports, and require a builder which supports them (the legacy
+		"docker" driver does not).
+
+		Equivalent to Docker's "--attest" flag.
+	`))
+	a.Describe(&ia.BaseImageLock, dedent(`
+		Pin every external "FROM" and "COPY --from" image to the digest it
+		resolved to on the first build.
+
+		Later builds substitute "docker-image://<ref>@<digest>" named
+		contexts for those images, so they don't change until the lock's
+		"refresh" value does.
+	`))
 	a.Describe(&ia.BuildArgs, dedent(`
 		"ARG" names and values to set during the build.
 
@@ -131,6 +202,13 @@ func (ia *ImageArgs) Annotate(a infer.Annotator) {
 
 		Equivalent to Docker's "--build-arg" flag.
 	`))
+	a.Describe(&ia.BuildLogPath, dedent(`
+		A file to write the build's full log to, including anything written to
+		stderr. The log is written whether or not the build succeeds, which is
+		useful for archiving logs from CI.
+
+		Defaults to a file in the provider's "buildLogDir", if set.
+	`))
 	a.Describe(&ia.BuildOnPreview, dedent(`
 		Setting this to "false" will always skip image builds during previews,
 		and setting it to "true" will always build images during previews.
@@ -145,6 +223,14 @@ func (ia *ImageArgs) Annotate(a infer.Annotator) {
 		of CI pipelines.
 	`))
 	a.SetDefault(&ia.BuildOnPreview, pulumi.Bool(true))
+	a.Describe(&ia.BuildRecordDir, dedent(`
+		A directory to export the build's history record to after it succeeds
+		or fails, as a ".dockerbuild" bundle. The bundle's path is reported by
+		"buildRecordPath".
+
+		Equivalent to running "docker buildx history export" after the build.
+		Records of failed builds are not available when "exec" is "true".
+	`))
 	a.Describe(&ia.Builder, dedent(`
 		Builder configuration.
 	`))
@@ -158,6 +244,11 @@ func (ia *ImageArgs) Annotate(a infer.Annotator) {
 
 		Equivalent to Docker's "--cache-to" flag.
 	`))
+	a.Describe(&ia.CgroupParent, dedent(`
+		Set the parent cgroup for "RUN" instructions.
+
+		Equivalent to Docker's "--cgroup-parent" flag.
+	`))
 	a.Describe(&ia.Context, dedent(`
 		Build context settings. Defaults to the current directory.
 
@@ -179,11 +270,24 @@ func (ia *ImageArgs) Annotate(a infer.Annotator) {
 
 		Equivalent to Docker's "--output" flag.
 	`))
+	a.Describe(&ia.IgnoreWarnings, dedent(`
+		Names of rules, like "StageNameCasing" or "JSONArgsRecommended", whose
+		warnings shouldn't fail the build when "warningsAsErrors" is enabled.
+		These warnings are still logged.
+	`))
 	a.Describe(&ia.Labels, dedent(`
 		Attach arbitrary key/value metadata to the image.
 
 		Equivalent to Docker's "--label" flag.
 	`))
+	a.Describe(&ia.Lint, dedent(`
+		Run BuildKit's Dockerfile lint rules, like "StageNameCasing" or
+		"SecretsUsedInArgOrEnv", against local and inline Dockerfiles before
+		building. Violations are reported as warnings unless configured to be
+		errors.
+
+		Lint settings in the Dockerfile, like "# check=skip=...", are honored.
+	`))
 	a.Describe(&ia.Load, dedent(`
 		When "true" the build will automatically include a "docker" export.
 
@@ -196,6 +300,9 @@ func (ia *ImageArgs) Annotate(a infer.Annotator) {
 
 		For custom networks, configure your builder with "--driver-opt network=...".
 
+		The "host" network mode requires the "network.host" entitlement to be
+		included in "allow".
+
 		Equivalent to Docker's "--network" flag.
 	`))
 	a.Describe(&ia.NoCache, dedent(`
@@ -208,9 +315,18 @@ func (ia *ImageArgs) Annotate(a infer.Annotator) {
 
 		Equivalent to Docker's "--platform" flag.
 	`))
+	a.Describe(&ia.Progress, dedent(`
+		How to report build progress. Defaults to the provider's "progress"
+		setting.
+
+		Equivalent to Docker's "--progress" flag.
+	`))
 	a.Describe(&ia.Pull, dedent(`
 		Always pull referenced images.
 
+		Images with "pull" are only rebuilt when one of their
+		"baseImageDigests" has moved, or always if those aren't known.
+
 		Equivalent to Docker's "--pull" flag.
 	`))
 	a.Describe(&ia.Push, dedent(`
@@ -220,6 +336,14 @@ func (ia *ImageArgs) Annotate(a infer.Annotator) {
 
 		Equivalent to Docker's "--push" flag.
 	`))
+	a.Describe(&ia.RequirePinnedBaseImages, dedent(`
+		Require every external "FROM" and "COPY --from" image to be pinned
+		with an "@sha256:" digest, after substituting ARGs from "buildArgs".
+		Failures suggest the image's current digest when it can be looked up
+		with the configured registry credentials.
+
+		Defaults to the provider's "requirePinnedBaseImages" setting.
+	`))
 	a.Describe(&ia.Secrets, dedent(`
 		A mapping of secret names to their corresponding values.
 
@@ -239,6 +363,11 @@ func (ia *ImageArgs) Annotate(a infer.Annotator) {
 
 		This is useful when you want to avoid unnecessary rebuilds caused by short-lived secrets that change on every run.
 	`))
+	a.Describe(&ia.ShmSize, dedent(`
+		Size of "/dev/shm" for "RUN" instructions, for example "2g".
+
+		Equivalent to Docker's "--shm-size" flag.
+	`))
 	a.Describe(&ia.SSH, dedent(`
 		SSH agent socket or keys to expose to the build.
 
@@ -269,6 +398,18 @@ func (ia *ImageArgs) Annotate(a infer.Annotator) {
 		Similar to "docker login".
 	`))
 
+	a.Describe(&ia.Ulimits, dedent(`
+		Resource limits for "RUN" instructions.
+
+		Equivalent to Docker's "--ulimit" flag.
+	`))
+	a.Describe(&ia.WarningsAsErrors, dedent(`
+		Fail the build if BuildKit reports any warnings, like Dockerfile lint
+		rule violations or deprecated syntax. Warnings from rules listed in
+		"ignoreWarnings" are exempt.
+
+		Defaults to the provider's "warningsAsErrors" setting.
+	`))
 	a.Describe(&ia.Exec, dedent(`
 		Use "exec" mode to build this image.
 
@@ -304,9 +445,19 @@ func (ia ImageArgs) GetRegistries() []Registry {
 type ImageState struct {
 	ImageArgs
 
-	Digest      string `pulumi:"digest"      provider:"output"`
-	ContextHash string `pulumi:"contextHash" provider:"output"`
-	Ref         string `pulumi:"ref"         provider:"output"`
+	Digest           string                 `pulumi:"digest"                    provider:"output"`
+	ContextHash      string                 `pulumi:"contextHash"               provider:"output"`
+	Ref              string                 `pulumi:"ref"                       provider:"output"`
+	PlatformDigests  map[string]string      `pulumi:"platformDigests,optional"  provider:"output"`
+	Config           map[string]ImageConfig `pulumi:"config,optional"           provider:"output"`
+	Layers           map[string][]Layer     `pulumi:"layers,optional"           provider:"output"`
+	CompressedSize   map[string]int         `pulumi:"compressedSize,optional"   provider:"output"`
+	BuildMetadata    *BuildMetadata         `pulumi:"buildMetadata,optional"    provider:"output"`
+	BuildStats       *BuildStats            `pulumi:"buildStats,optional"       provider:"output"`
+	BuildRecordPath  string                 `pulumi:"buildRecordPath,optional"  provider:"output"`
+	BaseImageDigests map[string]string      `pulumi:"baseImageDigests,optional" provider:"output"`
+	LockedBaseImages map[string]string      `pulumi:"lockedBaseImages,optional" provider:"output"`
+	ContextManifest  string                 `pulumi:"contextManifest,optional"  provider:"output"`
 }
 
 // Annotate describes outputs of the Image resource.
@@ -323,11 +474,28 @@ func (is *ImageState) Annotate(a infer.Annotator) {
 		"ref" output provides one such reference as a convenience.
 		`,
 	))
+	a.Describe(&is.BaseImageDigests, dedent(`
+		The digest each external "FROM" image resolved to when the image was
+		built, keyed by its fully-qualified reference.
+
+		Only recorded for images with "pull", which are rebuilt when any of
+		these digests has moved.
+	`))
+	a.Describe(&is.LockedBaseImages, dedent(`
+		The digests each base image is pinned to by "baseImageLock", keyed by
+		its fully-qualified reference.
+	`))
 	a.Describe(&is.ContextHash, dedent(`
 		A preliminary hash of the image's build context.
 
 		Pulumi uses this to determine if an image _may_ need to be re-built.
 	`))
+	a.Describe(&is.ContextManifest, dedent(`
+		A compressed record of each file contributing to "contextHash", used
+		to explain which files changed when the image needs to be re-built.
+
+		Empty for contexts with more than 5000 files.
+	`))
 	a.Describe(&is.Ref, dedent(`
 		If the image was pushed to any registries then this will contain a
 		single fully-qualified tag including the build's digest.
@@ -344,6 +512,53 @@ func (is *ImageState) Annotate(a infer.Annotator) {
 		For more control over tags consumed by downstream resources you should
 		use the "digest" output.
 	`))
+	a.Describe(&is.PlatformDigests, dedent(`
+		The digest of each platform-specific manifest pushed to a registry,
+		keyed by platform (for example "linux/arm64").
+
+		This is useful when a downstream consumer requires a single-platform
+		image. Attestation manifests are not included. Single-platform images
+		are keyed by the requested platform, or by the platform recorded in
+		their config if "platforms" wasn't specified.
+
+		Empty if the image was not pushed to a registry.
+	`))
+	a.Describe(&is.Config, dedent(`
+		Runtime configuration recorded in each platform's pushed image, such
+		as its entrypoint, environment and exposed ports. Keyed by platform
+		in the same way as "platformDigests".
+
+		Empty if the image was not pushed to a registry.
+	`))
+	a.Describe(&is.Layers, dedent(`
+		The layers of each platform's pushed image, keyed by platform in the
+		same way as "platformDigests".
+
+		Empty if the image was not pushed to a registry.
+	`))
+	a.Describe(&is.BuildMetadata, dedent(`
+		Metadata returned by buildkit after the build, useful for tracing an
+		image back to its buildx history.
+	`))
+	a.Describe(&is.BuildStats, dedent(`
+		Statistics about the most recent build, like its duration and how many
+		steps were cached.
+
+		Not available when "exec" is "true".
+	`))
+	a.Describe(&is.BuildRecordPath, dedent(`
+		The path of the most recent build's exported history record, if
+		"buildRecordDir" is set.
+
+		The record can be opened with Docker Desktop's Builds view or imported
+		with "docker buildx history import".
+	`))
+	a.Describe(&is.CompressedSize, dedent(`
+		The total compressed size in bytes of each platform's pushed layers,
+		keyed by platform in the same way as "platformDigests".
+
+		Empty if the image was not pushed to a registry.
+	`))
 }
 
 // client produces a CLI client scoped to this resource and layered on top of
@@ -352,6 +567,25 @@ func (i *Image) client(ctx context.Context, args ImageArgs) (Client, error) {
 	return i.clientF(ctx, i.config.getHost(), i.config, args)
 }
 
+// resolveDigest returns a digestResolver authenticated with the image's
+// registries, or nil if we aren't able to create a client.
+func (i *Image) resolveDigest(args ImageArgs) digestResolver {
+	if i.clientF == nil {
+		return nil
+	}
+	var cli Client
+	return func(ctx context.Context, ref string) (string, error) {
+		if cli == nil {
+			c, err := i.client(ctx, args)
+			if err != nil {
+				return "", err
+			}
+			cli = c
+		}
+		return cli.ManifestInspect(ctx, ref)
+	}
+}
+
 // Check validates ImageArgs, sets defaults, and ensures our client is
 // authenticated.
 func (i *Image) Check(
@@ -382,6 +616,27 @@ func (i *Image) Check(
 		}
 	}
 
+	// Cross-check and lint the Dockerfile once all our inputs are known.
+	if !preview {
+		for _, check := range []func() ([]string, error){
+			args.crossCheck,
+			func() ([]string, error) { return args.lint(ctx) },
+			func() ([]string, error) { return nil, args.checkBaseImages(ctx, cfg, i.resolveDigest(args)) },
+		} {
+			warnings, cerr := check()
+			for _, w := range warnings {
+				provider.GetLogger(ctx).Warning(w)
+			}
+			if cerr != nil {
+				for _, e := range cerr.(interface{ Unwrap() []error }).Unwrap() {
+					if cf, ok := e.(checkFailure); ok {
+						failures = append(failures, cf.CheckFailure)
+					}
+				}
+			}
+		}
+	}
+
 	return infer.CheckResponse[ImageArgs]{Failures: failures, Inputs: args}, err
 }
 
@@ -406,29 +661,43 @@ func newCheckFailure(err error, format string, args ...any) error {
 // to enable build-on-preview behavior, we omit zero values during previews.
 func (ia *ImageArgs) normalize(preview bool) ImageArgs {
 	normalized := ImageArgs{
-		AddHosts:       filter(stringKeeper{preview}, ia.AddHosts...),
-		BuildArgs:      mapKeeper{preview}.keep(ia.BuildArgs),
-		BuildOnPreview: ia.BuildOnPreview,
-		Builder:        ia.Builder,
-		CacheFrom:      filter(stringerKeeper[CacheFrom]{preview}, ia.CacheFrom...),
-		CacheTo:        filter(stringerKeeper[CacheTo]{preview}, ia.CacheTo...),
-		Context:        contextKeeper{preview}.keep(ia.Context),
-		Dockerfile:     ia.Dockerfile,
-		Exports:        filter(stringerKeeper[Export]{preview}, ia.Exports...),
-		Labels:         mapKeeper{preview}.keep(ia.Labels),
-		Load:           ia.Load,
-		Network:        ia.Network,
-		NoCache:        ia.NoCache,
-		Platforms:      filter(stringerKeeper[Platform]{preview}, ia.Platforms...),
-		Pull:           ia.Pull,
-		Push:           ia.Push,
-		Registries:     filter(registryKeeper{preview}, ia.Registries...),
-		SSH:            filter(stringerKeeper[SSH]{preview}, ia.SSH...),
-		Secrets:        mapKeeper{preview}.keep(ia.Secrets),
-		Tags:           filter(stringKeeper{preview}, ia.Tags...),
-		Target:         ia.Target,
+		AddHosts:          filter(stringKeeper{preview}, ia.AddHosts...),
+		Allow:             filter(stringerKeeper[Entitlement]{preview}, ia.Allow...),
+		AllowedBaseImages: filter(stringKeeper{preview}, ia.AllowedBaseImages...),
+		Annotations:       mapKeeper{preview}.keep(ia.Annotations),
+		Attestations:      ia.Attestations,
+		BaseImageLock:     ia.BaseImageLock,
+		BuildArgs:         mapKeeper{preview}.keep(ia.BuildArgs),
+		BuildLogPath:      ia.BuildLogPath,
+		BuildOnPreview:    ia.BuildOnPreview,
+		BuildRecordDir:    ia.BuildRecordDir,
+		Builder:           ia.Builder,
+		CacheFrom:         filter(stringerKeeper[CacheFrom]{preview}, ia.CacheFrom...),
+		CacheTo:           filter(stringerKeeper[CacheTo]{preview}, ia.CacheTo...),
+		CgroupParent:      ia.CgroupParent,
+		Context:           contextKeeper{preview}.keep(ia.Context),
+		Dockerfile:        ia.Dockerfile,
+		Exports:           filter(stringerKeeper[Export]{preview}, ia.Exports...),
+		IgnoreWarnings:    filter(stringKeeper{preview}, ia.IgnoreWarnings...),
+		Labels:            mapKeeper{preview}.keep(ia.Labels),
+		Lint:              ia.Lint,
+		Load:              ia.Load,
+		Network:           ia.Network,
+		NoCache:           ia.NoCache,
+		Platforms:         filter(stringerKeeper[Platform]{preview}, ia.Platforms...),
+		Progress:          ia.Progress,
+		Pull:              ia.Pull,
+		Push:              ia.Push,
+		Registries:        filter(registryKeeper{preview}, ia.Registries...),
+		ShmSize:           ia.ShmSize,
+		SSH:               filter(stringerKeeper[SSH]{preview}, ia.SSH...),
+		Secrets:           mapKeeper{preview}.keep(ia.Secrets),
+		Tags:              filter(stringKeeper{preview}, ia.Tags...),
+		Target:            ia.Target,
+		Ulimits:           filter(stringerKeeper[Ulimit]{preview}, ia.Ulimits...),
 
 		IgnoreSecretsInDiffCalculation: ia.IgnoreSecretsInDiffCalculation,
+		WarningsAsErrors:               ia.WarningsAsErrors,
 	}
 
 	// Handle --push/--load shorthand.
@@ -589,6 +858,12 @@ func (ia *ImageArgs) validate(supportsMultipleExports, preview bool) (BuildOptio
 		}
 	}
 
+	annotations, err := parseAnnotations(normalized.Annotations)
+	if err != nil {
+		multierr = errors.Join(multierr, err)
+	}
+	applyAnnotations(exports, annotations)
+
 	platforms := []string{}
 	for idx, p := range normalized.Platforms {
 		platform, err := p.validate(preview)
@@ -601,6 +876,28 @@ func (ia *ImageArgs) validate(supportsMultipleExports, preview bool) (BuildOptio
 		}
 	}
 
+	allow := []string{}
+	for idx, e := range normalized.Allow {
+		entitlement, err := e.validate(preview)
+		if err != nil {
+			multierr = errors.Join(multierr, newCheckFailure(err, "allow[%d]", idx))
+			continue
+		}
+		if entitlement != "" {
+			allow = append(allow, entitlement)
+		}
+	}
+	// Unknown entitlements are dropped during previews, so only enforce this
+	// once all of them are known.
+	if normalized.Network != nil && *normalized.Network == Host &&
+		len(normalized.Allow) == len(ia.Allow) &&
+		!slices.Contains(normalized.Allow, EntitlementNetworkHost) {
+		multierr = errors.Join(multierr, newCheckFailure(
+			fmt.Errorf("the host network mode requires the %q entitlement", EntitlementNetworkHost),
+			"network",
+		))
+	}
+
 	cacheFrom := []*buildflags.CacheOptionsEntry{}
 	for idx, c := range normalized.CacheFrom {
 		if c.String() == "" {
@@ -643,12 +940,42 @@ func (ia *ImageArgs) validate(supportsMultipleExports, preview bool) (BuildOptio
 		}
 	}
 
+	var shmSize dockeropts.MemBytes
+	if normalized.ShmSize != "" {
+		if err := shmSize.Set(normalized.ShmSize); err != nil {
+			multierr = errors.Join(multierr, newCheckFailure(err, "shmSize"))
+		}
+	}
+
+	ulimits := []string{}
+	for idx, u := range normalized.Ulimits {
+		ulimit, err := u.validate()
+		if err != nil {
+			multierr = errors.Join(multierr, newCheckFailure(err, "ulimits[%d]", idx))
+			continue
+		}
+		ulimits = append(ulimits, ulimit)
+	}
+
 	for idx, t := range normalized.Tags {
 		if _, err := reference.Parse(t); err != nil {
 			multierr = errors.Join(multierr, newCheckFailure(err, "tags[%d]", idx))
 		}
 	}
 
+	attests, err := normalized.Attestations.validate()
+	if err != nil {
+		multierr = errors.Join(multierr, err)
+	}
+
+	// The SBOM generator is configured through build arguments. Explicit
+	// buildArgs take precedence.
+	buildArgs := normalized.BuildArgs
+	if sbomArgs := normalized.Attestations.buildArgs(); len(sbomArgs) > 0 {
+		buildArgs = maps.Clone(sbomArgs)
+		maps.Copy(buildArgs, normalized.BuildArgs)
+	}
+
 	secrets := []*buildflags.Secret{}
 	for k, v := range normalized.Secrets {
 		// We abuse the pb.Secret proto by stuffing the secret's value in
@@ -665,24 +992,32 @@ func (ia *ImageArgs) validate(supportsMultipleExports, preview bool) (BuildOptio
 	}
 
 	opts := BuildOptions{
-		BuildArgs:      normalized.BuildArgs,
+		Allow:          allow,
+		Annotations:    annotations,
+		Attests:        attests,
+		BuildArgs:      buildArgs,
 		Builder:        builder.Name,
 		CacheFrom:      cacheFrom,
 		CacheTo:        cacheTo,
+		CgroupParent:   normalized.CgroupParent,
 		ContextPath:    context.Location,
 		DockerfileName: dockerfile.Location,
 		Exports:        exports,
 		ExtraHosts:     normalized.AddHosts,
 		Labels:         normalized.Labels,
+		LogPath:        normalized.BuildLogPath,
 		NetworkMode:    normalized.Network.String(),
 		NoCache:        normalized.NoCache,
 		NamedContexts:  normalized.Context.namedMap(),
 		Platforms:      platforms,
+		Progress:       normalized.Progress.String(),
 		Pull:           normalized.Pull,
 		Secrets:        secrets,
+		ShmSize:        shmSize.Value(),
 		SSH:            ssh,
 		Tags:           normalized.Tags,
 		Target:         normalized.Target,
+		Ulimits:        ulimits,
 	}
 
 	return opts, multierr
@@ -692,6 +1027,16 @@ func (ia *ImageArgs) validate(supportsMultipleExports, preview bool) (BuildOptio
 func (i *Image) Create(
 	ctx context.Context,
 	req infer.CreateRequest[ImageArgs],
+) (infer.CreateResponse[ImageState], error) {
+	return i.create(ctx, req, nil)
+}
+
+// create builds an image. olds is the image's previous state, if any, from
+// which a base image lock is carried over.
+func (i *Image) create(
+	ctx context.Context,
+	req infer.CreateRequest[ImageArgs],
+	olds *ImageState,
 ) (infer.CreateResponse[ImageState], error) {
 	input := req.Inputs
 	state := ImageState{ImageArgs: input}
@@ -725,7 +1070,31 @@ func (i *Image) Create(
 		}, errors.New("buildkit is not supported on this host")
 	}
 
-	build, err := input.toBuild(ctx, cli.SupportsMultipleExports(), req.DryRun)
+	// Fall back to the provider's progress mode.
+	if input.Progress == nil && i.config != nil {
+		input.Progress = i.config.Progress
+	}
+	// Likewise for the build log's location.
+	if input.BuildLogPath == "" && i.config != nil && i.config.BuildLogDir != "" {
+		input.BuildLogPath = defaultBuildLogPath(i.config.BuildLogDir, req.Name, input.Tags)
+	}
+
+	// Swap locked base images for named contexts pinned to their digests.
+	// This happens before hashing so a freshly written lockfile doesn't
+	// appear as a context change later.
+	if input.BaseImageLock != nil {
+		locked, err := input.lockBaseImages(ctx, cli.ManifestInspect, olds, req.DryRun)
+		if err != nil {
+			return infer.CreateResponse[ImageState]{
+				ID:     id,
+				Output: state,
+			}, fmt.Errorf("locking base images: %w", err)
+		}
+		state.LockedBaseImages = locked
+	}
+	build, err := input.withLockedImages(state.LockedBaseImages).toBuild(
+		ctx, cli.SupportsMultipleExports(), req.DryRun,
+	)
 	if err != nil {
 		return infer.CreateResponse[ImageState]{
 			ID:     id,
@@ -733,11 +1102,19 @@ func (i *Image) Create(
 		}, fmt.Errorf("preparing: %w", err)
 	}
 
-	hash, err := hashBuildContext(
+	_, span := startSpan(ctx, "hashBuildContext")
+	manifest := contextManifest{}
+	hash, err := hashBuildContextWith(
 		input.Context.Location,
 		input.Dockerfile.Location,
 		input.Context.Named.Map(),
+		hashOptions{
+			paths:    input.contextPaths(),
+			manifest: manifest,
+			cacheDir: i.contextHashCacheDir(),
+		},
 	)
+	endSpan(span, err)
 	if err != nil {
 		return infer.CreateResponse[ImageState]{
 			ID:     id,
@@ -745,6 +1122,13 @@ func (i *Image) Create(
 		}, fmt.Errorf("hashing build context: %w", err)
 	}
 	state.ContextHash = hash
+	state.ContextManifest, err = manifest.encode()
+	if err != nil {
+		return infer.CreateResponse[ImageState]{
+			ID:     id,
+			Output: state,
+		}, fmt.Errorf("encoding context manifest: %w", err)
+	}
 
 	if req.DryRun && !input.shouldBuildOnPreview() {
 		return infer.CreateResponse[ImageState]{ID: id, Output: state}, nil
@@ -754,9 +1138,44 @@ func (i *Image) Create(
 		return infer.CreateResponse[ImageState]{ID: id, Output: state}, nil
 	}
 
+	// Resolve base images before building, so an image which moves during
+	// the build triggers another one. Only images with pull=true track them.
+	var baseImageDigests map[string]string
+	if !req.DryRun && input.Pull {
+		pinned := input.withLockedImages(state.LockedBaseImages)
+		baseImageDigests = pinned.baseImageDigests(ctx, cli.ManifestInspect)
+	}
+
 	result, err := cli.Build(ctx, build)
+	if dir := input.BuildRecordDir; dir != "" && !req.DryRun {
+		path, exportErr := exportBuildRecord(ctx, cli, buildRefFor(result, err), dir)
+		if exportErr != nil {
+			provider.GetLogger(ctx).Warning("unable to export build record: " + exportErr.Error())
+		} else {
+			provider.GetLogger(ctx).Info("exported build record to " + path)
+		}
+		state.BuildRecordPath = path
+	}
 	if err != nil {
-		return infer.CreateResponse[ImageState]{ID: id, Output: state}, err
+		return infer.CreateResponse[ImageState]{ID: id, Output: state}, classifyBuildError(err, input)
+	}
+
+	warningsAsErrors := input.WarningsAsErrors
+	if warningsAsErrors == nil && i.config != nil {
+		warningsAsErrors = i.config.WarningsAsErrors
+	}
+	if warningsAsErrors != nil && *warningsAsErrors {
+		err := warningsError(result.Warnings, input.IgnoreWarnings)
+		if err != nil {
+			return infer.CreateResponse[ImageState]{ID: id, Output: state}, err
+		}
+	}
+
+	state.BaseImageDigests = baseImageDigests
+	state.BuildMetadata = newBuildMetadata(result.ExporterResponse)
+	state.BuildStats = result.Stats
+	if state.BuildStats != nil {
+		provider.GetLogger(ctx).Info(state.BuildStats.String())
 	}
 
 	if d, ok := result.ExporterResponse[exptypes.ExporterImageDigestKey]; ok {
@@ -781,9 +1200,109 @@ func (i *Image) Create(
 		break
 	}
 
+	if !req.DryRun && state.Ref != "" && state.isExported() {
+		digests, fetched, err := platformDigests(ctx, cli, state.Ref, input.Platforms)
+		if err != nil {
+			provider.GetLogger(ctx).Warning("unable to determine platform digests: " + err.Error())
+		}
+		state.PlatformDigests = digests
+
+		configs, err := imageConfigs(ctx, cli, state.Ref, digests, fetched)
+		if err != nil {
+			provider.GetLogger(ctx).Warning("unable to read image config: " + err.Error())
+		}
+		state.Config = configs
+
+		layers, sizes, err := imageLayers(ctx, cli, state.Ref, digests)
+		if err != nil {
+			provider.GetLogger(ctx).Warning("unable to read image layers: " + err.Error())
+		}
+		state.Layers = layers
+		state.CompressedSize = sizes
+	}
+
 	return infer.CreateResponse[ImageState]{ID: id, Output: state}, nil
 }
 
+// platformDigests inspects a pushed image and returns the digest of each of
+// its platform-specific manifests. Attestation manifests are skipped.
+//
+// Single-platform images are not indexed, so their manifest doesn't record a
+// platform. In that case we fall back to the requested platform, if any, and
+// otherwise to the platform recorded in the image's config. Any configs
+// fetched along the way are also returned, keyed by platform, so they aren't
+// fetched again.
+func platformDigests(
+	ctx context.Context,
+	cli Client,
+	ref string,
+	platforms []Platform,
+) (map[string]string, map[string]v1.Image, error) {
+	descriptors, err := cli.Inspect(ctx, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	digests := digestsByPlatform(descriptors, platforms)
+	if digests != nil || len(descriptors) != 1 || descriptors[0].Platform != nil {
+		return digests, nil, nil
+	}
+
+	r, ok := addDigest(ref, descriptors[0].Digest.String())
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid reference %q", ref)
+	}
+	img, err := cli.ImageConfig(ctx, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if img.OS == "" {
+		return nil, nil, nil
+	}
+	platform := img.Platform.String()
+	return map[string]string{platform: descriptors[0].Digest.String()}, map[string]v1.Image{platform: img}, nil
+}
+
+// forEachPlatform calls f with a reference to each platform-specific manifest
+// in digests, which is keyed by platform. ref is any pushed reference to the
+// image; its digest is swapped for each platform's. Errors from f don't stop
+// the iteration, and are returned together.
+func forEachPlatform(ref string, digests map[string]string, f func(platform, ref string) error) error {
+	var multierr error
+	for platform, digest := range digests {
+		r, ok := addDigest(ref, digest)
+		if !ok {
+			return fmt.Errorf("invalid reference %q", ref)
+		}
+		if err := f(platform, r); err != nil {
+			multierr = errors.Join(multierr, fmt.Errorf("%s: %w", platform, err))
+		}
+	}
+	return multierr
+}
+
+func digestsByPlatform(
+	descriptors []descriptor.Descriptor,
+	platforms []Platform,
+) map[string]string {
+	digests := map[string]string{}
+	//nolint:gocritic // Bytes aren't copied in a hot path.
+	for _, d := range descriptors {
+		switch {
+		case d.Platform == nil && len(descriptors) == 1 && len(platforms) == 1:
+			digests[platforms[0].String()] = d.Digest.String()
+		case d.Platform == nil, d.Platform.Architecture == "unknown":
+			// Ignore attestation and cache manifests.
+			continue
+		default:
+			digests[d.Platform.String()] = d.Digest.String()
+		}
+	}
+	if len(digests) == 0 {
+		return nil
+	}
+	return digests
+}
+
 // Update builds a new image. Normally we create-replace resources, but for
 // images built locally there is nothing to delete. We treat those cases as
 // updates and simply re-build the image without deleting anything.
@@ -791,8 +1310,9 @@ func (i *Image) Update(
 	ctx context.Context,
 	req infer.UpdateRequest[ImageArgs, ImageState],
 ) (infer.UpdateResponse[ImageState], error) {
-	resp, err := i.Create(ctx,
+	resp, err := i.create(ctx,
 		infer.CreateRequest[ImageArgs]{Name: req.ID, Inputs: req.Inputs, DryRun: req.DryRun},
+		&req.State,
 	)
 	return infer.UpdateResponse[ImageState]{Output: resp.Output}, err
 }
@@ -827,6 +1347,7 @@ func (i *Image) Read(
 	}
 
 	tagsToKeep := []string{}
+	var digests map[string]string
 
 	// Do a lookup on all of the tags at the digests we expect to see.
 	for _, tag := range state.Tags {
@@ -865,6 +1386,10 @@ func (i *Image) Read(
 			tagsToKeep = append(tagsToKeep, tag)
 			break
 		}
+
+		if digests == nil {
+			digests = digestsByPlatform(descriptors, state.Platforms)
+		}
 	}
 
 	// If we couldn't find the tags we expected then return an empty ID to
@@ -874,6 +1399,9 @@ func (i *Image) Read(
 	}
 
 	state.Tags = tagsToKeep
+	if digests != nil {
+		state.PlatformDigests = digests
+	}
 
 	return infer.ReadResponse[ImageArgs, ImageState]{ID: req.ID, Inputs: input, State: state}, nil
 }
@@ -925,8 +1453,8 @@ func (i *Image) Delete(
 
 // Diff re-implements most of the default diff behavior, with the exception of
 // ignoring "password" changes on registry inputs.
-func (*Image) Diff(
-	_ context.Context,
+func (i *Image) Diff(
+	ctx context.Context,
 	req infer.DiffRequest[ImageArgs, ImageState],
 ) (provider.DiffResponse, error) {
 	olds, news := req.State, req.Inputs
@@ -937,6 +1465,18 @@ func (*Image) Diff(
 	if !reflect.DeepEqual(olds.AddHosts, news.AddHosts) {
 		diff["addHosts"] = update
 	}
+	if !reflect.DeepEqual(olds.Allow, news.Allow) {
+		diff["allow"] = update
+	}
+	if !reflect.DeepEqual(olds.Annotations, news.Annotations) {
+		diff["annotations"] = update
+	}
+	if !reflect.DeepEqual(olds.Attestations, news.Attestations) {
+		diff["attestations"] = update
+	}
+	if !reflect.DeepEqual(olds.BaseImageLock, news.BaseImageLock) {
+		diff["baseImageLock"] = update
+	}
 	if !reflect.DeepEqual(olds.BuildArgs, news.BuildArgs) {
 		diff["buildArgs"] = update
 	}
@@ -952,12 +1492,18 @@ func (*Image) Diff(
 	if !reflect.DeepEqual(olds.CacheTo, news.CacheTo) {
 		diff["cacheTo"] = update
 	}
+	if olds.CgroupParent != news.CgroupParent {
+		diff["cgroupParent"] = update
+	}
 	if olds.Context.Location != news.Context.Location {
 		diff["context.location"] = update
 	}
 	if !reflect.DeepEqual(olds.Context.Named, news.Context.Named) {
 		diff["context.named"] = update
 	}
+	if !reflect.DeepEqual(olds.Context.HashMode, news.Context.HashMode) {
+		diff["context.hashMode"] = update
+	}
 	dockerfile, _, _ := news.Context.validate(true, news.Dockerfile)
 	if !reflect.DeepEqual(olds.Dockerfile, dockerfile) {
 		diff["dockerfile"] = update
@@ -1006,6 +1552,9 @@ func (*Image) Diff(
 	if !reflect.DeepEqual(oldSecretsCopy, newsSecretsCopy) {
 		diff["secrets"] = update
 	}
+	if olds.ShmSize != news.ShmSize {
+		diff["shmSize"] = update
+	}
 	if !reflect.DeepEqual(olds.SSH, news.SSH) {
 		diff["ssh"] = update
 	}
@@ -1015,24 +1564,60 @@ func (*Image) Diff(
 	if !reflect.DeepEqual(olds.Target, news.Target) {
 		diff["target"] = update
 	}
+	if !reflect.DeepEqual(olds.Ulimits, news.Ulimits) {
+		diff["ulimits"] = update
+	}
 
-	// pull=true indicates that we want to keep base layers up-to-date. In this
-	// case we'll always perform the build.
-	if news.Pull && (len(news.Exports) > 0 || news.Push || news.Load) {
+	// pull=true indicates that we want to keep base layers up-to-date. If we
+	// know which base images we were built on we only rebuild when one of
+	// them has moved; otherwise we'll always perform the build. Locked base
+	// images only change when the lock is refreshed.
+	locked := news.BaseImageLock != nil
+	if !locked && news.Pull && len(olds.BaseImageDigests) == 0 && (len(news.Exports) > 0 || news.Push || news.Load) {
 		diff["contextHash"] = update
 	}
+	if !locked && news.Pull && len(olds.BaseImageDigests) > 0 {
+		args := news
+		args.Dockerfile = dockerfile
+		current := args.baseImageDigests(ctx, i.resolveDigest(news))
+		for ref, dgst := range olds.BaseImageDigests {
+			if moved, ok := current[ref]; ok && moved != dgst {
+				provider.GetLogger(ctx).Info(fmt.Sprintf("base image %q has moved to %s", ref, moved))
+				diff["baseImageDigests"] = update
+			}
+		}
+	}
 
 	// Check if anything has changed in our build context.
-	hash, err := hashBuildContext(
+	hashed := news
+	hashed.Dockerfile = dockerfile
+	_, span := startSpan(ctx, "hashBuildContext")
+	manifest := contextManifest{}
+	hash, err := hashBuildContextWith(
 		news.Context.Location,
 		dockerfile.Location,
 		news.Context.Named.Map(),
+		hashOptions{
+			paths:    hashed.contextPaths(),
+			manifest: manifest,
+			cacheDir: i.contextHashCacheDir(),
+		},
 	)
+	endSpan(span, err)
 	if err != nil {
 		return provider.DiffResponse{}, err
 	}
 	if hash != olds.ContextHash {
 		diff["contextHash"] = update
+		if olds.ContextManifest != "" {
+			// Explain what changed, if we know what was hashed before.
+			previous, err := decodeContextManifest(olds.ContextManifest)
+			if err == nil {
+				if changes := previous.explain(manifest); changes != "" {
+					provider.GetLogger(ctx).Info("contextHash: update (" + changes + ")")
+				}
+			}
+		}
 	}
 
 	// Registries need special handling because we ignore "password" changes to not introduce unnecessary changes.
@@ -30,6 +30,7 @@ import (
 	"github.com/moby/buildkit/exporter/containerimage/exptypes"
 	"github.com/regclient/regclient/types/descriptor"
 	"github.com/regclient/regclient/types/errs"
+	v1 "github.com/regclient/regclient/types/oci/v1"
 	"github.com/regclient/regclient/types/platform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -70,15 +71,29 @@ func TestImageLifecycle(t *testing.T) {
 				c.EXPECT().BuildKitEnabled().Return(true, nil).AnyTimes()
 				c.EXPECT().SupportsMultipleExports().Return(true).AnyTimes()
 				c.EXPECT().Build(gomock.Any(), gomock.AssignableToTypeOf(&build{})).DoAndReturn(
-					func(_ context.Context, b Build) (*client.SolveResponse, error) {
+					func(_ context.Context, b Build) (*BuildResult, error) {
 						assert.Equal(t, "testdata/noop/Dockerfile", b.BuildOptions().DockerfileName)
-						return &client.SolveResponse{
+						return &BuildResult{
 							ExporterResponse: map[string]string{
 								exptypes.ExporterImageDigestKey: "sha256:98ea6e4f216f2fb4b69fff9b3a44842c38686ca685f3f55dc48c5d3fb1107be4",
 							},
 						}, nil
 					},
 				).AnyTimes()
+				c.EXPECT().Inspect(gomock.Any(),
+					"docker.io/pulumibot/buildkit-e2e:latest@sha256:98ea6e4f216f2fb4b69fff9b3a44842c38686ca685f3f55dc48c5d3fb1107be4",
+				).Return([]descriptor.Descriptor{
+					{
+						Platform: &platform.Platform{OS: "linux", Architecture: "arm64"},
+						Digest:   "sha256:7c3ec6b1f3a2b1e8ac2cd2f8a0e6bd0ac5e7ad0d1b2b3e2d6f64bf8b5f8a9c31",
+					},
+					{
+						Platform: &platform.Platform{OS: "linux", Architecture: "amd64"},
+						Digest:   "sha256:2b5bd5c1f6c2f74b6b84d9e3b8c6b1c0e0f8f2d1a6a1d9f5e7c4b3a2f1e0d9c8",
+					},
+				}, nil)
+				c.EXPECT().ImageConfig(gomock.Any(), gomock.Any()).Return(v1.Image{}, nil).Times(2)
+				c.EXPECT().Layers(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
 				c.EXPECT().Delete(gomock.Any(),
 					"docker.io/pulumibot/buildkit-e2e@sha256:98ea6e4f216f2fb4b69fff9b3a44842c38686ca685f3f55dc48c5d3fb1107be4",
 				).
@@ -243,9 +258,9 @@ func TestImageLifecycle(t *testing.T) {
 				c.EXPECT().BuildKitEnabled().Return(true, nil).AnyTimes()
 				c.EXPECT().SupportsMultipleExports().Return(true).AnyTimes()
 				c.EXPECT().Build(gomock.Any(), gomock.AssignableToTypeOf(&build{})).DoAndReturn(
-					func(_ context.Context, b Build) (*client.SolveResponse, error) {
+					func(_ context.Context, b Build) (*BuildResult, error) {
 						assert.Equal(t, "testdata/noop/Dockerfile", b.BuildOptions().DockerfileName)
-						return &client.SolveResponse{
+						return &BuildResult{
 							ExporterResponse: map[string]string{"image.name": "test:latest"},
 						}, nil
 					},
@@ -285,9 +300,9 @@ func TestImageLifecycle(t *testing.T) {
 				c.EXPECT().BuildKitEnabled().Return(true, nil).AnyTimes()
 				c.EXPECT().SupportsMultipleExports().Return(true).AnyTimes()
 				c.EXPECT().Build(gomock.Any(), gomock.AssignableToTypeOf(&build{})).DoAndReturn(
-					func(_ context.Context, b Build) (*client.SolveResponse, error) {
+					func(_ context.Context, b Build) (*BuildResult, error) {
 						assert.Equal(t, "FROM alpine:latest", b.Inline())
-						return &client.SolveResponse{
+						return &BuildResult{
 							ExporterResponse: map[string]string{"image.name": "alpine:latest"},
 						}, nil
 					},
@@ -344,6 +359,380 @@ type errNotFound struct{}
 func (errNotFound) NotFound()     {}
 func (errNotFound) Error() string { return "not found " }
 
+func TestCreateProgress(t *testing.T) {
+	t.Parallel()
+	quiet, rawjson := ProgressQuiet, ProgressRawJSON
+
+	tests := []struct {
+		name     string
+		config   *ProgressMode
+		progress *ProgressMode
+		want     string
+	}{
+		{name: "default", want: "plain"},
+		{name: "provider default", config: &quiet, want: "quiet"},
+		{name: "override", config: &quiet, progress: &rawjson, want: "rawjson"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			c := NewMockClient(ctrl)
+			c.EXPECT().BuildKitEnabled().Return(true, nil)
+			c.EXPECT().SupportsMultipleExports().Return(true)
+			c.EXPECT().Build(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, b Build) (*BuildResult, error) {
+					assert.Equal(t, tt.want, b.BuildOptions().Progress)
+					return &BuildResult{}, nil
+				},
+			)
+
+			i := &Image{clientF: mockClientF(c), config: &Config{Progress: tt.config}}
+			resp, err := i.Create(t.Context(), infer.CreateRequest[ImageArgs]{
+				Name: "progress",
+				Inputs: ImageArgs{
+					Context:    &BuildContext{Context: Context{Location: testdataNoop}},
+					Dockerfile: &Dockerfile{Location: testdataNoop + "/Dockerfile"},
+					Exports:    []Export{{CacheOnly: &ExportCacheOnly{}}},
+					Progress:   tt.progress,
+				},
+			})
+			require.NoError(t, err)
+			// The provider's default isn't persisted in state.
+			assert.Equal(t, tt.progress, resp.Output.Progress)
+		})
+	}
+}
+
+func TestCreateBuildLogPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		config       *Config
+		buildLogPath string
+		want         string
+	}{
+		{name: "default", want: ""},
+		{name: "provider default", config: &Config{BuildLogDir: "logs"}, want: filepath.Join("logs", "app_v1.log")},
+		{name: "override", config: &Config{BuildLogDir: "logs"}, buildLogPath: "build.log", want: "build.log"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			c := NewMockClient(ctrl)
+			c.EXPECT().BuildKitEnabled().Return(true, nil)
+			c.EXPECT().SupportsMultipleExports().Return(true)
+			c.EXPECT().Build(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, b Build) (*BuildResult, error) {
+					assert.Equal(t, tt.want, b.BuildOptions().LogPath)
+					return &BuildResult{}, nil
+				},
+			)
+
+			i := &Image{clientF: mockClientF(c), config: tt.config}
+			resp, err := i.Create(t.Context(), infer.CreateRequest[ImageArgs]{
+				Name: "build-log",
+				Inputs: ImageArgs{
+					BuildLogPath: tt.buildLogPath,
+					Context:      &BuildContext{Context: Context{Location: testdataNoop}},
+					Dockerfile:   &Dockerfile{Location: testdataNoop + "/Dockerfile"},
+					Exports:      []Export{{CacheOnly: &ExportCacheOnly{}}},
+					Tags:         []string{"app:v1"},
+				},
+			})
+			require.NoError(t, err)
+			// The provider's default isn't persisted in state.
+			assert.Equal(t, tt.buildLogPath, resp.Output.BuildLogPath)
+		})
+	}
+}
+
+func TestCreateBuildRecord(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	ctrl := gomock.NewController(t)
+	c := NewMockClient(ctrl)
+	c.EXPECT().BuildKitEnabled().Return(true, nil)
+	c.EXPECT().SupportsMultipleExports().Return(true)
+	c.EXPECT().Build(gomock.Any(), gomock.Any()).Return(
+		nil, &buildRefError{ref: "default/default/abc", err: errors.New("build failed")},
+	)
+	c.EXPECT().ExportHistory(gomock.Any(), "default", "abc", filepath.Join(dir, "abc.dockerbuild"))
+
+	i := &Image{clientF: mockClientF(c)}
+	resp, err := i.Create(t.Context(), infer.CreateRequest[ImageArgs]{
+		Name: "build-record",
+		Inputs: ImageArgs{
+			BuildRecordDir: dir,
+			Context:        &BuildContext{Context: Context{Location: testdataNoop}},
+			Dockerfile:     &Dockerfile{Location: testdataNoop + "/Dockerfile"},
+			Exports:        []Export{{CacheOnly: &ExportCacheOnly{}}},
+		},
+	})
+	assert.ErrorContains(t, err, "build failed")
+	assert.Equal(t, filepath.Join(dir, "abc.dockerbuild"), resp.Output.BuildRecordPath)
+}
+
+func TestCreateBaseImageDigests(t *testing.T) {
+	t.Parallel()
+	alpine := "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "Dockerfile"),
+		[]byte("FROM alpine:3.20 AS base\nFROM base\nFROM golang:1.26\n"),
+		0o600,
+	))
+
+	ctrl := gomock.NewController(t)
+	c := NewMockClient(ctrl)
+	c.EXPECT().BuildKitEnabled().Return(true, nil)
+	c.EXPECT().SupportsMultipleExports().Return(true)
+	c.EXPECT().ManifestInspect(gomock.Any(), "docker.io/library/alpine:3.20").Return(alpine, nil)
+	c.EXPECT().ManifestInspect(gomock.Any(), "docker.io/library/golang:1.26").Return("", errors.New("unauthorized"))
+	c.EXPECT().Build(gomock.Any(), gomock.Any()).Return(&BuildResult{}, nil)
+
+	i := &Image{clientF: mockClientF(c)}
+	resp, err := i.Create(t.Context(), infer.CreateRequest[ImageArgs]{
+		Name: "base-images",
+		Inputs: ImageArgs{
+			Context:    &BuildContext{Context: Context{Location: dir}},
+			Dockerfile: &Dockerfile{Location: filepath.Join(dir, "Dockerfile")},
+			Exports:    []Export{{CacheOnly: &ExportCacheOnly{}}},
+			Pull:       true,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"docker.io/library/alpine:3.20": alpine}, resp.Output.BaseImageDigests)
+}
+
+func TestCreateSinglePlatformOutputs(t *testing.T) {
+	t.Parallel()
+	const (
+		digest = "sha256:98ea6e4f216f2fb4b69fff9b3a44842c38686ca685f3f55dc48c5d3fb1107be4"
+		layer  = "sha256:7c3ec6b1f3a2b1e8ac2cd2f8a0e6bd0ac5e7ad0d1b2b3e2d6f64bf8b5f8a9c31"
+		gzip   = "application/vnd.oci.image.layer.v1.tar+gzip"
+		ref    = "docker.io/pulumi/pulumitest:latest@" + digest
+	)
+
+	ctrl := gomock.NewController(t)
+	c := NewMockClient(ctrl)
+	c.EXPECT().BuildKitEnabled().Return(true, nil)
+	c.EXPECT().SupportsMultipleExports().Return(true)
+	c.EXPECT().Build(gomock.Any(), gomock.Any()).Return(&BuildResult{
+		ExporterResponse: map[string]string{exptypes.ExporterImageDigestKey: digest},
+	}, nil)
+	// A single-platform push isn't indexed, so its manifest has no platform.
+	c.EXPECT().Inspect(gomock.Any(), ref).Return([]descriptor.Descriptor{{Digest: digest}}, nil)
+	c.EXPECT().ImageConfig(gomock.Any(), ref).Return(v1.Image{
+		Platform: platform.Platform{OS: "linux", Architecture: "amd64"},
+		Config:   v1.ImageConfig{User: "nobody"},
+	}, nil)
+	c.EXPECT().Layers(gomock.Any(), ref).Return([]descriptor.Descriptor{
+		{MediaType: gzip, Digest: layer, Size: 1024},
+	}, nil)
+
+	i := &Image{clientF: mockClientF(c)}
+	resp, err := i.Create(t.Context(), infer.CreateRequest[ImageArgs]{
+		Name: "single-platform",
+		Inputs: ImageArgs{
+			Context:    &BuildContext{Context: Context{Location: testdataNoop}},
+			Dockerfile: &Dockerfile{Location: testdataNoop + "/Dockerfile"},
+			Push:       true,
+			Tags:       []string{"docker.io/pulumi/pulumitest"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{platformLinuxAMD64: digest}, resp.Output.PlatformDigests)
+	assert.Equal(t, map[string]ImageConfig{platformLinuxAMD64: {User: "nobody"}}, resp.Output.Config)
+	assert.Equal(t, map[string][]Layer{
+		platformLinuxAMD64: {{Digest: layer, MediaType: gzip, Size: 1024}},
+	}, resp.Output.Layers)
+	assert.Equal(t, map[string]int{platformLinuxAMD64: 1024}, resp.Output.CompressedSize)
+}
+
+func TestImageDiffBaseImageDigests(t *testing.T) {
+	t.Parallel()
+	old := "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	moved := "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+
+	dir := t.TempDir()
+	dockerfile := filepath.Join(dir, "Dockerfile")
+	require.NoError(t, os.WriteFile(dockerfile, []byte("FROM alpine:3.20\n"), 0o600))
+	hash, err := hashBuildContext(dir, dockerfile, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		digests  map[string]string
+		resolved string
+		err      error
+		pull     bool
+
+		wantDiff bool
+	}{
+		{
+			name:     "unchanged",
+			digests:  map[string]string{"docker.io/library/alpine:3.20": old},
+			resolved: old,
+			pull:     true,
+		},
+		{
+			name:     "moved",
+			digests:  map[string]string{"docker.io/library/alpine:3.20": old},
+			resolved: moved,
+			pull:     true,
+			wantDiff: true,
+		},
+		{
+			name:    "moved without pull",
+			digests: map[string]string{"docker.io/library/alpine:3.20": old},
+		},
+		{
+			name:    "unresolved",
+			digests: map[string]string{"docker.io/library/alpine:3.20": old},
+			err:     errors.New("unauthorized"),
+			pull:    true,
+		},
+		{
+			name:     "not recorded with pull",
+			pull:     true,
+			wantDiff: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			c := NewMockClient(ctrl)
+			if tt.digests != nil && tt.pull {
+				c.EXPECT().ManifestInspect(gomock.Any(), "docker.io/library/alpine:3.20").Return(tt.resolved, tt.err)
+			}
+
+			args := ImageArgs{
+				Context:    &BuildContext{Context: Context{Location: dir}},
+				Dockerfile: &Dockerfile{Location: dockerfile},
+				Pull:       tt.pull,
+				Push:       true,
+				Tags:       []string{"docker.io/pulumi/test:latest"},
+			}
+			i := &Image{clientF: mockClientF(c)}
+			resp, err := i.Diff(t.Context(), infer.DiffRequest[ImageArgs, ImageState]{
+				State: ImageState{
+					ImageArgs:        args,
+					ContextHash:      hash,
+					BaseImageDigests: tt.digests,
+				},
+				Inputs: args,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDiff, resp.HasChanges, resp.DetailedDiff)
+			if tt.digests != nil {
+				_, ok := resp.DetailedDiff["baseImageDigests"]
+				assert.Equal(t, tt.wantDiff, ok)
+			}
+		})
+	}
+}
+
+func TestImageDiffBaseImageDigestsWithoutClient(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	dockerfile := filepath.Join(dir, "Dockerfile")
+	require.NoError(t, os.WriteFile(dockerfile, []byte("FROM alpine:3.20\n"), 0o600))
+	hash, err := hashBuildContext(dir, dockerfile, nil)
+	require.NoError(t, err)
+
+	args := ImageArgs{
+		Context:    &BuildContext{Context: Context{Location: dir}},
+		Dockerfile: &Dockerfile{Location: dockerfile},
+		Pull:       true,
+	}
+	i := &Image{}
+	resp, err := i.Diff(t.Context(), infer.DiffRequest[ImageArgs, ImageState]{
+		State: ImageState{
+			ImageArgs:   args,
+			ContextHash: hash,
+			BaseImageDigests: map[string]string{
+				"docker.io/library/alpine:3.20": "sha256:1111111111111111111111111111111111111111111111111111111111111111",
+			},
+		},
+		Inputs: args,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.HasChanges, resp.DetailedDiff)
+}
+
+func TestCreateWarningsAsErrors(t *testing.T) {
+	t.Parallel()
+	enabled, disabled := true, false
+
+	tests := []struct {
+		name             string
+		config           *Config
+		warningsAsErrors *bool
+		ignoreWarnings   []string
+		wantErr          string
+	}{
+		{name: "default"},
+		{
+			name:    "provider default",
+			config:  &Config{WarningsAsErrors: &enabled},
+			wantErr: "StageNameCasing",
+		},
+		{
+			name:             "override",
+			config:           &Config{WarningsAsErrors: &enabled},
+			warningsAsErrors: &disabled,
+		},
+		{
+			name:             "ignored",
+			warningsAsErrors: &enabled,
+			ignoreWarnings:   []string{"StageNameCasing"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			c := NewMockClient(ctrl)
+			c.EXPECT().BuildKitEnabled().Return(true, nil)
+			c.EXPECT().SupportsMultipleExports().Return(true)
+			c.EXPECT().Build(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(context.Context, Build) (*BuildResult, error) {
+					return &BuildResult{Warnings: []client.VertexWarning{{
+						Short: []byte("StageNameCasing: Stage name 'Build' should be lowercase (line 1)"),
+					}}}, nil
+				},
+			)
+
+			i := &Image{clientF: mockClientF(c), config: tt.config}
+			_, err := i.Create(t.Context(), infer.CreateRequest[ImageArgs]{
+				Name: "warnings",
+				Inputs: ImageArgs{
+					Context:          &BuildContext{Context: Context{Location: testdataNoop}},
+					Dockerfile:       &Dockerfile{Location: testdataNoop + "/Dockerfile"},
+					Exports:          []Export{{CacheOnly: &ExportCacheOnly{}}},
+					IgnoreWarnings:   tt.ignoreWarnings,
+					WarningsAsErrors: tt.warningsAsErrors,
+				},
+			})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	t.Parallel()
 	t.Run("image was already deleted", func(t *testing.T) {
@@ -373,14 +762,15 @@ func TestDelete(t *testing.T) {
 func TestRead(t *testing.T) {
 	t.Parallel()
 	tag := "docker.io/pulumi/pulumitest"
-	digest := "sha256:3be99cafdcd80a8e620da56bdc215acab6213bb608d3d492c0ba1807128786a1"
+	const digest = "sha256:3be99cafdcd80a8e620da56bdc215acab6213bb608d3d492c0ba1807128786a1"
 
 	ctrl := gomock.NewController(t)
 	client := NewMockClient(ctrl)
 	client.EXPECT().Inspect(gomock.Any(), fmt.Sprintf("%s:latest@%s", tag, digest)).Return(
 		[]descriptor.Descriptor{
 			{
-				Platform: &platform.Platform{Architecture: "arm64"},
+				Platform: &platform.Platform{OS: "linux", Architecture: "arm64"},
+				Digest:   digest,
 			},
 			{
 				Platform: &platform.Platform{Architecture: "unknown"},
@@ -402,6 +792,118 @@ func TestRead(t *testing.T) {
 
 	require.NoError(t, err)
 	assert.Equal(t, []string{tag}, resp.State.Tags)
+	assert.Equal(t, map[string]string{"linux/arm64": digest}, resp.State.PlatformDigests)
+}
+
+func TestDigestsByPlatform(t *testing.T) {
+	t.Parallel()
+	const digest = "sha256:3be99cafdcd80a8e620da56bdc215acab6213bb608d3d492c0ba1807128786a1"
+
+	tests := []struct {
+		name        string
+		descriptors []descriptor.Descriptor
+		platforms   []Platform
+		want        map[string]string
+	}{
+		{
+			name: "index",
+			descriptors: []descriptor.Descriptor{
+				{Platform: &platform.Platform{OS: "linux", Architecture: "amd64"}, Digest: digest},
+				{Platform: &platform.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}, Digest: digest},
+				{Platform: &platform.Platform{OS: "unknown", Architecture: "unknown"}, Digest: digest},
+			},
+			want: map[string]string{platformLinuxAMD64: digest, "linux/arm/v7": digest},
+		},
+		{
+			name:        "single platform",
+			descriptors: []descriptor.Descriptor{{Digest: digest}},
+			platforms:   []Platform{platformLinuxAMD64},
+			want:        map[string]string{platformLinuxAMD64: digest},
+		},
+		{
+			name:        "single unknown platform",
+			descriptors: []descriptor.Descriptor{{Digest: digest}},
+			want:        nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, digestsByPlatform(tt.descriptors, tt.platforms))
+		})
+	}
+}
+
+func TestImageConfigs(t *testing.T) {
+	t.Parallel()
+	const (
+		ref    = "docker.io/pulumi/pulumitest:latest@sha256:3be99cafdcd80a8e620da56bdc215acab6213bb608d3d492c0ba1807128786a1"
+		amd64  = "sha256:2b5bd5c1f6c2f74b6b84d9e3b8c6b1c0e0f8f2d1a6a1d9f5e7c4b3a2f1e0d9c8"
+		arm64  = "sha256:7c3ec6b1f3a2b1e8ac2cd2f8a0e6bd0ac5e7ad0d1b2b3e2d6f64bf8b5f8a9c31"
+		prefix = "docker.io/pulumi/pulumitest:latest@"
+	)
+
+	ctrl := gomock.NewController(t)
+	client := NewMockClient(ctrl)
+	client.EXPECT().ImageConfig(gomock.Any(), prefix+amd64).Return(v1.Image{
+		Config: v1.ImageConfig{
+			Entrypoint:   []string{"/app"},
+			Cmd:          []string{"serve"},
+			Env:          []string{"PATH=/usr/bin"},
+			ExposedPorts: map[string]struct{}{"8080/tcp": {}, "443/tcp": {}},
+			User:         "nobody",
+			WorkingDir:   "/srv",
+			Labels:       map[string]string{fooName: barName},
+		},
+	}, nil)
+	client.EXPECT().ImageConfig(gomock.Any(), prefix+arm64).Return(v1.Image{}, errs.ErrNotFound)
+
+	configs, err := imageConfigs(t.Context(), client, ref, map[string]string{
+		platformLinuxAMD64: amd64,
+		"linux/arm64":      arm64,
+	}, nil)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.Equal(t, map[string]ImageConfig{
+		platformLinuxAMD64: {
+			Entrypoint:   []string{"/app"},
+			Cmd:          []string{"serve"},
+			Env:          []string{"PATH=/usr/bin"},
+			ExposedPorts: []string{"443/tcp", "8080/tcp"},
+			User:         "nobody",
+			WorkingDir:   "/srv",
+			Labels:       map[string]string{fooName: barName},
+		},
+	}, configs)
+}
+
+func TestImageLayers(t *testing.T) {
+	t.Parallel()
+	const (
+		ref    = "docker.io/pulumi/pulumitest:latest@sha256:3be99cafdcd80a8e620da56bdc215acab6213bb608d3d492c0ba1807128786a1"
+		amd64  = "sha256:2b5bd5c1f6c2f74b6b84d9e3b8c6b1c0e0f8f2d1a6a1d9f5e7c4b3a2f1e0d9c8"
+		layer1 = "sha256:7c3ec6b1f3a2b1e8ac2cd2f8a0e6bd0ac5e7ad0d1b2b3e2d6f64bf8b5f8a9c31"
+		layer2 = "sha256:98ea6e4f216f2fb4b69fff9b3a44842c38686ca685f3f55dc48c5d3fb1107be4"
+		gzip   = "application/vnd.oci.image.layer.v1.tar+gzip"
+	)
+
+	ctrl := gomock.NewController(t)
+	client := NewMockClient(ctrl)
+	client.EXPECT().Layers(gomock.Any(), "docker.io/pulumi/pulumitest:latest@"+amd64).Return(
+		[]descriptor.Descriptor{
+			{MediaType: gzip, Digest: layer1, Size: 1024},
+			{MediaType: gzip, Digest: layer2, Size: 512},
+		}, nil)
+
+	layers, sizes, err := imageLayers(t.Context(), client, ref, map[string]string{platformLinuxAMD64: amd64})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]Layer{
+		platformLinuxAMD64: {
+			{Digest: layer1, MediaType: gzip, Size: 1024},
+			{Digest: layer2, MediaType: gzip, Size: 512},
+		},
+	}, layers)
+	assert.Equal(t, map[string]int{platformLinuxAMD64: 1536}, sizes)
 }
 
 // Read deletes state only on a definitive not-found, not on auth/network errors.
@@ -566,6 +1068,60 @@ func TestImageDiff(t *testing.T) {
 			},
 			wantChanges: true,
 		},
+		{
+			name:  "diff if allow changes",
+			state: func(_ *testing.T, s ImageState) ImageState { return s },
+			inputs: func(_ *testing.T, a ImageArgs) ImageArgs {
+				a.Allow = []Entitlement{EntitlementSecurityInsecure}
+				return a
+			},
+			wantChanges: true,
+		},
+		{
+			name:  "diff if cgroupParent changes",
+			state: func(_ *testing.T, s ImageState) ImageState { return s },
+			inputs: func(_ *testing.T, a ImageArgs) ImageArgs {
+				a.CgroupParent = "/docker"
+				return a
+			},
+			wantChanges: true,
+		},
+		{
+			name:  "diff if shmSize changes",
+			state: func(_ *testing.T, s ImageState) ImageState { return s },
+			inputs: func(_ *testing.T, a ImageArgs) ImageArgs {
+				a.ShmSize = "1g"
+				return a
+			},
+			wantChanges: true,
+		},
+		{
+			name:  "diff if ulimits change",
+			state: func(_ *testing.T, s ImageState) ImageState { return s },
+			inputs: func(_ *testing.T, a ImageArgs) ImageArgs {
+				a.Ulimits = []Ulimit{{Name: "nofile", Soft: 1024}}
+				return a
+			},
+			wantChanges: true,
+		},
+		{
+			name:  "diff if annotations change",
+			state: func(_ *testing.T, s ImageState) ImageState { return s },
+			inputs: func(_ *testing.T, a ImageArgs) ImageArgs {
+				a.Annotations = map[string]string{fooName: barName}
+				return a
+			},
+			wantChanges: true,
+		},
+		{
+			name:  "diff if attestations change",
+			state: func(_ *testing.T, s ImageState) ImageState { return s },
+			inputs: func(_ *testing.T, a ImageArgs) ImageArgs {
+				a.Attestations = &Attestations{SBOM: &SBOMAttestation{}}
+				return a
+			},
+			wantChanges: true,
+		},
 		{
 			name:  "diff if pull changes",
 			state: func(_ *testing.T, s ImageState) ImageState { return s },
@@ -1163,6 +1719,101 @@ func TestValidateImageArgs(t *testing.T) {
 		assert.ErrorContains(t, err, "cacheTo should only specify one cache type")
 	})
 
+	t.Run("attestations", func(t *testing.T) {
+		t.Parallel()
+		args := ImageArgs{
+			Context:   &BuildContext{Context: Context{Location: testdataNoop}},
+			BuildArgs: map[string]string{sbomScanStageArg: falseLiteral},
+			Attestations: &Attestations{
+				SBOM: &SBOMAttestation{
+					ScanContext: pulumi.BoolRef(true),
+					ScanStage:   pulumi.BoolRef(true),
+				},
+			},
+		}
+		opts, err := args.validate(true, false)
+		require.NoError(t, err)
+		assert.Equal(t, "type=sbom", opts.Attests[0].String())
+		// Explicit build arguments take precedence.
+		assert.Equal(t, map[string]string{
+			sbomScanContextArg: trueLiteral,
+			sbomScanStageArg:   falseLiteral,
+		}, opts.BuildArgs)
+		assert.Equal(t, map[string]string{sbomScanStageArg: falseLiteral}, args.BuildArgs)
+	})
+
+	t.Run("entitlements", func(t *testing.T) {
+		t.Parallel()
+		host := Host
+		args := ImageArgs{
+			Context: &BuildContext{Context: Context{Location: testdataNoop}},
+			Network: &host,
+			Allow:   []Entitlement{"bad.entitlement"},
+		}
+		_, err := args.validate(true, false)
+		assert.ErrorContains(t, err, "bad.entitlement")
+		assert.ErrorContains(t, err, `requires the "network.host" entitlement`)
+
+		args.Allow = []Entitlement{EntitlementNetworkHost, EntitlementSecurityInsecure}
+		opts, err := args.validate(true, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"network.host", "security.insecure"}, opts.Allow)
+
+		// Unknown entitlements shouldn't fail previews.
+		args.Allow = []Entitlement{""}
+		_, err = args.validate(true, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("resource limits", func(t *testing.T) {
+		t.Parallel()
+		args := ImageArgs{
+			Context:      &BuildContext{Context: Context{Location: testdataNoop}},
+			CgroupParent: "/docker",
+			ShmSize:      "2g",
+			Ulimits:      []Ulimit{{Name: "nofile", Soft: 1024, Hard: pulumi.IntRef(2048)}},
+		}
+		opts, err := args.validate(true, false)
+		require.NoError(t, err)
+		assert.Equal(t, "/docker", opts.CgroupParent)
+		assert.Equal(t, int64(2<<30), opts.ShmSize)
+		assert.Equal(t, []string{"nofile=1024:2048"}, opts.Ulimits)
+
+		args.ShmSize = "lots"
+		args.Ulimits = []Ulimit{{Name: fooName}}
+		_, err = args.validate(true, false)
+		assert.ErrorContains(t, err, "invalid size")
+		assert.ErrorContains(t, err, "invalid ulimit type")
+	})
+
+	t.Run("annotations", func(t *testing.T) {
+		t.Parallel()
+		args := ImageArgs{
+			Context: &BuildContext{Context: Context{Location: testdataNoop}},
+			Annotations: map[string]string{
+				fooName:                            barName,
+				"manifest[linux/arm64]:" + barName: fooName,
+			},
+			Exports: []Export{
+				{Image: &ExportImage{ExportWithAnnotations: ExportWithAnnotations{
+					Annotations: map[string]string{fooName: "override"},
+				}}},
+				{Local: &ExportLocal{Dest: "/tmp"}},
+			},
+		}
+		opts, err := args.validate(true, false)
+		require.NoError(t, err)
+		assert.Len(t, opts.Annotations, 2)
+		// Annotations on the export take precedence.
+		assert.Equal(t, "override", opts.Exports[0].Attrs["annotation."+fooName])
+		assert.Equal(t, fooName, opts.Exports[0].Attrs["annotation-manifest[linux/arm64]."+barName])
+		assert.NotContains(t, opts.Exports[1].Attrs, "annotation."+fooName)
+
+		args.Annotations = map[string]string{"layer:" + fooName: barName}
+		_, err = args.validate(true, false)
+		assert.ErrorContains(t, err, `unknown annotation type "layer"`)
+	})
+
 	t.Run("dockerfile parsing", func(t *testing.T) {
 		t.Parallel()
 		path := "./testdata/Dockerfile.invalid"
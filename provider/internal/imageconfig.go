@@ -0,0 +1,94 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	v1 "github.com/regclient/regclient/types/oci/v1"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+var _ infer.Annotated = (*ImageConfig)(nil)
+
+// ImageConfig is the runtime configuration recorded in a pushed image.
+type ImageConfig struct {
+	Entrypoint   []string          `pulumi:"entrypoint,optional"`
+	Cmd          []string          `pulumi:"cmd,optional"`
+	Env          []string          `pulumi:"env,optional"`
+	ExposedPorts []string          `pulumi:"exposedPorts,optional"`
+	User         string            `pulumi:"user,optional"`
+	WorkingDir   string            `pulumi:"workingDir,optional"`
+	Labels       map[string]string `pulumi:"labels,optional"`
+}
+
+// Annotate sets docstrings on ImageConfig.
+func (c *ImageConfig) Annotate(a infer.Annotator) {
+	a.Describe(&c.Entrypoint, `The image's "ENTRYPOINT".`)
+	a.Describe(&c.Cmd, `The image's "CMD".`)
+	a.Describe(&c.Env, `Environment variables set by "ENV", as "KEY=value" pairs.`)
+	a.Describe(&c.ExposedPorts, dedent(`
+		Ports declared by "EXPOSE", for example "8080/tcp".
+	`))
+	a.Describe(&c.User, `The image's "USER".`)
+	a.Describe(&c.WorkingDir, `The image's "WORKDIR".`)
+	a.Describe(&c.Labels, dedent(`
+		The image's final labels, including any inherited from base images.
+	`))
+}
+
+func newImageConfig(img v1.Image) ImageConfig {
+	return ImageConfig{
+		Entrypoint:   img.Config.Entrypoint,
+		Cmd:          img.Config.Cmd,
+		Env:          img.Config.Env,
+		ExposedPorts: slices.Sorted(maps.Keys(img.Config.ExposedPorts)),
+		User:         img.Config.User,
+		WorkingDir:   img.Config.WorkingDir,
+		Labels:       img.Config.Labels,
+	}
+}
+
+// imageConfigs fetches the config of each platform-specific manifest in
+// digests, which is keyed by platform, unless it's already in fetched.
+func imageConfigs(
+	ctx context.Context,
+	cli Client,
+	ref string,
+	digests map[string]string,
+	fetched map[string]v1.Image,
+) (map[string]ImageConfig, error) {
+	if len(digests) == 0 {
+		return nil, nil
+	}
+
+	configs := map[string]ImageConfig{}
+	err := forEachPlatform(ref, digests, func(platform, ref string) error {
+		img, ok := fetched[platform]
+		if !ok {
+			var err error
+			if img, err = cli.ImageConfig(ctx, ref); err != nil {
+				return err
+			}
+		}
+		configs[platform] = newImageConfig(img)
+		return nil
+	})
+
+	return configs, err
+}
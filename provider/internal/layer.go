@@ -0,0 +1,76 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+var _ infer.Annotated = (*Layer)(nil)
+
+// Layer describes a single layer of a pushed image.
+type Layer struct {
+	Digest    string `pulumi:"digest"`
+	MediaType string `pulumi:"mediaType"`
+	Size      int    `pulumi:"size"`
+}
+
+// Annotate sets docstrings on Layer.
+func (l *Layer) Annotate(a infer.Annotator) {
+	a.Describe(&l.Digest, "The layer's content digest.")
+	a.Describe(&l.MediaType, `The layer's media type, for example "application/vnd.oci.image.layer.v1.tar+gzip".`)
+	a.Describe(&l.Size, "The layer's compressed size in bytes.")
+}
+
+// imageLayers fetches the layers of each platform-specific manifest in
+// digests, which is keyed by platform, along with the total compressed size
+// of those layers.
+func imageLayers(
+	ctx context.Context,
+	cli Client,
+	ref string,
+	digests map[string]string,
+) (map[string][]Layer, map[string]int, error) {
+	if len(digests) == 0 {
+		return nil, nil, nil
+	}
+
+	layers := map[string][]Layer{}
+	sizes := map[string]int{}
+	err := forEachPlatform(ref, digests, func(platform, ref string) error {
+		descriptors, err := cli.Layers(ctx, ref)
+		if err != nil {
+			return err
+		}
+		ll := make([]Layer, 0, len(descriptors))
+		size := 0
+		//nolint:gocritic // Bytes aren't copied in a hot path.
+		for _, d := range descriptors {
+			ll = append(ll, Layer{
+				Digest:    d.Digest.String(),
+				MediaType: d.MediaType,
+				Size:      int(d.Size),
+			})
+			size += int(d.Size)
+		}
+		layers[platform] = ll
+		sizes[platform] = size
+		return nil
+	})
+
+	return layers, sizes, err
+}
@@ -0,0 +1,163 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/moby/buildkit/client/llb/sourceresolver"
+	"github.com/moby/buildkit/frontend/dockerfile/dockerfile2llb"
+	"github.com/moby/buildkit/frontend/dockerfile/instructions"
+	"github.com/moby/buildkit/frontend/dockerfile/linter"
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+	"github.com/moby/buildkit/frontend/dockerui"
+	"github.com/opencontainers/go-digest"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+var _ infer.Annotated = (*Lint)(nil)
+
+// Lint configures the Dockerfile lint rules checked before building.
+type Lint struct {
+	Skip  []string `pulumi:"skip,optional"`
+	Error *bool    `pulumi:"error,optional"`
+}
+
+// Annotate sets docstrings on Lint.
+func (l *Lint) Annotate(a infer.Annotator) {
+	a.Describe(&l.Skip, dedent(`
+		Names of lint rules to skip, like "StageNameCasing", or "all" to
+		disable linting. Rules skipped by the Dockerfile's "# check=skip=..."
+		directive are also skipped.
+	`))
+	a.Describe(&l.Error, dedent(`
+		Report lint violations as errors instead of warnings.
+
+		Defaults to the Dockerfile's "# check=error=..." directive.
+	`))
+}
+
+// lint runs BuildKit's Dockerfile lint rules against a local or inline
+// Dockerfile. Violations are returned as warnings, or as check failures if
+// they're configured to be fatal.
+//
+// Linting happens offline, so base images are treated as empty. Rules which
+// depend on a base image's environment, like "UndefinedVar", are only
+// applied to stages which don't inherit one.
+func (ia *ImageArgs) lint(ctx context.Context) ([]string, error) {
+	df, err := ia.Dockerfile.contents()
+	if err != nil || df == nil {
+		// Read errors are already reported by validate.
+		return nil, nil
+	}
+
+	directive, _, _, _ := parser.ParseDirective("check", df)
+	cfg, err := linter.ParseLintOptions(directive)
+	if err != nil {
+		return nil, errors.Join(newCheckFailure(err, "dockerfile"))
+	}
+	fatal := cfg.ReturnAsError
+	skip := cfg.SkipRules
+	if ia.Lint != nil {
+		skip = append(slices.Clone(skip), ia.Lint.Skip...)
+		if ia.Lint.Error != nil {
+			fatal = *ia.Lint.Error
+		}
+	}
+	if cfg.SkipAll || slices.Contains(skip, "all") {
+		return nil, nil
+	}
+
+	stages, _, err := parseDockerfile(bytes.NewReader(df))
+	if err != nil {
+		// Parse errors are already reported by validate.
+		return nil, nil
+	}
+
+	var violations []string
+	warn := func(rule, _, _, msg string, location []parser.Range) {
+		line := 0
+		if len(location) > 0 {
+			line = location[0].Start.Line
+		}
+		if slices.Contains(skip, rule) {
+			return
+		}
+		if rule == "UndefinedVar" && inheritsImageEnv(stages, line) {
+			return
+		}
+		violations = append(violations, linter.LintFormatShort(rule, msg, line))
+	}
+
+	// Errors are ignored because conversion stops at the first one, and
+	// anything we can detect statically is reported by validate or
+	// crossCheck.
+	_, _ = dockerfile2llb.Dockerfile2LLB(ctx, df, dockerfile2llb.ConvertOpt{
+		Config: dockerui.Config{
+			BuildArgs: ia.BuildArgs,
+			Target:    ia.Target,
+		},
+		AllStages:    ia.Target == "",
+		MetaResolver: offlineResolver{},
+		Warn:         warn,
+	})
+
+	if !fatal {
+		return violations, nil
+	}
+	failures := []error{}
+	for _, v := range violations {
+		failures = append(failures, newCheckFailure(errors.New(v), "dockerfile"))
+	}
+	return nil, errors.Join(failures...)
+}
+
+// inheritsImageEnv returns true if the stage containing the given line is
+// (transitively) based on an external image, whose environment we can't see
+// offline.
+func inheritsImageEnv(stages []instructions.Stage, line int) bool {
+	idx := -1
+	for i, s := range stages {
+		if len(s.Location) > 0 && s.Location[0].Start.Line <= line {
+			idx = i
+		}
+	}
+	for seen := 0; idx >= 0 && seen < len(stages); seen++ {
+		base := stages[idx].BaseName
+		next, ok := stageIndex(stages, base)
+		if !ok {
+			return !strings.EqualFold(base, "scratch")
+		}
+		idx = next
+	}
+	return false
+}
+
+// offlineResolver resolves every base image to an empty image, so linting
+// doesn't depend on a registry.
+type offlineResolver struct{}
+
+func (offlineResolver) ResolveImageConfig(
+	_ context.Context,
+	ref string,
+	_ sourceresolver.Opt,
+) (string, digest.Digest, []byte, error) {
+	return ref, "", []byte("{}"), nil
+}
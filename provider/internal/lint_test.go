@@ -0,0 +1,123 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+	enabled, disabled := true, false
+
+	dockerfile := `
+FROM alpine as Build
+ARG password=hunter2
+
+FROM scratch AS empty
+ENV FOO=$UNDEFINED
+
+FROM build
+ENV BAR=$FROM_BASE_IMAGE
+`
+
+	tests := []struct {
+		name         string
+		dockerfile   string
+		lint         *Lint
+		wantFailures []string
+		wantWarnings []string
+	}{
+		{
+			name:       "warnings",
+			dockerfile: dockerfile,
+			wantWarnings: []string{
+				"StageNameCasing: Stage name 'Build' should be lowercase (line 2)",
+				"FromAsCasing: 'as' and 'FROM' keywords' casing do not match (line 2)",
+				`SecretsUsedInArgOrEnv: Do not use ARG or ENV instructions for sensitive data (ARG "password") (line 3)`,
+				"UndefinedVar: Usage of undefined variable '$UNDEFINED' (line 6)",
+			},
+		},
+		{
+			name:       "skipped",
+			dockerfile: dockerfile,
+			lint:       &Lint{Skip: []string{"StageNameCasing", "FromAsCasing", "UndefinedVar"}},
+			wantWarnings: []string{
+				`SecretsUsedInArgOrEnv: Do not use ARG or ENV instructions for sensitive data (ARG "password") (line 3)`,
+			},
+		},
+		{
+			name:       "skip all",
+			dockerfile: dockerfile,
+			lint:       &Lint{Skip: []string{"all"}},
+		},
+		{
+			name:       "errors",
+			dockerfile: "FROM alpine as build\n",
+			lint:       &Lint{Error: &enabled},
+			wantFailures: []string{
+				"dockerfile: FromAsCasing: 'as' and 'FROM' keywords' casing do not match (line 1)",
+			},
+		},
+		{
+			name:       "directive",
+			dockerfile: "# check=skip=StageNameCasing;error=true\nFROM alpine as Build\n",
+			wantFailures: []string{
+				"dockerfile: FromAsCasing: 'as' and 'FROM' keywords' casing do not match (line 2)",
+			},
+		},
+		{
+			name:       "directive overridden",
+			dockerfile: "# check=error=true\nFROM alpine as build\n",
+			lint:       &Lint{Error: &disabled},
+			wantWarnings: []string{
+				"FromAsCasing: 'as' and 'FROM' keywords' casing do not match (line 2)",
+			},
+		},
+		{
+			name:         "invalid directive",
+			dockerfile:   "# check=bogus=true\nFROM alpine\n",
+			wantFailures: []string{`dockerfile: invalid check option "bogus"`},
+		},
+		{
+			name:       "custom syntax",
+			dockerfile: "# syntax=example.com/custom\nFROM alpine as Build\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ia := ImageArgs{
+				Dockerfile: &Dockerfile{Inline: tt.dockerfile},
+				Lint:       tt.lint,
+			}
+
+			warnings, err := ia.lint(t.Context())
+
+			var failures []string
+			if err != nil {
+				for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+					cf := e.(checkFailure)
+					failures = append(failures, cf.Property+": "+cf.Reason)
+				}
+			}
+			assert.Equal(t, tt.wantFailures, failures)
+			assert.Equal(t, tt.wantWarnings, warnings)
+		})
+	}
+}
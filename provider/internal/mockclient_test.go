@@ -21,6 +21,7 @@ import (
 	client "github.com/moby/buildkit/client"
 	session "github.com/moby/buildkit/session"
 	descriptor "github.com/regclient/regclient/types/descriptor"
+	v1 "github.com/regclient/regclient/types/oci/v1"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -49,10 +50,10 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 }
 
 // Build mocks base method.
-func (m *MockClient) Build(ctx context.Context, b Build) (*client.SolveResponse, error) {
+func (m *MockClient) Build(ctx context.Context, b Build) (*BuildResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Build", ctx, b)
-	ret0, _ := ret[0].(*client.SolveResponse)
+	ret0, _ := ret[0].(*BuildResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -70,19 +71,19 @@ type MockClientBuildCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockClientBuildCall) Return(arg0 *client.SolveResponse, arg1 error) *MockClientBuildCall {
+func (c *MockClientBuildCall) Return(arg0 *BuildResult, arg1 error) *MockClientBuildCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockClientBuildCall) Do(f func(context.Context, Build) (*client.SolveResponse, error)) *MockClientBuildCall {
+func (c *MockClientBuildCall) Do(f func(context.Context, Build) (*BuildResult, error)) *MockClientBuildCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockClientBuildCall) DoAndReturn(f func(context.Context, Build) (*client.SolveResponse, error)) *MockClientBuildCall {
+func (c *MockClientBuildCall) DoAndReturn(f func(context.Context, Build) (*BuildResult, error)) *MockClientBuildCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -164,6 +165,83 @@ func (c *MockClientDeleteCall) DoAndReturn(f func(context.Context, string) error
 	return c
 }
 
+// ExportHistory mocks base method.
+func (m *MockClient) ExportHistory(ctx context.Context, builderName, ref, dest string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportHistory", ctx, builderName, ref, dest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportHistory indicates an expected call of ExportHistory.
+func (mr *MockClientMockRecorder) ExportHistory(ctx, builderName, ref, dest any) *MockClientExportHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportHistory", reflect.TypeOf((*MockClient)(nil).ExportHistory), ctx, builderName, ref, dest)
+	return &MockClientExportHistoryCall{Call: call}
+}
+
+// MockClientExportHistoryCall wrap *gomock.Call
+type MockClientExportHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientExportHistoryCall) Return(arg0 error) *MockClientExportHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientExportHistoryCall) Do(f func(context.Context, string, string, string) error) *MockClientExportHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientExportHistoryCall) DoAndReturn(f func(context.Context, string, string, string) error) *MockClientExportHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ImageConfig mocks base method.
+func (m *MockClient) ImageConfig(ctx context.Context, id string) (v1.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageConfig", ctx, id)
+	ret0, _ := ret[0].(v1.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageConfig indicates an expected call of ImageConfig.
+func (mr *MockClientMockRecorder) ImageConfig(ctx, id any) *MockClientImageConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageConfig", reflect.TypeOf((*MockClient)(nil).ImageConfig), ctx, id)
+	return &MockClientImageConfigCall{Call: call}
+}
+
+// MockClientImageConfigCall wrap *gomock.Call
+type MockClientImageConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientImageConfigCall) Return(arg0 v1.Image, arg1 error) *MockClientImageConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientImageConfigCall) Do(f func(context.Context, string) (v1.Image, error)) *MockClientImageConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientImageConfigCall) DoAndReturn(f func(context.Context, string) (v1.Image, error)) *MockClientImageConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Inspect mocks base method.
 func (m *MockClient) Inspect(ctx context.Context, id string) ([]descriptor.Descriptor, error) {
 	m.ctrl.T.Helper()
@@ -203,6 +281,45 @@ func (c *MockClientInspectCall) DoAndReturn(f func(context.Context, string) ([]d
 	return c
 }
 
+// Layers mocks base method.
+func (m *MockClient) Layers(ctx context.Context, id string) ([]descriptor.Descriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Layers", ctx, id)
+	ret0, _ := ret[0].([]descriptor.Descriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Layers indicates an expected call of Layers.
+func (mr *MockClientMockRecorder) Layers(ctx, id any) *MockClientLayersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Layers", reflect.TypeOf((*MockClient)(nil).Layers), ctx, id)
+	return &MockClientLayersCall{Call: call}
+}
+
+// MockClientLayersCall wrap *gomock.Call
+type MockClientLayersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientLayersCall) Return(arg0 []descriptor.Descriptor, arg1 error) *MockClientLayersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientLayersCall) Do(f func(context.Context, string) ([]descriptor.Descriptor, error)) *MockClientLayersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientLayersCall) DoAndReturn(f func(context.Context, string) ([]descriptor.Descriptor, error)) *MockClientLayersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ManifestCreate mocks base method.
 func (m *MockClient) ManifestCreate(ctx context.Context, push bool, target string, refs ...string) error {
 	m.ctrl.T.Helper()
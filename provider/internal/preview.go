@@ -111,7 +111,8 @@ func (k contextKeeper) keep(bc *BuildContext) *BuildContext {
 	}
 
 	return &BuildContext{
-		Context: Context{bc.Location},
-		Named:   named,
+		Context:  Context{bc.Location},
+		Named:    named,
+		HashMode: bc.HashMode,
 	}
 }
@@ -0,0 +1,70 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"github.com/moby/buildkit/util/progress/progressui"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+var _ = (infer.Enum[ProgressMode])((*ProgressMode)(nil))
+
+// ProgressMode controls how build progress is reported.
+type ProgressMode string
+
+const (
+	// ProgressPlain reports progress as plain text.
+	ProgressPlain ProgressMode = "plain"
+	// ProgressRawJSON reports progress as JSON-encoded status events.
+	ProgressRawJSON ProgressMode = "rawjson"
+	// ProgressQuiet suppresses progress unless the build fails.
+	ProgressQuiet ProgressMode = "quiet"
+)
+
+// Values returns all valid ProgressMode values for SDK generation.
+func (ProgressMode) Values() []infer.EnumValue[ProgressMode] {
+	return []infer.EnumValue[ProgressMode]{
+		{
+			Value:       ProgressPlain,
+			Description: "Stream plain-text progress as the build runs.",
+		},
+		{
+			Value:       ProgressRawJSON,
+			Description: "Log each build status update as a line of JSON.",
+		},
+		{
+			Value:       ProgressQuiet,
+			Description: "Only report progress if the build fails.",
+		},
+	}
+}
+
+func (p *ProgressMode) String() string {
+	if p == nil {
+		return string(ProgressPlain)
+	}
+	return string(*p)
+}
+
+// displayMode returns the mode used to render build progress. Quiet builds
+// are still rendered as plain text so we have something to show if the build
+// fails.
+func (p ProgressMode) displayMode() progressui.DisplayMode {
+	if p == ProgressRawJSON {
+		return progressui.RawJSONMode
+	}
+	return progressui.PlainMode
+}
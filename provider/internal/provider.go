@@ -39,8 +39,15 @@ var (
 
 // Config configures the buildx provider.
 type Config struct {
-	Host       string     `pulumi:"host,optional"`
-	Registries []Registry `pulumi:"registries,optional"`
+	Host                    string        `pulumi:"host,optional"`
+	Registries              []Registry    `pulumi:"registries,optional"`
+	Progress                *ProgressMode `pulumi:"progress,optional"`
+	BuildLogDir             string        `pulumi:"buildLogDir,optional"`
+	OTLPEndpoint            string        `pulumi:"otlpEndpoint,optional"`
+	WarningsAsErrors        *bool         `pulumi:"warningsAsErrors,optional"`
+	AllowedBaseImages       []string      `pulumi:"allowedBaseImages,optional"`
+	RequirePinnedBaseImages *bool         `pulumi:"requirePinnedBaseImages,optional"`
+	ContextHashCacheDir     string        `pulumi:"contextHashCacheDir,optional"`
 
 	host *host
 }
@@ -49,6 +56,46 @@ type Config struct {
 func (c *Config) Annotate(a infer.Annotator) {
 	a.Describe(&c.Host, "The build daemon's address.")
 	a.SetDefault(&c.Host, "", "DOCKER_HOST")
+	a.Describe(&c.Progress, dedent(`
+		How to report build progress for images which don't specify their own
+		"progress". Defaults to "plain".
+	`))
+	a.Describe(&c.BuildLogDir, dedent(`
+		A directory to write the full log of each image build to, for images
+		which don't specify their own "buildLogPath".
+
+		Logs are named after the image's first tag, or the resource's name if
+		it has no tags.
+	`))
+	a.Describe(&c.OTLPEndpoint, dedent(`
+		An OTLP/HTTP endpoint, like "http://localhost:4318", to export traces
+		of resource operations and builds to. gRPC endpoints aren't supported.
+
+		Spans join the trace in the "TRACEPARENT" environment variable, if set,
+		and trace context is propagated to the build daemon.
+	`))
+	a.SetDefault(&c.OTLPEndpoint, "", "PULUMI_DOCKER_BUILD_OTLP_ENDPOINT")
+	a.Describe(&c.WarningsAsErrors, dedent(`
+		Fail image builds if BuildKit reports any warnings, for images which
+		don't specify their own "warningsAsErrors".
+	`))
+	a.Describe(&c.AllowedBaseImages, dedent(`
+		Glob patterns, like "docker.io/library/*", that every image's "FROM"
+		instructions must match. This applies in addition to each image's own
+		"allowedBaseImages".
+	`))
+	a.Describe(&c.RequirePinnedBaseImages, dedent(`
+		Require external "FROM" and "COPY --from" images to be pinned with an
+		"@sha256:" digest, for images which don't specify their own
+		"requirePinnedBaseImages".
+	`))
+	a.Describe(&c.ContextHashCacheDir, dedent(`
+		A directory to cache build context hashes in, so files which haven't
+		changed aren't read again on later runs.
+
+		Files are considered unchanged if their size, modification time and
+		inode are the same. The resulting "contextHash" is unaffected.
+	`))
 }
 
 // Configure validates and processes user-provided configuration values.
@@ -58,6 +105,15 @@ func (c *Config) Configure(ctx context.Context) error {
 		return fmt.Errorf("getting host: %w", err)
 	}
 	c.host = h
+
+	if c.OTLPEndpoint != "" {
+		tp, err := newTracerProvider(ctx, c.OTLPEndpoint)
+		if err != nil {
+			return fmt.Errorf("configuring tracing: %w", err)
+		}
+		setTracerProvider(ctx, tp)
+	}
+
 	return nil
 }
 
@@ -135,7 +191,7 @@ func NewBuildxProvider(clientF clientF) provider.Provider {
 		},
 	)
 
-	return prov
+	return traced(prov)
 }
 
 // Schema returns our package specification.
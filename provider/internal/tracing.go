@@ -0,0 +1,207 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/moby/buildkit/util/tracing/childprocess"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	provider "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+const (
+	// tracerName is the instrumentation scope of our spans.
+	tracerName = "github.com/pulumi/pulumi-docker-build"
+
+	// serviceName identifies the provider in exported traces.
+	serviceName = "pulumi-resource-docker-build"
+
+	// flushTimeout bounds how long we wait for spans to be exported at the
+	// end of each operation, or when the provider exits.
+	flushTimeout = 5 * time.Second
+)
+
+// startSpan starts a span with the given name. Spans are no-ops unless
+// tracing has been configured.
+func startSpan(
+	ctx context.Context,
+	name string,
+	attrs ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan records err, if any, and ends the span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// newTracerProvider returns a TracerProvider which exports spans to an
+// OTLP/HTTP endpoint like "http://localhost:4318".
+func newTracerProvider(ctx context.Context, endpoint string) (*sdktrace.TracerProvider, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("%q must be an http or https URL", endpoint)
+	}
+
+	exp, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	// Spans are batched so a slow collector doesn't block them as they end.
+	// The engine can kill us without warning, so we also flush at the end of
+	// each operation.
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(sdkresource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+		)),
+	), nil
+}
+
+// setTracerProvider installs tp as the global TracerProvider, shutting down
+// any provider we installed previously so its buffered spans aren't lost. It
+// also installs the W3C trace context propagator, so the trace is propagated
+// to BuildKit and any other instrumented clients.
+func setTracerProvider(ctx context.Context, tp *sdktrace.TracerProvider) {
+	ShutdownTracing(ctx)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+// flushTracing exports any spans which have ended, if tracing has been
+// configured.
+func flushTracing(ctx context.Context) {
+	tp, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), flushTimeout)
+	defer cancel()
+	_ = tp.ForceFlush(ctx)
+}
+
+// ShutdownTracing exports any remaining spans and stops tracing, if it has
+// been configured. It should be called before the provider exits.
+func ShutdownTracing(ctx context.Context) {
+	tp, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, flushTimeout)
+	defer cancel()
+	_ = tp.Shutdown(ctx)
+}
+
+// withEnvTraceParent returns a context parented to the "TRACEPARENT"
+// environment variable, if it's set, so our spans can join a trace started by
+// whatever invoked "pulumi". The context is returned unchanged if it already
+// has a span.
+func withEnvTraceParent(ctx context.Context) context.Context {
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	parent := os.Getenv("TRACEPARENT")
+	if parent == "" {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{
+		"traceparent": parent,
+		"tracestate":  os.Getenv("TRACESTATE"),
+	})
+}
+
+// tracingEnv returns environment variables which let a child buildx process
+// join the current trace and export its own spans to endpoint.
+func tracingEnv(ctx context.Context, endpoint string) []string {
+	env := childprocess.Environ(ctx)
+	if len(env) > 0 && endpoint != "" {
+		env = append(env,
+			"OTEL_EXPORTER_OTLP_ENDPOINT="+endpoint,
+			"OTEL_EXPORTER_OTLP_PROTOCOL=http/protobuf",
+		)
+	}
+	return env
+}
+
+// traced wraps a provider's resource operations in spans named after the
+// resource type and operation, for example "Image.Create".
+func traced(p provider.Provider) provider.Provider {
+	p.Check = tracedOp(p.Check, "Check", func(r provider.CheckRequest) resource.URN { return r.Urn })
+	p.Diff = tracedOp(p.Diff, "Diff", func(r provider.DiffRequest) resource.URN { return r.Urn })
+	p.Create = tracedOp(p.Create, "Create", func(r provider.CreateRequest) resource.URN { return r.Urn })
+	p.Read = tracedOp(p.Read, "Read", func(r provider.ReadRequest) resource.URN { return r.Urn })
+	p.Update = tracedOp(p.Update, "Update", func(r provider.UpdateRequest) resource.URN { return r.Urn })
+	if del := p.Delete; del != nil {
+		p.Delete = func(ctx context.Context, req provider.DeleteRequest) (err error) {
+			ctx, span := startOpSpan(ctx, "Delete", req.Urn)
+			defer func() {
+				endSpan(span, err)
+				flushTracing(ctx)
+			}()
+			return del(ctx, req)
+		}
+	}
+	return p
+}
+
+func tracedOp[Req, Resp any](
+	f func(context.Context, Req) (Resp, error),
+	op string,
+	urn func(Req) resource.URN,
+) func(context.Context, Req) (Resp, error) {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, req Req) (_ Resp, err error) {
+		ctx, span := startOpSpan(ctx, op, urn(req))
+		defer func() {
+			endSpan(span, err)
+			flushTracing(ctx)
+		}()
+		return f(ctx, req)
+	}
+}
+
+// startOpSpan starts a span for a resource operation.
+func startOpSpan(ctx context.Context, op string, urn resource.URN) (context.Context, trace.Span) {
+	name := op
+	if urn.IsValid() {
+		name = urn.Type().Name().String() + "." + op
+	}
+	return startSpan(withEnvTraceParent(ctx), name, attribute.String("pulumi.urn", string(urn)))
+}
@@ -0,0 +1,274 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	controlapi "github.com/moby/buildkit/api/services/control"
+	bkclient "github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	provider "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// collector is a stand-in for an OTLP/HTTP collector which records the spans
+// it receives.
+type collector struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	spans map[string]*tracepb.Span
+}
+
+func newCollector(t *testing.T) *collector {
+	c := &collector{spans: map[string]*tracepb.Span{}}
+	c.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var req coltracepb.ExportTraceServiceRequest
+		require.NoError(t, proto.Unmarshal(body, &req))
+
+		c.mu.Lock()
+		for _, rs := range req.GetResourceSpans() {
+			for _, ss := range rs.GetScopeSpans() {
+				for _, s := range ss.GetSpans() {
+					c.spans[s.GetName()] = s
+				}
+			}
+		}
+		c.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(c.Close)
+	return c
+}
+
+func (c *collector) span(t *testing.T, name string) *tracepb.Span {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.spans[name]
+	require.True(t, ok, "missing span %q", name)
+	return s
+}
+
+// useCollector exports spans to a new collector for the duration of the test.
+func useCollector(t *testing.T) *collector {
+	c := newCollector(t)
+
+	tp, err := newTracerProvider(t.Context(), c.URL)
+	require.NoError(t, err)
+
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+		_ = tp.Shutdown(context.Background())
+	})
+
+	return c
+}
+
+//nolint:paralleltest // Overrides the global tracer provider.
+func TestTraced(t *testing.T) {
+	urn := resource.URN("urn:pulumi:stack::project::docker-build:index:Image::img")
+
+	p := traced(provider.Provider{
+		Create: func(ctx context.Context, _ provider.CreateRequest) (provider.CreateResponse, error) {
+			_, span := startSpan(ctx, "child")
+			endSpan(span, nil)
+			return provider.CreateResponse{ID: "id"}, nil
+		},
+		Delete: func(context.Context, provider.DeleteRequest) error {
+			return errors.New("boom")
+		},
+	})
+
+	t.Run("spans", func(t *testing.T) {
+		c := useCollector(t)
+
+		resp, err := p.Create(t.Context(), provider.CreateRequest{Urn: urn})
+		require.NoError(t, err)
+		assert.Equal(t, "id", resp.ID)
+
+		err = p.Delete(t.Context(), provider.DeleteRequest{Urn: urn})
+		assert.ErrorContains(t, err, "boom")
+
+		create := c.span(t, "Image.Create")
+		child := c.span(t, "child")
+		assert.Equal(t, create.GetTraceId(), child.GetTraceId())
+		assert.Equal(t, create.GetSpanId(), child.GetParentSpanId())
+
+		del := c.span(t, "Image.Delete")
+		assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, del.GetStatus().GetCode())
+		assert.NotEqual(t, create.GetTraceId(), del.GetTraceId())
+	})
+
+	t.Run("TRACEPARENT", func(t *testing.T) {
+		c := useCollector(t)
+		t.Setenv("TRACEPARENT", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+
+		_, err := p.Create(t.Context(), provider.CreateRequest{Urn: urn})
+		require.NoError(t, err)
+
+		create := c.span(t, "Image.Create")
+		assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", hex.EncodeToString(create.GetTraceId()))
+		assert.Equal(t, "b7ad6b7169203331", hex.EncodeToString(create.GetParentSpanId()))
+	})
+
+	t.Run("unconfigured", func(t *testing.T) {
+		_, err := p.Create(t.Context(), provider.CreateRequest{Urn: urn})
+		require.NoError(t, err)
+	})
+}
+
+// controlServer is a stand-in for BuildKit's control API which records the
+// metadata of each solve.
+type controlServer struct {
+	controlapi.UnimplementedControlServer
+
+	mu       sync.Mutex
+	metadata metadata.MD
+}
+
+func (s *controlServer) Solve(ctx context.Context, _ *controlapi.SolveRequest) (*controlapi.SolveResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.mu.Lock()
+	s.metadata = md
+	s.mu.Unlock()
+	return &controlapi.SolveResponse{}, nil
+}
+
+func (s *controlServer) Status(*controlapi.StatusRequest, controlapi.Control_StatusServer) error {
+	return nil
+}
+
+//nolint:paralleltest // Overrides the global tracer provider.
+func TestSolveTraceContext(t *testing.T) {
+	_ = useCollector(t)
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	control := &controlServer{}
+	controlapi.RegisterControlServer(srv, control)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	// Like builderFor, create the client within a span.
+	ctx, span := startSpan(t.Context(), "host.builderFor")
+	c, err := bkclient.New(ctx, "", bkclient.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+	endSpan(span, err)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = c.Close() })
+
+	sess, err := session.NewSession(t.Context(), "test")
+	require.NoError(t, err)
+
+	ctx, span = startSpan(t.Context(), "cli.Build")
+	defer endSpan(span, nil)
+	_, err = c.Solve(ctx, nil, bkclient.SolveOpt{
+		Frontend:              "dockerfile.v0",
+		SharedSession:         sess,
+		SessionPreInitialized: true,
+	}, nil)
+	require.NoError(t, err)
+
+	control.mu.Lock()
+	defer control.mu.Unlock()
+	traceparent := control.metadata.Get("traceparent")
+	require.Len(t, traceparent, 1)
+	assert.Contains(t, traceparent[0], span.SpanContext().TraceID().String())
+}
+
+//nolint:paralleltest // Overrides the global tracer provider.
+func TestSetTracerProvider(t *testing.T) {
+	prev, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		ShutdownTracing(context.Background())
+		otel.SetTracerProvider(prev)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	first, err := newTracerProvider(t.Context(), "http://localhost:4318")
+	require.NoError(t, err)
+	setTracerProvider(t.Context(), first)
+
+	second, err := newTracerProvider(t.Context(), "http://localhost:4318")
+	require.NoError(t, err)
+	setTracerProvider(t.Context(), second)
+
+	// The first provider was shut down, so its spans are no longer recorded.
+	_, span := first.Tracer(tracerName).Start(t.Context(), "span")
+	assert.False(t, span.IsRecording())
+	assert.Equal(t, second, otel.GetTracerProvider())
+	assert.Contains(t, otel.GetTextMapPropagator().Fields(), "traceparent")
+}
+
+func TestTracingEnv(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, tracingEnv(t.Context(), "http://localhost:4318"))
+
+	traceID, err := trace.TraceIDFromHex("0af7651916cd43dd8448eb211c80319c")
+	require.NoError(t, err)
+	spanID, err := trace.SpanIDFromHex("b7ad6b7169203331")
+	require.NoError(t, err)
+	ctx := trace.ContextWithSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	env := tracingEnv(ctx, "http://localhost:4318")
+	assert.Contains(t, env, "TRACEPARENT=00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	assert.Contains(t, env, "OTEL_EXPORTER_OTLP_ENDPOINT=http://localhost:4318")
+
+	assert.NotContains(t, tracingEnv(ctx, ""), "OTEL_EXPORTER_OTLP_ENDPOINT=http://localhost:4318")
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	t.Parallel()
+
+	_, err := newTracerProvider(t.Context(), "localhost:4317")
+	assert.ErrorContains(t, err, "must be an http or https URL")
+
+	tp, err := newTracerProvider(t.Context(), "http://localhost:4318")
+	require.NoError(t, err)
+	assert.NoError(t, tp.Shutdown(t.Context()))
+}
@@ -0,0 +1,67 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+
+	dockeropts "github.com/docker/cli/opts"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+var (
+	_ fmt.Stringer    = Ulimit{}
+	_ infer.Annotated = (*Ulimit)(nil)
+)
+
+// Ulimit is a resource limit applied to "RUN" instructions.
+type Ulimit struct {
+	Name string `pulumi:"name"`
+	Soft int    `pulumi:"soft"`
+	Hard *int   `pulumi:"hard,optional"`
+}
+
+// Annotate sets docstrings on Ulimit.
+func (u *Ulimit) Annotate(a infer.Annotator) {
+	a.Describe(&u.Name, dedent(`
+		The name of the limit, for example "nofile" or "nproc".
+	`))
+	a.Describe(&u.Soft, dedent(`
+		The soft limit. Use "-1" for unlimited.
+	`))
+	a.Describe(&u.Hard, dedent(`
+		The hard limit. Defaults to the soft limit.
+	`))
+}
+
+// String returns a CLI-encoded value for the ulimit, or an empty string if its
+// name is not known.
+func (u Ulimit) String() string {
+	if u.Name == "" {
+		return ""
+	}
+	if u.Hard == nil {
+		return fmt.Sprintf("%s=%d", u.Name, u.Soft)
+	}
+	return fmt.Sprintf("%s=%d:%d", u.Name, u.Soft, *u.Hard)
+}
+
+func (u Ulimit) validate() (string, error) {
+	if err := dockeropts.NewUlimitOpt(nil).Set(u.String()); err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
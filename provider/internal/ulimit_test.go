@@ -0,0 +1,70 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func TestValidateUlimit(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		ulimit Ulimit
+
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "soft only",
+			ulimit: Ulimit{Name: "nofile", Soft: 1024},
+			want:   "nofile=1024",
+		},
+		{
+			name:   "soft and hard",
+			ulimit: Ulimit{Name: "nofile", Soft: 1024, Hard: pulumi.IntRef(65536)},
+			want:   "nofile=1024:65536",
+		},
+		{
+			name:    "invalid name",
+			ulimit:  Ulimit{Name: fooName, Soft: 1},
+			wantErr: "invalid ulimit type: foo",
+		},
+		{
+			name:    "soft exceeds hard",
+			ulimit:  Ulimit{Name: "nproc", Soft: 2, Hard: pulumi.IntRef(1)},
+			wantErr: "must be less than or equal to hard limit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := tt.ulimit.validate()
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, actual)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/moby/buildkit/client"
+)
+
+// buildWarningsKey is the key buildx's metadata file records a build's
+// warnings under. Its value is a JSON-encoded list of warnings, which
+// execBuild further base64-encodes.
+const buildWarningsKey = "buildx.build.warnings"
+
+// buildWarnings extracts warnings from the metadata of an exec build.
+func buildWarnings(resp map[string]string) []client.VertexWarning {
+	encoded, ok := resp[buildWarningsKey]
+	if !ok {
+		return nil
+	}
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil
+	}
+	var warnings []client.VertexWarning
+	if err := json.Unmarshal(raw, &warnings); err != nil {
+		return nil
+	}
+	return warnings
+}
+
+// warningRule returns the name of the rule which produced a warning, for
+// example "StageNameCasing" for Dockerfile lint violations. Returns an empty
+// string if the warning isn't attributed to a rule.
+func warningRule(w client.VertexWarning) string {
+	rule, _, ok := strings.Cut(string(w.Short), ":")
+	if !ok || strings.ContainsAny(rule, " \t") {
+		return ""
+	}
+	return rule
+}
+
+// warningsError returns an error describing any warnings which weren't
+// produced by one of the ignored rules.
+func warningsError(warnings []client.VertexWarning, ignored []string) error {
+	msgs := []string{}
+	//nolint:gocritic // Bytes aren't copied in a hot path.
+	for _, w := range warnings {
+		if rule := warningRule(w); rule != "" && slices.Contains(ignored, rule) {
+			continue
+		}
+		msgs = append(msgs, "  "+string(w.Short))
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("the build produced %d warning(s) and \"warningsAsErrors\" is enabled:\n%s",
+		len(msgs), strings.Join(msgs, "\n"))
+}
@@ -0,0 +1,95 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/moby/buildkit/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	stageNameCasing = client.VertexWarning{
+		Short: []byte("StageNameCasing: Stage name 'Build' should be lowercase (line 1)"),
+		URL:   "https://docs.docker.com/go/dockerfile/rule/stage-name-casing/",
+	}
+	jsonArgsRecommended = client.VertexWarning{
+		Short: []byte("JSONArgsRecommended: JSON arguments recommended for CMD (line 3)"),
+	}
+	unruled = client.VertexWarning{
+		Short: []byte("Empty continuation line found in: RUN echo hello"),
+	}
+)
+
+func TestBuildWarnings(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, buildWarnings(nil))
+	assert.Nil(t, buildWarnings(map[string]string{buildWarningsKey: "not base64"}))
+
+	raw, err := json.Marshal([]client.VertexWarning{stageNameCasing, unruled})
+	require.NoError(t, err)
+
+	got := buildWarnings(map[string]string{buildWarningsKey: base64.StdEncoding.EncodeToString(raw)})
+	require.Len(t, got, 2)
+	assert.Equal(t, stageNameCasing.Short, got[0].Short)
+	assert.Equal(t, stageNameCasing.URL, got[0].URL)
+	assert.Equal(t, unruled.Short, got[1].Short)
+}
+
+func TestWarningRule(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "StageNameCasing", warningRule(stageNameCasing))
+	assert.Equal(t, "JSONArgsRecommended", warningRule(jsonArgsRecommended))
+	assert.Empty(t, warningRule(unruled))
+	assert.Empty(t, warningRule(client.VertexWarning{}))
+}
+
+func TestWarningsError(t *testing.T) {
+	t.Parallel()
+
+	warnings := []client.VertexWarning{stageNameCasing, jsonArgsRecommended, unruled}
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, warningsError(nil, nil))
+	})
+
+	t.Run("all", func(t *testing.T) {
+		t.Parallel()
+		err := warningsError(warnings, nil)
+		assert.ErrorContains(t, err, "3 warning(s)")
+		assert.ErrorContains(t, err, "StageNameCasing: Stage name 'Build' should be lowercase (line 1)")
+		assert.ErrorContains(t, err, "Empty continuation line")
+	})
+
+	t.Run("ignored", func(t *testing.T) {
+		t.Parallel()
+		err := warningsError(warnings, []string{"StageNameCasing", "JSONArgsRecommended"})
+		assert.ErrorContains(t, err, "1 warning(s)")
+		assert.NotContains(t, err.Error(), "StageNameCasing")
+	})
+
+	t.Run("all ignored", func(t *testing.T) {
+		t.Parallel()
+		err := warningsError(warnings[:2], []string{"StageNameCasing", "JSONArgsRecommended"})
+		assert.NoError(t, err)
+	})
+}
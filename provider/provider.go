@@ -15,6 +15,8 @@
 package provider
 
 import (
+	"context"
+
 	gp "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/provider"
 	rpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
@@ -30,6 +32,7 @@ const Name string = "docker-build"
 
 // Serve launches the gRPC server for the resource provider.
 func Serve() error {
+	defer internal.ShutdownTracing(context.Background())
 	return provider.Main(Name, New)
 }
 
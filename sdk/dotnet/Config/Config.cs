@@ -32,6 +32,46 @@ namespace Pulumi.DockerBuild
 
         private static readonly global::Pulumi.Config __config = new global::Pulumi.Config("docker-build");
 
+        private static readonly __Value<ImmutableArray<string>> _allowedBaseImages = new __Value<ImmutableArray<string>>(() => __config.GetObject<ImmutableArray<string>>("allowedBaseImages"));
+        /// <summary>
+        /// Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+        /// instructions must match. This applies in addition to each image's own
+        /// `allowedBaseImages`.
+        /// </summary>
+        public static ImmutableArray<string> AllowedBaseImages
+        {
+            get => _allowedBaseImages.Get();
+            set => _allowedBaseImages.Set(value);
+        }
+
+        private static readonly __Value<string?> _buildLogDir = new __Value<string?>(() => __config.Get("buildLogDir"));
+        /// <summary>
+        /// A directory to write the full log of each image build to, for images
+        /// which don't specify their own `buildLogPath`.
+        /// 
+        /// Logs are named after the image's first tag, or the resource's name if
+        /// it has no tags.
+        /// </summary>
+        public static string? BuildLogDir
+        {
+            get => _buildLogDir.Get();
+            set => _buildLogDir.Set(value);
+        }
+
+        private static readonly __Value<string?> _contextHashCacheDir = new __Value<string?>(() => __config.Get("contextHashCacheDir"));
+        /// <summary>
+        /// A directory to cache build context hashes in, so files which haven't
+        /// changed aren't read again on later runs.
+        /// 
+        /// Files are considered unchanged if their size, modification time and
+        /// inode are the same. The resulting `contextHash` is unaffected.
+        /// </summary>
+        public static string? ContextHashCacheDir
+        {
+            get => _contextHashCacheDir.Get();
+            set => _contextHashCacheDir.Set(value);
+        }
+
         private static readonly __Value<string?> _host = new __Value<string?>(() => __config.Get("host") ?? Utilities.GetEnv("DOCKER_HOST") ?? "");
         /// <summary>
         /// The build daemon's address.
@@ -42,6 +82,31 @@ namespace Pulumi.DockerBuild
             set => _host.Set(value);
         }
 
+        private static readonly __Value<string?> _otlpEndpoint = new __Value<string?>(() => __config.Get("otlpEndpoint") ?? Utilities.GetEnv("PULUMI_DOCKER_BUILD_OTLP_ENDPOINT") ?? "");
+        /// <summary>
+        /// An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+        /// of resource operations and builds to. gRPC endpoints aren't supported.
+        /// 
+        /// Spans join the trace in the `TRACEPARENT` environment variable, if set,
+        /// and trace context is propagated to the build daemon.
+        /// </summary>
+        public static string? OtlpEndpoint
+        {
+            get => _otlpEndpoint.Get();
+            set => _otlpEndpoint.Set(value);
+        }
+
+        private static readonly __Value<Pulumi.DockerBuild.ProgressMode?> _progress = new __Value<Pulumi.DockerBuild.ProgressMode?>(() => __config.GetObject<Pulumi.DockerBuild.ProgressMode>("progress"));
+        /// <summary>
+        /// How to report build progress for images which don't specify their own
+        /// `progress`. Defaults to `plain`.
+        /// </summary>
+        public static Pulumi.DockerBuild.ProgressMode? Progress
+        {
+            get => _progress.Get();
+            set => _progress.Set(value);
+        }
+
         private static readonly __Value<ImmutableArray<Types.Registry>> _registries = new __Value<ImmutableArray<Types.Registry>>(() => __config.GetObject<ImmutableArray<Types.Registry>>("registries"));
         public static ImmutableArray<Types.Registry> Registries
         {
@@ -49,6 +114,29 @@ namespace Pulumi.DockerBuild
             set => _registries.Set(value);
         }
 
+        private static readonly __Value<bool?> _requirePinnedBaseImages = new __Value<bool?>(() => __config.GetBoolean("requirePinnedBaseImages"));
+        /// <summary>
+        /// Require external `FROM` and `COPY --from` images to be pinned with an
+        /// `@sha256:` digest, for images which don't specify their own
+        /// `requirePinnedBaseImages`.
+        /// </summary>
+        public static bool? RequirePinnedBaseImages
+        {
+            get => _requirePinnedBaseImages.Get();
+            set => _requirePinnedBaseImages.Set(value);
+        }
+
+        private static readonly __Value<bool?> _warningsAsErrors = new __Value<bool?>(() => __config.GetBoolean("warningsAsErrors"));
+        /// <summary>
+        /// Fail image builds if BuildKit reports any warnings, for images which
+        /// don't specify their own `warningsAsErrors`.
+        /// </summary>
+        public static bool? WarningsAsErrors
+        {
+            get => _warningsAsErrors.Get();
+            set => _warningsAsErrors.Set(value);
+        }
+
         public static class Types
         {
 
@@ -79,6 +79,74 @@ namespace Pulumi.DockerBuild
         public override string ToString() => _value;
     }
 
+    [EnumType]
+    public readonly struct ContextHashMode : IEquatable<ContextHashMode>
+    {
+        private readonly string _value;
+
+        private ContextHashMode(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Hash every file in the context which isn't excluded by ".dockerignore".
+        /// </summary>
+        public static ContextHashMode All { get; } = new ContextHashMode("all");
+        /// <summary>
+        /// Hash only the files reachable from "COPY" and "ADD" sources, falling back to every file when they can't be determined statically.
+        /// </summary>
+        public static ContextHashMode Referenced { get; } = new ContextHashMode("referenced");
+
+        public static bool operator ==(ContextHashMode left, ContextHashMode right) => left.Equals(right);
+        public static bool operator !=(ContextHashMode left, ContextHashMode right) => !left.Equals(right);
+
+        public static explicit operator string(ContextHashMode value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is ContextHashMode other && Equals(other);
+        public bool Equals(ContextHashMode other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct Entitlement : IEquatable<Entitlement>
+    {
+        private readonly string _value;
+
+        private Entitlement(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Allow "RUN --network=host" and the "host" network mode.
+        /// </summary>
+        public static Entitlement Network_host { get; } = new Entitlement("network.host");
+        /// <summary>
+        /// Allow "RUN --security=insecure" to run privileged containers.
+        /// </summary>
+        public static Entitlement Security_insecure { get; } = new Entitlement("security.insecure");
+
+        public static bool operator ==(Entitlement left, Entitlement right) => left.Equals(right);
+        public static bool operator !=(Entitlement left, Entitlement right) => !left.Equals(right);
+
+        public static explicit operator string(Entitlement value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is Entitlement other && Equals(other);
+        public bool Equals(Entitlement other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
     [EnumType]
     public readonly struct NetworkMode : IEquatable<NetworkMode>
     {
@@ -170,4 +238,76 @@ namespace Pulumi.DockerBuild
 
         public override string ToString() => _value;
     }
+
+    [EnumType]
+    public readonly struct ProgressMode : IEquatable<ProgressMode>
+    {
+        private readonly string _value;
+
+        private ProgressMode(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Stream plain-text progress as the build runs.
+        /// </summary>
+        public static ProgressMode Plain { get; } = new ProgressMode("plain");
+        /// <summary>
+        /// Log each build status update as a line of JSON.
+        /// </summary>
+        public static ProgressMode Rawjson { get; } = new ProgressMode("rawjson");
+        /// <summary>
+        /// Only report progress if the build fails.
+        /// </summary>
+        public static ProgressMode Quiet { get; } = new ProgressMode("quiet");
+
+        public static bool operator ==(ProgressMode left, ProgressMode right) => left.Equals(right);
+        public static bool operator !=(ProgressMode left, ProgressMode right) => !left.Equals(right);
+
+        public static explicit operator string(ProgressMode value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is ProgressMode other && Equals(other);
+        public bool Equals(ProgressMode other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct ProvenanceMode : IEquatable<ProvenanceMode>
+    {
+        private readonly string _value;
+
+        private ProvenanceMode(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Only include a minimal description of the build.
+        /// </summary>
+        public static ProvenanceMode Min { get; } = new ProvenanceMode("min");
+        /// <summary>
+        /// Include detailed information about the build, including build arguments and source mappings.
+        /// </summary>
+        public static ProvenanceMode Max { get; } = new ProvenanceMode("max");
+
+        public static bool operator ==(ProvenanceMode left, ProvenanceMode right) => left.Equals(right);
+        public static bool operator !=(ProvenanceMode left, ProvenanceMode right) => !left.Equals(right);
+
+        public static explicit operator string(ProvenanceMode value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is ProvenanceMode other && Equals(other);
+        public bool Equals(ProvenanceMode other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
 }
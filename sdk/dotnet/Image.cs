@@ -504,6 +504,84 @@ namespace Pulumi.DockerBuild
         [Output("addHosts")]
         public Output<ImmutableArray<string>> AddHosts { get; private set; } = null!;
 
+        /// <summary>
+        /// Extra privileges to grant to the build.
+        /// 
+        /// The builder must also be configured to permit these entitlements,
+        /// for example with `--allow-insecure-entitlement`.
+        /// 
+        /// Equivalent to Docker's `--allow` flag.
+        /// </summary>
+        [Output("allow")]
+        public Output<ImmutableArray<Pulumi.DockerBuild.Entitlement>> Allow { get; private set; } = null!;
+
+        /// <summary>
+        /// Glob patterns, like `docker.io/library/*` or
+        /// `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+        /// are matched against fully-qualified references such as
+        /// `docker.io/library/alpine:latest` and their repository names, and `*`
+        /// doesn't match `/`.
+        /// 
+        /// ARGs are substituted from `buildArgs`. References to other stages and
+        /// to `named` contexts are not restricted.
+        /// 
+        /// Images must also match the provider's `allowedBaseImages`, if set.
+        /// </summary>
+        [Output("allowedBaseImages")]
+        public Output<ImmutableArray<string>> AllowedBaseImages { get; private set; } = null!;
+
+        /// <summary>
+        /// Attach arbitrary key/value annotations to the image's manifests.
+        /// 
+        /// Keys may be prefixed with the level to annotate -- `manifest`,
+        /// `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+        /// qualified with a platform, for example
+        /// `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+        /// levels can be separated by commas. Defaults to `manifest`.
+        /// 
+        /// Annotations are applied to every `image`, `registry`, `oci` and
+        /// `docker` export. Annotations set directly on an export take
+        /// precedence.
+        /// 
+        /// Equivalent to Docker's `--annotation` flag.
+        /// </summary>
+        [Output("annotations")]
+        public Output<ImmutableDictionary<string, string>?> Annotations { get; private set; } = null!;
+
+        /// <summary>
+        /// Attestations to attach to the image, such as an SBOM or SLSA
+        /// provenance.
+        /// 
+        /// Attestations are only exported with `image`, `registry` or `oci`
+        /// exports, and require a builder which supports them (the legacy
+        /// `docker` driver does not).
+        /// 
+        /// Equivalent to Docker's `--attest` flag.
+        /// </summary>
+        [Output("attestations")]
+        public Output<Outputs.Attestations?> Attestations { get; private set; } = null!;
+
+        /// <summary>
+        /// The digest each external `FROM` image resolved to when the image was
+        /// built, keyed by its fully-qualified reference.
+        /// 
+        /// Only recorded for images with `pull`, which are rebuilt when any of
+        /// these digests has moved.
+        /// </summary>
+        [Output("baseImageDigests")]
+        public Output<ImmutableDictionary<string, string>?> BaseImageDigests { get; private set; } = null!;
+
+        /// <summary>
+        /// Pin every external `FROM` and `COPY --from` image to the digest it
+        /// resolved to on the first build.
+        /// 
+        /// Later builds substitute `docker-image://&lt;ref&gt;@&lt;digest&gt;` named
+        /// contexts for those images, so they don't change until the lock's
+        /// `refresh` value does.
+        /// </summary>
+        [Output("baseImageLock")]
+        public Output<Outputs.BaseImageLock?> BaseImageLock { get; private set; } = null!;
+
         /// <summary>
         /// `ARG` names and values to set during the build.
         /// 
@@ -518,6 +596,23 @@ namespace Pulumi.DockerBuild
         [Output("buildArgs")]
         public Output<ImmutableDictionary<string, string>?> BuildArgs { get; private set; } = null!;
 
+        /// <summary>
+        /// A file to write the build's full log to, including anything written to
+        /// stderr. The log is written whether or not the build succeeds, which is
+        /// useful for archiving logs from CI.
+        /// 
+        /// Defaults to a file in the provider's `buildLogDir`, if set.
+        /// </summary>
+        [Output("buildLogPath")]
+        public Output<string?> BuildLogPath { get; private set; } = null!;
+
+        /// <summary>
+        /// Metadata returned by buildkit after the build, useful for tracing an
+        /// image back to its buildx history.
+        /// </summary>
+        [Output("buildMetadata")]
+        public Output<Outputs.BuildMetadata?> BuildMetadata { get; private set; } = null!;
+
         /// <summary>
         /// Setting this to `false` will always skip image builds during previews,
         /// and setting it to `true` will always build images during previews.
@@ -534,6 +629,36 @@ namespace Pulumi.DockerBuild
         [Output("buildOnPreview")]
         public Output<bool?> BuildOnPreview { get; private set; } = null!;
 
+        /// <summary>
+        /// A directory to export the build's history record to after it succeeds
+        /// or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+        /// `buildRecordPath`.
+        /// 
+        /// Equivalent to running `docker buildx history export` after the build.
+        /// Records of failed builds are not available when `exec` is `true`.
+        /// </summary>
+        [Output("buildRecordDir")]
+        public Output<string?> BuildRecordDir { get; private set; } = null!;
+
+        /// <summary>
+        /// The path of the most recent build's exported history record, if
+        /// `buildRecordDir` is set.
+        /// 
+        /// The record can be opened with Docker Desktop's Builds view or imported
+        /// with `docker buildx history import`.
+        /// </summary>
+        [Output("buildRecordPath")]
+        public Output<string?> BuildRecordPath { get; private set; } = null!;
+
+        /// <summary>
+        /// Statistics about the most recent build, like its duration and how many
+        /// steps were cached.
+        /// 
+        /// Not available when `exec` is `true`.
+        /// </summary>
+        [Output("buildStats")]
+        public Output<Outputs.BuildStats?> BuildStats { get; private set; } = null!;
+
         /// <summary>
         /// Builder configuration.
         /// </summary>
@@ -556,6 +681,33 @@ namespace Pulumi.DockerBuild
         [Output("cacheTo")]
         public Output<ImmutableArray<Outputs.CacheTo>> CacheTo { get; private set; } = null!;
 
+        /// <summary>
+        /// Set the parent cgroup for `RUN` instructions.
+        /// 
+        /// Equivalent to Docker's `--cgroup-parent` flag.
+        /// </summary>
+        [Output("cgroupParent")]
+        public Output<string?> CgroupParent { get; private set; } = null!;
+
+        /// <summary>
+        /// The total compressed size in bytes of each platform's pushed layers,
+        /// keyed by platform in the same way as `platformDigests`.
+        /// 
+        /// Empty if the image was not pushed to a registry.
+        /// </summary>
+        [Output("compressedSize")]
+        public Output<ImmutableDictionary<string, int>?> CompressedSize { get; private set; } = null!;
+
+        /// <summary>
+        /// Runtime configuration recorded in each platform's pushed image, such
+        /// as its entrypoint, environment and exposed ports. Keyed by platform
+        /// in the same way as `platformDigests`.
+        /// 
+        /// Empty if the image was not pushed to a registry.
+        /// </summary>
+        [Output("config")]
+        public Output<ImmutableDictionary<string, Outputs.ImageConfig>?> Config { get; private set; } = null!;
+
         /// <summary>
         /// Build context settings. Defaults to the current directory.
         /// 
@@ -572,6 +724,15 @@ namespace Pulumi.DockerBuild
         [Output("contextHash")]
         public Output<string> ContextHash { get; private set; } = null!;
 
+        /// <summary>
+        /// A compressed record of each file contributing to `contextHash`, used
+        /// to explain which files changed when the image needs to be re-built.
+        /// 
+        /// Empty for contexts with more than 5000 files.
+        /// </summary>
+        [Output("contextManifest")]
+        public Output<string?> ContextManifest { get; private set; } = null!;
+
         /// <summary>
         /// A SHA256 digest of the image if it was exported to a registry or
         /// elsewhere.
@@ -642,6 +803,14 @@ namespace Pulumi.DockerBuild
         [Output("ignoreSecretsInDiffCalculation")]
         public Output<ImmutableArray<string>> IgnoreSecretsInDiffCalculation { get; private set; } = null!;
 
+        /// <summary>
+        /// Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+        /// warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+        /// These warnings are still logged.
+        /// </summary>
+        [Output("ignoreWarnings")]
+        public Output<ImmutableArray<string>> IgnoreWarnings { get; private set; } = null!;
+
         /// <summary>
         /// Attach arbitrary key/value metadata to the image.
         /// 
@@ -650,6 +819,26 @@ namespace Pulumi.DockerBuild
         [Output("labels")]
         public Output<ImmutableDictionary<string, string>?> Labels { get; private set; } = null!;
 
+        /// <summary>
+        /// The layers of each platform's pushed image, keyed by platform in the
+        /// same way as `platformDigests`.
+        /// 
+        /// Empty if the image was not pushed to a registry.
+        /// </summary>
+        [Output("layers")]
+        public Output<ImmutableDictionary<string, ImmutableArray<Outputs.Layer>>?> Layers { get; private set; } = null!;
+
+        /// <summary>
+        /// Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+        /// `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+        /// building. Violations are reported as warnings unless configured to be
+        /// errors.
+        /// 
+        /// Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+        /// </summary>
+        [Output("lint")]
+        public Output<Outputs.Lint?> Lint { get; private set; } = null!;
+
         /// <summary>
         /// When `true` the build will automatically include a `docker` export.
         /// 
@@ -660,11 +849,21 @@ namespace Pulumi.DockerBuild
         [Output("load")]
         public Output<bool?> Load { get; private set; } = null!;
 
+        /// <summary>
+        /// The digests each base image is pinned to by `baseImageLock`, keyed by
+        /// its fully-qualified reference.
+        /// </summary>
+        [Output("lockedBaseImages")]
+        public Output<ImmutableDictionary<string, string>?> LockedBaseImages { get; private set; } = null!;
+
         /// <summary>
         /// Set the network mode for `RUN` instructions. Defaults to `default`.
         /// 
         /// For custom networks, configure your builder with `--driver-opt network=...`.
         /// 
+        /// The `host` network mode requires the `network.host` entitlement to be
+        /// included in `allow`.
+        /// 
         /// Equivalent to Docker's `--network` flag.
         /// </summary>
         [Output("network")]
@@ -678,6 +877,20 @@ namespace Pulumi.DockerBuild
         [Output("noCache")]
         public Output<bool?> NoCache { get; private set; } = null!;
 
+        /// <summary>
+        /// The digest of each platform-specific manifest pushed to a registry,
+        /// keyed by platform (for example `linux/arm64`).
+        /// 
+        /// This is useful when a downstream consumer requires a single-platform
+        /// image. Attestation manifests are not included. Single-platform images
+        /// are keyed by the requested platform, or by the platform recorded in
+        /// their config if `platforms` wasn't specified.
+        /// 
+        /// Empty if the image was not pushed to a registry.
+        /// </summary>
+        [Output("platformDigests")]
+        public Output<ImmutableDictionary<string, string>?> PlatformDigests { get; private set; } = null!;
+
         /// <summary>
         /// Set target platform(s) for the build. Defaults to the host's platform.
         /// 
@@ -686,9 +899,21 @@ namespace Pulumi.DockerBuild
         [Output("platforms")]
         public Output<ImmutableArray<Pulumi.DockerBuild.Platform>> Platforms { get; private set; } = null!;
 
+        /// <summary>
+        /// How to report build progress. Defaults to the provider's `progress`
+        /// setting.
+        /// 
+        /// Equivalent to Docker's `--progress` flag.
+        /// </summary>
+        [Output("progress")]
+        public Output<Pulumi.DockerBuild.ProgressMode?> Progress { get; private set; } = null!;
+
         /// <summary>
         /// Always pull referenced images.
         /// 
+        /// Images with `pull` are only rebuilt when one of their
+        /// `baseImageDigests` has moved, or always if those aren't known.
+        /// 
         /// Equivalent to Docker's `--pull` flag.
         /// </summary>
         [Output("pull")]
@@ -735,6 +960,17 @@ namespace Pulumi.DockerBuild
         [Output("registries")]
         public Output<ImmutableArray<Outputs.Registry>> Registries { get; private set; } = null!;
 
+        /// <summary>
+        /// Require every external `FROM` and `COPY --from` image to be pinned
+        /// with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+        /// Failures suggest the image's current digest when it can be looked up
+        /// with the configured registry credentials.
+        /// 
+        /// Defaults to the provider's `requirePinnedBaseImages` setting.
+        /// </summary>
+        [Output("requirePinnedBaseImages")]
+        public Output<bool?> RequirePinnedBaseImages { get; private set; } = null!;
+
         /// <summary>
         /// A mapping of secret names to their corresponding values.
         /// 
@@ -749,6 +985,14 @@ namespace Pulumi.DockerBuild
         [Output("secrets")]
         public Output<ImmutableDictionary<string, string>?> Secrets { get; private set; } = null!;
 
+        /// <summary>
+        /// Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+        /// 
+        /// Equivalent to Docker's `--shm-size` flag.
+        /// </summary>
+        [Output("shmSize")]
+        public Output<string?> ShmSize { get; private set; } = null!;
+
         /// <summary>
         /// SSH agent socket or keys to expose to the build.
         /// 
@@ -778,6 +1022,24 @@ namespace Pulumi.DockerBuild
         [Output("target")]
         public Output<string?> Target { get; private set; } = null!;
 
+        /// <summary>
+        /// Resource limits for `RUN` instructions.
+        /// 
+        /// Equivalent to Docker's `--ulimit` flag.
+        /// </summary>
+        [Output("ulimits")]
+        public Output<ImmutableArray<Outputs.Ulimit>> Ulimits { get; private set; } = null!;
+
+        /// <summary>
+        /// Fail the build if BuildKit reports any warnings, like Dockerfile lint
+        /// rule violations or deprecated syntax. Warnings from rules listed in
+        /// `ignoreWarnings` are exempt.
+        /// 
+        /// Defaults to the provider's `warningsAsErrors` setting.
+        /// </summary>
+        [Output("warningsAsErrors")]
+        public Output<bool?> WarningsAsErrors { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a Image resource with the given unique name, arguments, and options.
@@ -841,6 +1103,92 @@ namespace Pulumi.DockerBuild
             set => _addHosts = value;
         }
 
+        [Input("allow")]
+        private InputList<Pulumi.DockerBuild.Entitlement>? _allow;
+
+        /// <summary>
+        /// Extra privileges to grant to the build.
+        /// 
+        /// The builder must also be configured to permit these entitlements,
+        /// for example with `--allow-insecure-entitlement`.
+        /// 
+        /// Equivalent to Docker's `--allow` flag.
+        /// </summary>
+        public InputList<Pulumi.DockerBuild.Entitlement> Allow
+        {
+            get => _allow ?? (_allow = new InputList<Pulumi.DockerBuild.Entitlement>());
+            set => _allow = value;
+        }
+
+        [Input("allowedBaseImages")]
+        private InputList<string>? _allowedBaseImages;
+
+        /// <summary>
+        /// Glob patterns, like `docker.io/library/*` or
+        /// `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+        /// are matched against fully-qualified references such as
+        /// `docker.io/library/alpine:latest` and their repository names, and `*`
+        /// doesn't match `/`.
+        /// 
+        /// ARGs are substituted from `buildArgs`. References to other stages and
+        /// to `named` contexts are not restricted.
+        /// 
+        /// Images must also match the provider's `allowedBaseImages`, if set.
+        /// </summary>
+        public InputList<string> AllowedBaseImages
+        {
+            get => _allowedBaseImages ?? (_allowedBaseImages = new InputList<string>());
+            set => _allowedBaseImages = value;
+        }
+
+        [Input("annotations")]
+        private InputMap<string>? _annotations;
+
+        /// <summary>
+        /// Attach arbitrary key/value annotations to the image's manifests.
+        /// 
+        /// Keys may be prefixed with the level to annotate -- `manifest`,
+        /// `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+        /// qualified with a platform, for example
+        /// `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+        /// levels can be separated by commas. Defaults to `manifest`.
+        /// 
+        /// Annotations are applied to every `image`, `registry`, `oci` and
+        /// `docker` export. Annotations set directly on an export take
+        /// precedence.
+        /// 
+        /// Equivalent to Docker's `--annotation` flag.
+        /// </summary>
+        public InputMap<string> Annotations
+        {
+            get => _annotations ?? (_annotations = new InputMap<string>());
+            set => _annotations = value;
+        }
+
+        /// <summary>
+        /// Attestations to attach to the image, such as an SBOM or SLSA
+        /// provenance.
+        /// 
+        /// Attestations are only exported with `image`, `registry` or `oci`
+        /// exports, and require a builder which supports them (the legacy
+        /// `docker` driver does not).
+        /// 
+        /// Equivalent to Docker's `--attest` flag.
+        /// </summary>
+        [Input("attestations")]
+        public Input<Inputs.AttestationsArgs>? Attestations { get; set; }
+
+        /// <summary>
+        /// Pin every external `FROM` and `COPY --from` image to the digest it
+        /// resolved to on the first build.
+        /// 
+        /// Later builds substitute `docker-image://&lt;ref&gt;@&lt;digest&gt;` named
+        /// contexts for those images, so they don't change until the lock's
+        /// `refresh` value does.
+        /// </summary>
+        [Input("baseImageLock")]
+        public Input<Inputs.BaseImageLockArgs>? BaseImageLock { get; set; }
+
         [Input("buildArgs")]
         private InputMap<string>? _buildArgs;
 
@@ -861,6 +1209,16 @@ namespace Pulumi.DockerBuild
             set => _buildArgs = value;
         }
 
+        /// <summary>
+        /// A file to write the build's full log to, including anything written to
+        /// stderr. The log is written whether or not the build succeeds, which is
+        /// useful for archiving logs from CI.
+        /// 
+        /// Defaults to a file in the provider's `buildLogDir`, if set.
+        /// </summary>
+        [Input("buildLogPath")]
+        public Input<string>? BuildLogPath { get; set; }
+
         /// <summary>
         /// Setting this to `false` will always skip image builds during previews,
         /// and setting it to `true` will always build images during previews.
@@ -877,6 +1235,17 @@ namespace Pulumi.DockerBuild
         [Input("buildOnPreview")]
         public Input<bool>? BuildOnPreview { get; set; }
 
+        /// <summary>
+        /// A directory to export the build's history record to after it succeeds
+        /// or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+        /// `buildRecordPath`.
+        /// 
+        /// Equivalent to running `docker buildx history export` after the build.
+        /// Records of failed builds are not available when `exec` is `true`.
+        /// </summary>
+        [Input("buildRecordDir")]
+        public Input<string>? BuildRecordDir { get; set; }
+
         /// <summary>
         /// Builder configuration.
         /// </summary>
@@ -911,6 +1280,14 @@ namespace Pulumi.DockerBuild
             set => _cacheTo = value;
         }
 
+        /// <summary>
+        /// Set the parent cgroup for `RUN` instructions.
+        /// 
+        /// Equivalent to Docker's `--cgroup-parent` flag.
+        /// </summary>
+        [Input("cgroupParent")]
+        public Input<string>? CgroupParent { get; set; }
+
         /// <summary>
         /// Build context settings. Defaults to the current directory.
         /// 
@@ -989,6 +1366,20 @@ namespace Pulumi.DockerBuild
             set => _ignoreSecretsInDiffCalculation = value;
         }
 
+        [Input("ignoreWarnings")]
+        private InputList<string>? _ignoreWarnings;
+
+        /// <summary>
+        /// Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+        /// warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+        /// These warnings are still logged.
+        /// </summary>
+        public InputList<string> IgnoreWarnings
+        {
+            get => _ignoreWarnings ?? (_ignoreWarnings = new InputList<string>());
+            set => _ignoreWarnings = value;
+        }
+
         [Input("labels")]
         private InputMap<string>? _labels;
 
@@ -1003,6 +1394,17 @@ namespace Pulumi.DockerBuild
             set => _labels = value;
         }
 
+        /// <summary>
+        /// Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+        /// `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+        /// building. Violations are reported as warnings unless configured to be
+        /// errors.
+        /// 
+        /// Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+        /// </summary>
+        [Input("lint")]
+        public Input<Inputs.LintArgs>? Lint { get; set; }
+
         /// <summary>
         /// When `true` the build will automatically include a `docker` export.
         /// 
@@ -1018,6 +1420,9 @@ namespace Pulumi.DockerBuild
         /// 
         /// For custom networks, configure your builder with `--driver-opt network=...`.
         /// 
+        /// The `host` network mode requires the `network.host` entitlement to be
+        /// included in `allow`.
+        /// 
         /// Equivalent to Docker's `--network` flag.
         /// </summary>
         [Input("network")]
@@ -1045,9 +1450,21 @@ namespace Pulumi.DockerBuild
             set => _platforms = value;
         }
 
+        /// <summary>
+        /// How to report build progress. Defaults to the provider's `progress`
+        /// setting.
+        /// 
+        /// Equivalent to Docker's `--progress` flag.
+        /// </summary>
+        [Input("progress")]
+        public Input<Pulumi.DockerBuild.ProgressMode>? Progress { get; set; }
+
         /// <summary>
         /// Always pull referenced images.
         /// 
+        /// Images with `pull` are only rebuilt when one of their
+        /// `baseImageDigests` has moved, or always if those aren't known.
+        /// 
         /// Equivalent to Docker's `--pull` flag.
         /// </summary>
         [Input("pull")]
@@ -1081,6 +1498,17 @@ namespace Pulumi.DockerBuild
             set => _registries = value;
         }
 
+        /// <summary>
+        /// Require every external `FROM` and `COPY --from` image to be pinned
+        /// with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+        /// Failures suggest the image's current digest when it can be looked up
+        /// with the configured registry credentials.
+        /// 
+        /// Defaults to the provider's `requirePinnedBaseImages` setting.
+        /// </summary>
+        [Input("requirePinnedBaseImages")]
+        public Input<bool>? RequirePinnedBaseImages { get; set; }
+
         [Input("secrets")]
         private InputMap<string>? _secrets;
 
@@ -1105,6 +1533,14 @@ namespace Pulumi.DockerBuild
             }
         }
 
+        /// <summary>
+        /// Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+        /// 
+        /// Equivalent to Docker's `--shm-size` flag.
+        /// </summary>
+        [Input("shmSize")]
+        public Input<string>? ShmSize { get; set; }
+
         [Input("ssh")]
         private InputList<Inputs.SSHArgs>? _ssh;
 
@@ -1146,6 +1582,30 @@ namespace Pulumi.DockerBuild
         [Input("target")]
         public Input<string>? Target { get; set; }
 
+        [Input("ulimits")]
+        private InputList<Inputs.UlimitArgs>? _ulimits;
+
+        /// <summary>
+        /// Resource limits for `RUN` instructions.
+        /// 
+        /// Equivalent to Docker's `--ulimit` flag.
+        /// </summary>
+        public InputList<Inputs.UlimitArgs> Ulimits
+        {
+            get => _ulimits ?? (_ulimits = new InputList<Inputs.UlimitArgs>());
+            set => _ulimits = value;
+        }
+
+        /// <summary>
+        /// Fail the build if BuildKit reports any warnings, like Dockerfile lint
+        /// rule violations or deprecated syntax. Warnings from rules listed in
+        /// `ignoreWarnings` are exempt.
+        /// 
+        /// Defaults to the provider's `warningsAsErrors` setting.
+        /// </summary>
+        [Input("warningsAsErrors")]
+        public Input<bool>? WarningsAsErrors { get; set; }
+
         public ImageArgs()
         {
             BuildOnPreview = true;
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Inputs
+{
+
+    public sealed class AttestationsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Generate SLSA provenance for the build.
+        /// 
+        /// Provenance is disabled unless configured here.
+        /// 
+        /// Equivalent to Docker's `--attest type=provenance` flag.
+        /// </summary>
+        [Input("provenance")]
+        public Input<Inputs.ProvenanceAttestationArgs>? Provenance { get; set; }
+
+        /// <summary>
+        /// Generate a Software Bill of Materials (SBOM) for the image.
+        /// 
+        /// Equivalent to Docker's `--attest type=sbom` flag.
+        /// </summary>
+        [Input("sbom")]
+        public Input<Inputs.SBOMAttestationArgs>? Sbom { get; set; }
+
+        public AttestationsArgs()
+        {
+        }
+        public static new AttestationsArgs Empty => new AttestationsArgs();
+    }
+}
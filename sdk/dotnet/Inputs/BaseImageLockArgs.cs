@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Inputs
+{
+
+    public sealed class BaseImageLockArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// A lockfile to record digests in, like `Dockerfile.lock`. Relative
+        /// paths are resolved from the Dockerfile's directory, or the context's
+        /// for inline Dockerfiles.
+        /// 
+        /// Digests are recorded in the resource's state if this isn't set.
+        /// </summary>
+        [Input("path")]
+        public Input<string>? Path { get; set; }
+
+        /// <summary>
+        /// An arbitrary value, like a date, which re-resolves every base image
+        /// when it changes.
+        /// </summary>
+        [Input("refresh")]
+        public Input<string>? Refresh { get; set; }
+
+        public BaseImageLockArgs()
+        {
+        }
+        public static new BaseImageLockArgs Empty => new BaseImageLockArgs();
+    }
+}
@@ -12,6 +12,21 @@ namespace Pulumi.DockerBuild.Inputs
 
     public sealed class BuildContextArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// Which files in local contexts contribute to the `contextHash` used to
+        /// decide whether the image needs to be rebuilt.
+        /// 
+        /// With `referenced`, only the paths copied by `COPY` and `ADD`
+        /// instructions are hashed, so changes to other files don't trigger a
+        /// rebuild. Every file is hashed if those paths can't be determined
+        /// statically -- for example when the Dockerfile is remote or a `RUN`
+        /// instruction bind-mounts a context.
+        /// 
+        /// Defaults to `all`.
+        /// </summary>
+        [Input("hashMode")]
+        public Input<Pulumi.DockerBuild.ContextHashMode>? HashMode { get; set; }
+
         /// <summary>
         /// Resources to use for build context.
         /// 
@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Inputs
+{
+
+    public sealed class LintArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Report lint violations as errors instead of warnings.
+        /// 
+        /// Defaults to the Dockerfile's `# check=error=...` directive.
+        /// </summary>
+        [Input("error")]
+        public Input<bool>? Error { get; set; }
+
+        [Input("skip")]
+        private InputList<string>? _skip;
+
+        /// <summary>
+        /// Names of lint rules to skip, like `StageNameCasing`, or `all` to
+        /// disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+        /// directive are also skipped.
+        /// </summary>
+        public InputList<string> Skip
+        {
+            get => _skip ?? (_skip = new InputList<string>());
+            set => _skip = value;
+        }
+
+        public LintArgs()
+        {
+        }
+        public static new LintArgs Empty => new LintArgs();
+    }
+}
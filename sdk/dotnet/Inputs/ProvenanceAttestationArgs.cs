@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Inputs
+{
+
+    public sealed class ProvenanceAttestationArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// An explicit builder ID to record in the provenance, for example the
+        /// URL of the CI job performing the build.
+        /// </summary>
+        [Input("builderId")]
+        public Input<string>? BuilderId { get; set; }
+
+        /// <summary>
+        /// When `true` no provenance will be generated. Defaults to `false`.
+        /// </summary>
+        [Input("disabled")]
+        public Input<bool>? Disabled { get; set; }
+
+        /// <summary>
+        /// Only attach provenance to exporters which embed it in the image
+        /// (e.g. `image` and `registry`). Defaults to `false`.
+        /// </summary>
+        [Input("inlineOnly")]
+        public Input<bool>? InlineOnly { get; set; }
+
+        /// <summary>
+        /// The level of detail to include in the provenance. Defaults to `min`.
+        /// </summary>
+        [Input("mode")]
+        public Input<Pulumi.DockerBuild.ProvenanceMode>? Mode { get; set; }
+
+        public ProvenanceAttestationArgs()
+        {
+            Mode = Pulumi.DockerBuild.ProvenanceMode.Min;
+        }
+        public static new ProvenanceAttestationArgs Empty => new ProvenanceAttestationArgs();
+    }
+}
@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Inputs
+{
+
+    public sealed class SBOMAttestationArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// When `true` no SBOM will be generated. Defaults to `false`.
+        /// </summary>
+        [Input("disabled")]
+        public Input<bool>? Disabled { get; set; }
+
+        /// <summary>
+        /// The scanner image used to generate the SBOM.
+        /// 
+        /// Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+        /// </summary>
+        [Input("generator")]
+        public Input<string>? Generator { get; set; }
+
+        /// <summary>
+        /// Also scan the build context, in addition to the final image.
+        /// 
+        /// Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+        /// </summary>
+        [Input("scanContext")]
+        public Input<bool>? ScanContext { get; set; }
+
+        /// <summary>
+        /// Also scan intermediate build stages, in addition to the final image.
+        /// 
+        /// Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+        /// </summary>
+        [Input("scanStage")]
+        public Input<bool>? ScanStage { get; set; }
+
+        public SBOMAttestationArgs()
+        {
+        }
+        public static new SBOMAttestationArgs Empty => new SBOMAttestationArgs();
+    }
+}
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Inputs
+{
+
+    public sealed class UlimitArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The hard limit. Defaults to the soft limit.
+        /// </summary>
+        [Input("hard")]
+        public Input<int>? Hard { get; set; }
+
+        /// <summary>
+        /// The name of the limit, for example `nofile` or `nproc`.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The soft limit. Use `-1` for unlimited.
+        /// </summary>
+        [Input("soft", required: true)]
+        public Input<int> Soft { get; set; } = null!;
+
+        public UlimitArgs()
+        {
+        }
+        public static new UlimitArgs Empty => new UlimitArgs();
+    }
+}
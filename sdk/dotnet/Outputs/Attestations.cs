@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Outputs
+{
+
+    [OutputType]
+    public sealed class Attestations
+    {
+        /// <summary>
+        /// Generate SLSA provenance for the build.
+        /// 
+        /// Provenance is disabled unless configured here.
+        /// 
+        /// Equivalent to Docker's `--attest type=provenance` flag.
+        /// </summary>
+        public readonly Outputs.ProvenanceAttestation? Provenance;
+        /// <summary>
+        /// Generate a Software Bill of Materials (SBOM) for the image.
+        /// 
+        /// Equivalent to Docker's `--attest type=sbom` flag.
+        /// </summary>
+        public readonly Outputs.SBOMAttestation? Sbom;
+
+        [OutputConstructor]
+        private Attestations(
+            Outputs.ProvenanceAttestation? provenance,
+
+            Outputs.SBOMAttestation? sbom)
+        {
+            Provenance = provenance;
+            Sbom = sbom;
+        }
+    }
+}
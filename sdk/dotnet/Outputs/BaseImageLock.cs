@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Outputs
+{
+
+    [OutputType]
+    public sealed class BaseImageLock
+    {
+        /// <summary>
+        /// A lockfile to record digests in, like `Dockerfile.lock`. Relative
+        /// paths are resolved from the Dockerfile's directory, or the context's
+        /// for inline Dockerfiles.
+        /// 
+        /// Digests are recorded in the resource's state if this isn't set.
+        /// </summary>
+        public readonly string? Path;
+        /// <summary>
+        /// An arbitrary value, like a date, which re-resolves every base image
+        /// when it changes.
+        /// </summary>
+        public readonly string? Refresh;
+
+        [OutputConstructor]
+        private BaseImageLock(
+            string? path,
+
+            string? refresh)
+        {
+            Path = path;
+            Refresh = refresh;
+        }
+    }
+}
@@ -13,6 +13,19 @@ namespace Pulumi.DockerBuild.Outputs
     [OutputType]
     public sealed class BuildContext
     {
+        /// <summary>
+        /// Which files in local contexts contribute to the `contextHash` used to
+        /// decide whether the image needs to be rebuilt.
+        /// 
+        /// With `referenced`, only the paths copied by `COPY` and `ADD`
+        /// instructions are hashed, so changes to other files don't trigger a
+        /// rebuild. Every file is hashed if those paths can't be determined
+        /// statically -- for example when the Dockerfile is remote or a `RUN`
+        /// instruction bind-mounts a context.
+        /// 
+        /// Defaults to `all`.
+        /// </summary>
+        public readonly Pulumi.DockerBuild.ContextHashMode? HashMode;
         /// <summary>
         /// Resources to use for build context.
         /// 
@@ -36,10 +49,13 @@ namespace Pulumi.DockerBuild.Outputs
 
         [OutputConstructor]
         private BuildContext(
+            Pulumi.DockerBuild.ContextHashMode? hashMode,
+
             string location,
 
             ImmutableDictionary<string, Outputs.Context>? named)
         {
+            HashMode = hashMode;
             Location = location;
             Named = named;
         }
@@ -0,0 +1,60 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Outputs
+{
+
+    [OutputType]
+    public sealed class BuildMetadata
+    {
+        /// <summary>
+        /// The buildx history reference for the build, formatted as
+        /// `&lt;builder&gt;/&lt;node&gt;/&lt;ref&gt;`.
+        /// 
+        /// This can be passed to `docker buildx history inspect` to debug the
+        /// build.
+        /// </summary>
+        public readonly string? BuildRef;
+        /// <summary>
+        /// The digest of the image's config blob.
+        /// </summary>
+        public readonly string? ConfigDigest;
+        /// <summary>
+        /// The media type of the exported image's top-level manifest or index.
+        /// </summary>
+        public readonly string? DescriptorMediaType;
+        /// <summary>
+        /// The size in bytes of the exported image's top-level manifest or index.
+        /// </summary>
+        public readonly int? DescriptorSize;
+        /// <summary>
+        /// The comma-separated names the image was exported with.
+        /// </summary>
+        public readonly string? ImageName;
+
+        [OutputConstructor]
+        private BuildMetadata(
+            string? buildRef,
+
+            string? configDigest,
+
+            string? descriptorMediaType,
+
+            int? descriptorSize,
+
+            string? imageName)
+        {
+            BuildRef = buildRef;
+            ConfigDigest = configDigest;
+            DescriptorMediaType = descriptorMediaType;
+            DescriptorSize = descriptorSize;
+            ImageName = imageName;
+        }
+    }
+}
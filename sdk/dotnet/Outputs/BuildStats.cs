@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Outputs
+{
+
+    [OutputType]
+    public sealed class BuildStats
+    {
+        /// <summary>
+        /// The fraction of steps which were cached, between 0 and 1.
+        /// </summary>
+        public readonly double CacheHitRatio;
+        /// <summary>
+        /// The number of steps which were cached.
+        /// </summary>
+        public readonly int CachedSteps;
+        /// <summary>
+        /// Wall-clock duration of the build in seconds.
+        /// </summary>
+        public readonly double Duration;
+        /// <summary>
+        /// The slowest uncached steps, in descending order of duration.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.BuildStep> SlowestSteps;
+        /// <summary>
+        /// The number of steps (vertices) solved by the build.
+        /// </summary>
+        public readonly int Steps;
+
+        [OutputConstructor]
+        private BuildStats(
+            double cacheHitRatio,
+
+            int cachedSteps,
+
+            double duration,
+
+            ImmutableArray<Outputs.BuildStep> slowestSteps,
+
+            int steps)
+        {
+            CacheHitRatio = cacheHitRatio;
+            CachedSteps = cachedSteps;
+            Duration = duration;
+            SlowestSteps = slowestSteps;
+            Steps = steps;
+        }
+    }
+}
@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Outputs
+{
+
+    [OutputType]
+    public sealed class BuildStep
+    {
+        /// <summary>
+        /// The step's duration in seconds.
+        /// </summary>
+        public readonly double Duration;
+        /// <summary>
+        /// The step's name, for example "[2/3] RUN make".
+        /// </summary>
+        public readonly string Name;
+
+        [OutputConstructor]
+        private BuildStep(
+            double duration,
+
+            string name)
+        {
+            Duration = duration;
+            Name = name;
+        }
+    }
+}
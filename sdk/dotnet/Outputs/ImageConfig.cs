@@ -0,0 +1,70 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Outputs
+{
+
+    [OutputType]
+    public sealed class ImageConfig
+    {
+        /// <summary>
+        /// The image's "CMD".
+        /// </summary>
+        public readonly ImmutableArray<string> Cmd;
+        /// <summary>
+        /// The image's "ENTRYPOINT".
+        /// </summary>
+        public readonly ImmutableArray<string> Entrypoint;
+        /// <summary>
+        /// Environment variables set by "ENV", as "KEY=value" pairs.
+        /// </summary>
+        public readonly ImmutableArray<string> Env;
+        /// <summary>
+        /// Ports declared by `EXPOSE`, for example `8080/tcp`.
+        /// </summary>
+        public readonly ImmutableArray<string> ExposedPorts;
+        /// <summary>
+        /// The image's final labels, including any inherited from base images.
+        /// </summary>
+        public readonly ImmutableDictionary<string, string>? Labels;
+        /// <summary>
+        /// The image's "USER".
+        /// </summary>
+        public readonly string? User;
+        /// <summary>
+        /// The image's "WORKDIR".
+        /// </summary>
+        public readonly string? WorkingDir;
+
+        [OutputConstructor]
+        private ImageConfig(
+            ImmutableArray<string> cmd,
+
+            ImmutableArray<string> entrypoint,
+
+            ImmutableArray<string> env,
+
+            ImmutableArray<string> exposedPorts,
+
+            ImmutableDictionary<string, string>? labels,
+
+            string? user,
+
+            string? workingDir)
+        {
+            Cmd = cmd;
+            Entrypoint = entrypoint;
+            Env = env;
+            ExposedPorts = exposedPorts;
+            Labels = labels;
+            User = user;
+            WorkingDir = workingDir;
+        }
+    }
+}
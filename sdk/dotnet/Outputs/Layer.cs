@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Outputs
+{
+
+    [OutputType]
+    public sealed class Layer
+    {
+        /// <summary>
+        /// The layer's content digest.
+        /// </summary>
+        public readonly string Digest;
+        /// <summary>
+        /// The layer's media type, for example "application/vnd.oci.image.layer.v1.tar+gzip".
+        /// </summary>
+        public readonly string MediaType;
+        /// <summary>
+        /// The layer's compressed size in bytes.
+        /// </summary>
+        public readonly int Size;
+
+        [OutputConstructor]
+        private Layer(
+            string digest,
+
+            string mediaType,
+
+            int size)
+        {
+            Digest = digest;
+            MediaType = mediaType;
+            Size = size;
+        }
+    }
+}
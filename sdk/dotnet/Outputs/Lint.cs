@@ -0,0 +1,39 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Outputs
+{
+
+    [OutputType]
+    public sealed class Lint
+    {
+        /// <summary>
+        /// Report lint violations as errors instead of warnings.
+        /// 
+        /// Defaults to the Dockerfile's `# check=error=...` directive.
+        /// </summary>
+        public readonly bool? Error;
+        /// <summary>
+        /// Names of lint rules to skip, like `StageNameCasing`, or `all` to
+        /// disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+        /// directive are also skipped.
+        /// </summary>
+        public readonly ImmutableArray<string> Skip;
+
+        [OutputConstructor]
+        private Lint(
+            bool? error,
+
+            ImmutableArray<string> skip)
+        {
+            Error = error;
+            Skip = skip;
+        }
+    }
+}
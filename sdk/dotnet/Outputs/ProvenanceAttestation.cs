@@ -0,0 +1,51 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Outputs
+{
+
+    [OutputType]
+    public sealed class ProvenanceAttestation
+    {
+        /// <summary>
+        /// An explicit builder ID to record in the provenance, for example the
+        /// URL of the CI job performing the build.
+        /// </summary>
+        public readonly string? BuilderId;
+        /// <summary>
+        /// When `true` no provenance will be generated. Defaults to `false`.
+        /// </summary>
+        public readonly bool? Disabled;
+        /// <summary>
+        /// Only attach provenance to exporters which embed it in the image
+        /// (e.g. `image` and `registry`). Defaults to `false`.
+        /// </summary>
+        public readonly bool? InlineOnly;
+        /// <summary>
+        /// The level of detail to include in the provenance. Defaults to `min`.
+        /// </summary>
+        public readonly Pulumi.DockerBuild.ProvenanceMode? Mode;
+
+        [OutputConstructor]
+        private ProvenanceAttestation(
+            string? builderId,
+
+            bool? disabled,
+
+            bool? inlineOnly,
+
+            Pulumi.DockerBuild.ProvenanceMode? mode)
+        {
+            BuilderId = builderId;
+            Disabled = disabled;
+            InlineOnly = inlineOnly;
+            Mode = mode;
+        }
+    }
+}
@@ -0,0 +1,55 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Outputs
+{
+
+    [OutputType]
+    public sealed class SBOMAttestation
+    {
+        /// <summary>
+        /// When `true` no SBOM will be generated. Defaults to `false`.
+        /// </summary>
+        public readonly bool? Disabled;
+        /// <summary>
+        /// The scanner image used to generate the SBOM.
+        /// 
+        /// Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+        /// </summary>
+        public readonly string? Generator;
+        /// <summary>
+        /// Also scan the build context, in addition to the final image.
+        /// 
+        /// Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+        /// </summary>
+        public readonly bool? ScanContext;
+        /// <summary>
+        /// Also scan intermediate build stages, in addition to the final image.
+        /// 
+        /// Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+        /// </summary>
+        public readonly bool? ScanStage;
+
+        [OutputConstructor]
+        private SBOMAttestation(
+            bool? disabled,
+
+            string? generator,
+
+            bool? scanContext,
+
+            bool? scanStage)
+        {
+            Disabled = disabled;
+            Generator = generator;
+            ScanContext = scanContext;
+            ScanStage = scanStage;
+        }
+    }
+}
@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.DockerBuild.Outputs
+{
+
+    [OutputType]
+    public sealed class Ulimit
+    {
+        /// <summary>
+        /// The hard limit. Defaults to the soft limit.
+        /// </summary>
+        public readonly int? Hard;
+        /// <summary>
+        /// The name of the limit, for example `nofile` or `nproc`.
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The soft limit. Use `-1` for unlimited.
+        /// </summary>
+        public readonly int Soft;
+
+        [OutputConstructor]
+        private Ulimit(
+            int? hard,
+
+            string name,
+
+            int soft)
+        {
+            Hard = hard;
+            Name = name;
+            Soft = soft;
+        }
+    }
+}
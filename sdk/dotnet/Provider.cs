@@ -12,12 +12,49 @@ namespace Pulumi.DockerBuild
     [DockerBuildResourceType("pulumi:providers:docker-build")]
     public partial class Provider : global::Pulumi.ProviderResource
     {
+        /// <summary>
+        /// A directory to write the full log of each image build to, for images
+        /// which don't specify their own `buildLogPath`.
+        /// 
+        /// Logs are named after the image's first tag, or the resource's name if
+        /// it has no tags.
+        /// </summary>
+        [Output("buildLogDir")]
+        public Output<string?> BuildLogDir { get; private set; } = null!;
+
+        /// <summary>
+        /// A directory to cache build context hashes in, so files which haven't
+        /// changed aren't read again on later runs.
+        /// 
+        /// Files are considered unchanged if their size, modification time and
+        /// inode are the same. The resulting `contextHash` is unaffected.
+        /// </summary>
+        [Output("contextHashCacheDir")]
+        public Output<string?> ContextHashCacheDir { get; private set; } = null!;
+
         /// <summary>
         /// The build daemon's address.
         /// </summary>
         [Output("host")]
         public Output<string?> Host { get; private set; } = null!;
 
+        /// <summary>
+        /// An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+        /// of resource operations and builds to. gRPC endpoints aren't supported.
+        /// 
+        /// Spans join the trace in the `TRACEPARENT` environment variable, if set,
+        /// and trace context is propagated to the build daemon.
+        /// </summary>
+        [Output("otlpEndpoint")]
+        public Output<string?> OtlpEndpoint { get; private set; } = null!;
+
+        /// <summary>
+        /// How to report build progress for images which don't specify their own
+        /// `progress`. Defaults to `plain`.
+        /// </summary>
+        [Output("progress")]
+        public Output<string?> Progress { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a Provider resource with the given unique name, arguments, and options.
@@ -46,12 +83,63 @@ namespace Pulumi.DockerBuild
 
     public sealed class ProviderArgs : global::Pulumi.ResourceArgs
     {
+        [Input("allowedBaseImages", json: true)]
+        private InputList<string>? _allowedBaseImages;
+
+        /// <summary>
+        /// Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+        /// instructions must match. This applies in addition to each image's own
+        /// `allowedBaseImages`.
+        /// </summary>
+        public InputList<string> AllowedBaseImages
+        {
+            get => _allowedBaseImages ?? (_allowedBaseImages = new InputList<string>());
+            set => _allowedBaseImages = value;
+        }
+
+        /// <summary>
+        /// A directory to write the full log of each image build to, for images
+        /// which don't specify their own `buildLogPath`.
+        /// 
+        /// Logs are named after the image's first tag, or the resource's name if
+        /// it has no tags.
+        /// </summary>
+        [Input("buildLogDir")]
+        public Input<string>? BuildLogDir { get; set; }
+
+        /// <summary>
+        /// A directory to cache build context hashes in, so files which haven't
+        /// changed aren't read again on later runs.
+        /// 
+        /// Files are considered unchanged if their size, modification time and
+        /// inode are the same. The resulting `contextHash` is unaffected.
+        /// </summary>
+        [Input("contextHashCacheDir")]
+        public Input<string>? ContextHashCacheDir { get; set; }
+
         /// <summary>
         /// The build daemon's address.
         /// </summary>
         [Input("host")]
         public Input<string>? Host { get; set; }
 
+        /// <summary>
+        /// An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+        /// of resource operations and builds to. gRPC endpoints aren't supported.
+        /// 
+        /// Spans join the trace in the `TRACEPARENT` environment variable, if set,
+        /// and trace context is propagated to the build daemon.
+        /// </summary>
+        [Input("otlpEndpoint")]
+        public Input<string>? OtlpEndpoint { get; set; }
+
+        /// <summary>
+        /// How to report build progress for images which don't specify their own
+        /// `progress`. Defaults to `plain`.
+        /// </summary>
+        [Input("progress", json: true)]
+        public Input<Pulumi.DockerBuild.ProgressMode>? Progress { get; set; }
+
         [Input("registries", json: true)]
         private InputList<Inputs.RegistryArgs>? _registries;
         public InputList<Inputs.RegistryArgs> Registries
@@ -60,9 +148,25 @@ namespace Pulumi.DockerBuild
             set => _registries = value;
         }
 
+        /// <summary>
+        /// Require external `FROM` and `COPY --from` images to be pinned with an
+        /// `@sha256:` digest, for images which don't specify their own
+        /// `requirePinnedBaseImages`.
+        /// </summary>
+        [Input("requirePinnedBaseImages", json: true)]
+        public Input<bool>? RequirePinnedBaseImages { get; set; }
+
+        /// <summary>
+        /// Fail image builds if BuildKit reports any warnings, for images which
+        /// don't specify their own `warningsAsErrors`.
+        /// </summary>
+        [Input("warningsAsErrors", json: true)]
+        public Input<bool>? WarningsAsErrors { get; set; }
+
         public ProviderArgs()
         {
             Host = Utilities.GetEnv("DOCKER_HOST") ?? "";
+            OtlpEndpoint = Utilities.GetEnv("PULUMI_DOCKER_BUILD_OTLP_ENDPOINT") ?? "";
         }
         public static new ProviderArgs Empty => new ProviderArgs();
     }
@@ -11,6 +11,31 @@ import (
 
 var _ = internal.GetEnvOrDefault
 
+// Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+// instructions must match. This applies in addition to each image's own
+// `allowedBaseImages`.
+func GetAllowedBaseImages(ctx *pulumi.Context) string {
+	return config.Get(ctx, "docker-build:allowedBaseImages")
+}
+
+// A directory to write the full log of each image build to, for images
+// which don't specify their own `buildLogPath`.
+//
+// Logs are named after the image's first tag, or the resource's name if
+// it has no tags.
+func GetBuildLogDir(ctx *pulumi.Context) string {
+	return config.Get(ctx, "docker-build:buildLogDir")
+}
+
+// A directory to cache build context hashes in, so files which haven't
+// changed aren't read again on later runs.
+//
+// Files are considered unchanged if their size, modification time and
+// inode are the same. The resulting `contextHash` is unaffected.
+func GetContextHashCacheDir(ctx *pulumi.Context) string {
+	return config.Get(ctx, "docker-build:contextHashCacheDir")
+}
+
 // The build daemon's address.
 func GetHost(ctx *pulumi.Context) string {
 	v, err := config.Try(ctx, "docker-build:host")
@@ -23,6 +48,42 @@ func GetHost(ctx *pulumi.Context) string {
 	}
 	return value
 }
+
+// An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+// of resource operations and builds to. gRPC endpoints aren't supported.
+//
+// Spans join the trace in the `TRACEPARENT` environment variable, if set,
+// and trace context is propagated to the build daemon.
+func GetOtlpEndpoint(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "docker-build:otlpEndpoint")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "PULUMI_DOCKER_BUILD_OTLP_ENDPOINT"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// How to report build progress for images which don't specify their own
+// `progress`. Defaults to `plain`.
+func GetProgress(ctx *pulumi.Context) string {
+	return config.Get(ctx, "docker-build:progress")
+}
 func GetRegistries(ctx *pulumi.Context) string {
 	return config.Get(ctx, "docker-build:registries")
 }
+
+// Require external `FROM` and `COPY --from` images to be pinned with an
+// `@sha256:` digest, for images which don't specify their own
+// `requirePinnedBaseImages`.
+func GetRequirePinnedBaseImages(ctx *pulumi.Context) bool {
+	return config.GetBool(ctx, "docker-build:requirePinnedBaseImages")
+}
+
+// Fail image builds if BuildKit reports any warnings, for images which
+// don't specify their own `warningsAsErrors`.
+func GetWarningsAsErrors(ctx *pulumi.Context) bool {
+	return config.GetBool(ctx, "docker-build:warningsAsErrors")
+}
@@ -550,6 +550,60 @@ type Image struct {
 	//
 	// Equivalent to Docker's `--add-host` flag.
 	AddHosts pulumi.StringArrayOutput `pulumi:"addHosts"`
+	// Extra privileges to grant to the build.
+	//
+	// The builder must also be configured to permit these entitlements,
+	// for example with `--allow-insecure-entitlement`.
+	//
+	// Equivalent to Docker's `--allow` flag.
+	Allow EntitlementArrayOutput `pulumi:"allow"`
+	// Glob patterns, like `docker.io/library/*` or
+	// `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+	// are matched against fully-qualified references such as
+	// `docker.io/library/alpine:latest` and their repository names, and `*`
+	// doesn't match `/`.
+	//
+	// ARGs are substituted from `buildArgs`. References to other stages and
+	// to `named` contexts are not restricted.
+	//
+	// Images must also match the provider's `allowedBaseImages`, if set.
+	AllowedBaseImages pulumi.StringArrayOutput `pulumi:"allowedBaseImages"`
+	// Attach arbitrary key/value annotations to the image's manifests.
+	//
+	// Keys may be prefixed with the level to annotate -- `manifest`,
+	// `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+	// qualified with a platform, for example
+	// `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+	// levels can be separated by commas. Defaults to `manifest`.
+	//
+	// Annotations are applied to every `image`, `registry`, `oci` and
+	// `docker` export. Annotations set directly on an export take
+	// precedence.
+	//
+	// Equivalent to Docker's `--annotation` flag.
+	Annotations pulumi.StringMapOutput `pulumi:"annotations"`
+	// Attestations to attach to the image, such as an SBOM or SLSA
+	// provenance.
+	//
+	// Attestations are only exported with `image`, `registry` or `oci`
+	// exports, and require a builder which supports them (the legacy
+	// `docker` driver does not).
+	//
+	// Equivalent to Docker's `--attest` flag.
+	Attestations AttestationsPtrOutput `pulumi:"attestations"`
+	// The digest each external `FROM` image resolved to when the image was
+	// built, keyed by its fully-qualified reference.
+	//
+	// Only recorded for images with `pull`, which are rebuilt when any of
+	// these digests has moved.
+	BaseImageDigests pulumi.StringMapOutput `pulumi:"baseImageDigests"`
+	// Pin every external `FROM` and `COPY --from` image to the digest it
+	// resolved to on the first build.
+	//
+	// Later builds substitute `docker-image://<ref>@<digest>` named
+	// contexts for those images, so they don't change until the lock's
+	// `refresh` value does.
+	BaseImageLock BaseImageLockPtrOutput `pulumi:"baseImageLock"`
 	// `ARG` names and values to set during the build.
 	//
 	// These variables are accessed like environment variables inside `RUN`
@@ -560,6 +614,15 @@ type Image struct {
 	//
 	// Equivalent to Docker's `--build-arg` flag.
 	BuildArgs pulumi.StringMapOutput `pulumi:"buildArgs"`
+	// A file to write the build's full log to, including anything written to
+	// stderr. The log is written whether or not the build succeeds, which is
+	// useful for archiving logs from CI.
+	//
+	// Defaults to a file in the provider's `buildLogDir`, if set.
+	BuildLogPath pulumi.StringPtrOutput `pulumi:"buildLogPath"`
+	// Metadata returned by buildkit after the build, useful for tracing an
+	// image back to its buildx history.
+	BuildMetadata BuildMetadataPtrOutput `pulumi:"buildMetadata"`
 	// Setting this to `false` will always skip image builds during previews,
 	// and setting it to `true` will always build images during previews.
 	//
@@ -572,6 +635,24 @@ type Image struct {
 	// Defaults to `true` as a safeguard against broken images merging as part
 	// of CI pipelines.
 	BuildOnPreview pulumi.BoolPtrOutput `pulumi:"buildOnPreview"`
+	// A directory to export the build's history record to after it succeeds
+	// or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+	// `buildRecordPath`.
+	//
+	// Equivalent to running `docker buildx history export` after the build.
+	// Records of failed builds are not available when `exec` is `true`.
+	BuildRecordDir pulumi.StringPtrOutput `pulumi:"buildRecordDir"`
+	// The path of the most recent build's exported history record, if
+	// `buildRecordDir` is set.
+	//
+	// The record can be opened with Docker Desktop's Builds view or imported
+	// with `docker buildx history import`.
+	BuildRecordPath pulumi.StringPtrOutput `pulumi:"buildRecordPath"`
+	// Statistics about the most recent build, like its duration and how many
+	// steps were cached.
+	//
+	// Not available when `exec` is `true`.
+	BuildStats BuildStatsPtrOutput `pulumi:"buildStats"`
 	// Builder configuration.
 	Builder BuilderConfigPtrOutput `pulumi:"builder"`
 	// Cache export configuration.
@@ -582,6 +663,21 @@ type Image struct {
 	//
 	// Equivalent to Docker's `--cache-to` flag.
 	CacheTo CacheToArrayOutput `pulumi:"cacheTo"`
+	// Set the parent cgroup for `RUN` instructions.
+	//
+	// Equivalent to Docker's `--cgroup-parent` flag.
+	CgroupParent pulumi.StringPtrOutput `pulumi:"cgroupParent"`
+	// The total compressed size in bytes of each platform's pushed layers,
+	// keyed by platform in the same way as `platformDigests`.
+	//
+	// Empty if the image was not pushed to a registry.
+	CompressedSize pulumi.IntMapOutput `pulumi:"compressedSize"`
+	// Runtime configuration recorded in each platform's pushed image, such
+	// as its entrypoint, environment and exposed ports. Keyed by platform
+	// in the same way as `platformDigests`.
+	//
+	// Empty if the image was not pushed to a registry.
+	Config ImageConfigMapOutput `pulumi:"config"`
 	// Build context settings. Defaults to the current directory.
 	//
 	// Equivalent to Docker's `PATH | URL | -` positional argument.
@@ -590,6 +686,11 @@ type Image struct {
 	//
 	// Pulumi uses this to determine if an image _may_ need to be re-built.
 	ContextHash pulumi.StringOutput `pulumi:"contextHash"`
+	// A compressed record of each file contributing to `contextHash`, used
+	// to explain which files changed when the image needs to be re-built.
+	//
+	// Empty for contexts with more than 5000 files.
+	ContextManifest pulumi.StringPtrOutput `pulumi:"contextManifest"`
 	// A SHA256 digest of the image if it was exported to a registry or
 	// elsewhere.
 	//
@@ -640,32 +741,72 @@ type Image struct {
 	//
 	// This is useful when you want to avoid unnecessary rebuilds caused by short-lived secrets that change on every run.
 	IgnoreSecretsInDiffCalculation pulumi.StringArrayOutput `pulumi:"ignoreSecretsInDiffCalculation"`
+	// Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+	// warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+	// These warnings are still logged.
+	IgnoreWarnings pulumi.StringArrayOutput `pulumi:"ignoreWarnings"`
 	// Attach arbitrary key/value metadata to the image.
 	//
 	// Equivalent to Docker's `--label` flag.
 	Labels pulumi.StringMapOutput `pulumi:"labels"`
+	// The layers of each platform's pushed image, keyed by platform in the
+	// same way as `platformDigests`.
+	//
+	// Empty if the image was not pushed to a registry.
+	Layers LayerArrayMapOutput `pulumi:"layers"`
+	// Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+	// `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+	// building. Violations are reported as warnings unless configured to be
+	// errors.
+	//
+	// Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+	Lint LintPtrOutput `pulumi:"lint"`
 	// When `true` the build will automatically include a `docker` export.
 	//
 	// Defaults to `false`.
 	//
 	// Equivalent to Docker's `--load` flag.
 	Load pulumi.BoolPtrOutput `pulumi:"load"`
+	// The digests each base image is pinned to by `baseImageLock`, keyed by
+	// its fully-qualified reference.
+	LockedBaseImages pulumi.StringMapOutput `pulumi:"lockedBaseImages"`
 	// Set the network mode for `RUN` instructions. Defaults to `default`.
 	//
 	// For custom networks, configure your builder with `--driver-opt network=...`.
 	//
+	// The `host` network mode requires the `network.host` entitlement to be
+	// included in `allow`.
+	//
 	// Equivalent to Docker's `--network` flag.
 	Network NetworkModePtrOutput `pulumi:"network"`
 	// Do not import cache manifests when building the image.
 	//
 	// Equivalent to Docker's `--no-cache` flag.
 	NoCache pulumi.BoolPtrOutput `pulumi:"noCache"`
+	// The digest of each platform-specific manifest pushed to a registry,
+	// keyed by platform (for example `linux/arm64`).
+	//
+	// This is useful when a downstream consumer requires a single-platform
+	// image. Attestation manifests are not included. Single-platform images
+	// are keyed by the requested platform, or by the platform recorded in
+	// their config if `platforms` wasn't specified.
+	//
+	// Empty if the image was not pushed to a registry.
+	PlatformDigests pulumi.StringMapOutput `pulumi:"platformDigests"`
 	// Set target platform(s) for the build. Defaults to the host's platform.
 	//
 	// Equivalent to Docker's `--platform` flag.
 	Platforms PlatformArrayOutput `pulumi:"platforms"`
+	// How to report build progress. Defaults to the provider's `progress`
+	// setting.
+	//
+	// Equivalent to Docker's `--progress` flag.
+	Progress ProgressModePtrOutput `pulumi:"progress"`
 	// Always pull referenced images.
 	//
+	// Images with `pull` are only rebuilt when one of their
+	// `baseImageDigests` has moved, or always if those aren't known.
+	//
 	// Equivalent to Docker's `--pull` flag.
 	Pull pulumi.BoolPtrOutput `pulumi:"pull"`
 	// When `true` the build will automatically include a `registry` export.
@@ -697,6 +838,13 @@ type Image struct {
 	//
 	// Similar to `docker login`.
 	Registries RegistryArrayOutput `pulumi:"registries"`
+	// Require every external `FROM` and `COPY --from` image to be pinned
+	// with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+	// Failures suggest the image's current digest when it can be looked up
+	// with the configured registry credentials.
+	//
+	// Defaults to the provider's `requirePinnedBaseImages` setting.
+	RequirePinnedBaseImages pulumi.BoolPtrOutput `pulumi:"requirePinnedBaseImages"`
 	// A mapping of secret names to their corresponding values.
 	//
 	// Unlike the Docker CLI, these can be passed by value and do not need to
@@ -707,6 +855,10 @@ type Image struct {
 	//
 	// Similar to Docker's `--secret` flag.
 	Secrets pulumi.StringMapOutput `pulumi:"secrets"`
+	// Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+	//
+	// Equivalent to Docker's `--shm-size` flag.
+	ShmSize pulumi.StringPtrOutput `pulumi:"shmSize"`
 	// SSH agent socket or keys to expose to the build.
 	//
 	// Equivalent to Docker's `--ssh` flag.
@@ -724,6 +876,16 @@ type Image struct {
 	//
 	// Equivalent to Docker's `--target` flag.
 	Target pulumi.StringPtrOutput `pulumi:"target"`
+	// Resource limits for `RUN` instructions.
+	//
+	// Equivalent to Docker's `--ulimit` flag.
+	Ulimits UlimitArrayOutput `pulumi:"ulimits"`
+	// Fail the build if BuildKit reports any warnings, like Dockerfile lint
+	// rule violations or deprecated syntax. Warnings from rules listed in
+	// `ignoreWarnings` are exempt.
+	//
+	// Defaults to the provider's `warningsAsErrors` setting.
+	WarningsAsErrors pulumi.BoolPtrOutput `pulumi:"warningsAsErrors"`
 }
 
 // NewImage registers a new resource with the given unique name, arguments, and options.
@@ -736,6 +898,9 @@ func NewImage(ctx *pulumi.Context,
 	if args.Push == nil {
 		return nil, errors.New("invalid value for required argument 'Push'")
 	}
+	if args.Attestations != nil {
+		args.Attestations = args.Attestations.ToAttestationsPtrOutput().ApplyT(func(v *Attestations) *Attestations { return v.Defaults() }).(AttestationsPtrOutput)
+	}
 	if args.BuildOnPreview == nil {
 		args.BuildOnPreview = pulumi.BoolPtr(true)
 	}
@@ -786,6 +951,54 @@ type imageArgs struct {
 	//
 	// Equivalent to Docker's `--add-host` flag.
 	AddHosts []string `pulumi:"addHosts"`
+	// Extra privileges to grant to the build.
+	//
+	// The builder must also be configured to permit these entitlements,
+	// for example with `--allow-insecure-entitlement`.
+	//
+	// Equivalent to Docker's `--allow` flag.
+	Allow []Entitlement `pulumi:"allow"`
+	// Glob patterns, like `docker.io/library/*` or
+	// `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+	// are matched against fully-qualified references such as
+	// `docker.io/library/alpine:latest` and their repository names, and `*`
+	// doesn't match `/`.
+	//
+	// ARGs are substituted from `buildArgs`. References to other stages and
+	// to `named` contexts are not restricted.
+	//
+	// Images must also match the provider's `allowedBaseImages`, if set.
+	AllowedBaseImages []string `pulumi:"allowedBaseImages"`
+	// Attach arbitrary key/value annotations to the image's manifests.
+	//
+	// Keys may be prefixed with the level to annotate -- `manifest`,
+	// `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+	// qualified with a platform, for example
+	// `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+	// levels can be separated by commas. Defaults to `manifest`.
+	//
+	// Annotations are applied to every `image`, `registry`, `oci` and
+	// `docker` export. Annotations set directly on an export take
+	// precedence.
+	//
+	// Equivalent to Docker's `--annotation` flag.
+	Annotations map[string]string `pulumi:"annotations"`
+	// Attestations to attach to the image, such as an SBOM or SLSA
+	// provenance.
+	//
+	// Attestations are only exported with `image`, `registry` or `oci`
+	// exports, and require a builder which supports them (the legacy
+	// `docker` driver does not).
+	//
+	// Equivalent to Docker's `--attest` flag.
+	Attestations *Attestations `pulumi:"attestations"`
+	// Pin every external `FROM` and `COPY --from` image to the digest it
+	// resolved to on the first build.
+	//
+	// Later builds substitute `docker-image://<ref>@<digest>` named
+	// contexts for those images, so they don't change until the lock's
+	// `refresh` value does.
+	BaseImageLock *BaseImageLock `pulumi:"baseImageLock"`
 	// `ARG` names and values to set during the build.
 	//
 	// These variables are accessed like environment variables inside `RUN`
@@ -796,6 +1009,12 @@ type imageArgs struct {
 	//
 	// Equivalent to Docker's `--build-arg` flag.
 	BuildArgs map[string]string `pulumi:"buildArgs"`
+	// A file to write the build's full log to, including anything written to
+	// stderr. The log is written whether or not the build succeeds, which is
+	// useful for archiving logs from CI.
+	//
+	// Defaults to a file in the provider's `buildLogDir`, if set.
+	BuildLogPath *string `pulumi:"buildLogPath"`
 	// Setting this to `false` will always skip image builds during previews,
 	// and setting it to `true` will always build images during previews.
 	//
@@ -808,6 +1027,13 @@ type imageArgs struct {
 	// Defaults to `true` as a safeguard against broken images merging as part
 	// of CI pipelines.
 	BuildOnPreview *bool `pulumi:"buildOnPreview"`
+	// A directory to export the build's history record to after it succeeds
+	// or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+	// `buildRecordPath`.
+	//
+	// Equivalent to running `docker buildx history export` after the build.
+	// Records of failed builds are not available when `exec` is `true`.
+	BuildRecordDir *string `pulumi:"buildRecordDir"`
 	// Builder configuration.
 	Builder *BuilderConfig `pulumi:"builder"`
 	// Cache export configuration.
@@ -818,6 +1044,10 @@ type imageArgs struct {
 	//
 	// Equivalent to Docker's `--cache-to` flag.
 	CacheTo []CacheTo `pulumi:"cacheTo"`
+	// Set the parent cgroup for `RUN` instructions.
+	//
+	// Equivalent to Docker's `--cgroup-parent` flag.
+	CgroupParent *string `pulumi:"cgroupParent"`
 	// Build context settings. Defaults to the current directory.
 	//
 	// Equivalent to Docker's `PATH | URL | -` positional argument.
@@ -864,10 +1094,21 @@ type imageArgs struct {
 	//
 	// This is useful when you want to avoid unnecessary rebuilds caused by short-lived secrets that change on every run.
 	IgnoreSecretsInDiffCalculation []string `pulumi:"ignoreSecretsInDiffCalculation"`
+	// Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+	// warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+	// These warnings are still logged.
+	IgnoreWarnings []string `pulumi:"ignoreWarnings"`
 	// Attach arbitrary key/value metadata to the image.
 	//
 	// Equivalent to Docker's `--label` flag.
 	Labels map[string]string `pulumi:"labels"`
+	// Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+	// `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+	// building. Violations are reported as warnings unless configured to be
+	// errors.
+	//
+	// Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+	Lint *Lint `pulumi:"lint"`
 	// When `true` the build will automatically include a `docker` export.
 	//
 	// Defaults to `false`.
@@ -878,6 +1119,9 @@ type imageArgs struct {
 	//
 	// For custom networks, configure your builder with `--driver-opt network=...`.
 	//
+	// The `host` network mode requires the `network.host` entitlement to be
+	// included in `allow`.
+	//
 	// Equivalent to Docker's `--network` flag.
 	Network *NetworkMode `pulumi:"network"`
 	// Do not import cache manifests when building the image.
@@ -888,8 +1132,16 @@ type imageArgs struct {
 	//
 	// Equivalent to Docker's `--platform` flag.
 	Platforms []Platform `pulumi:"platforms"`
+	// How to report build progress. Defaults to the provider's `progress`
+	// setting.
+	//
+	// Equivalent to Docker's `--progress` flag.
+	Progress *ProgressMode `pulumi:"progress"`
 	// Always pull referenced images.
 	//
+	// Images with `pull` are only rebuilt when one of their
+	// `baseImageDigests` has moved, or always if those aren't known.
+	//
 	// Equivalent to Docker's `--pull` flag.
 	Pull *bool `pulumi:"pull"`
 	// When `true` the build will automatically include a `registry` export.
@@ -906,6 +1158,13 @@ type imageArgs struct {
 	//
 	// Similar to `docker login`.
 	Registries []Registry `pulumi:"registries"`
+	// Require every external `FROM` and `COPY --from` image to be pinned
+	// with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+	// Failures suggest the image's current digest when it can be looked up
+	// with the configured registry credentials.
+	//
+	// Defaults to the provider's `requirePinnedBaseImages` setting.
+	RequirePinnedBaseImages *bool `pulumi:"requirePinnedBaseImages"`
 	// A mapping of secret names to their corresponding values.
 	//
 	// Unlike the Docker CLI, these can be passed by value and do not need to
@@ -916,6 +1175,10 @@ type imageArgs struct {
 	//
 	// Similar to Docker's `--secret` flag.
 	Secrets map[string]string `pulumi:"secrets"`
+	// Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+	//
+	// Equivalent to Docker's `--shm-size` flag.
+	ShmSize *string `pulumi:"shmSize"`
 	// SSH agent socket or keys to expose to the build.
 	//
 	// Equivalent to Docker's `--ssh` flag.
@@ -933,6 +1196,16 @@ type imageArgs struct {
 	//
 	// Equivalent to Docker's `--target` flag.
 	Target *string `pulumi:"target"`
+	// Resource limits for `RUN` instructions.
+	//
+	// Equivalent to Docker's `--ulimit` flag.
+	Ulimits []Ulimit `pulumi:"ulimits"`
+	// Fail the build if BuildKit reports any warnings, like Dockerfile lint
+	// rule violations or deprecated syntax. Warnings from rules listed in
+	// `ignoreWarnings` are exempt.
+	//
+	// Defaults to the provider's `warningsAsErrors` setting.
+	WarningsAsErrors *bool `pulumi:"warningsAsErrors"`
 }
 
 // The set of arguments for constructing a Image resource.
@@ -941,6 +1214,54 @@ type ImageArgs struct {
 	//
 	// Equivalent to Docker's `--add-host` flag.
 	AddHosts pulumi.StringArrayInput
+	// Extra privileges to grant to the build.
+	//
+	// The builder must also be configured to permit these entitlements,
+	// for example with `--allow-insecure-entitlement`.
+	//
+	// Equivalent to Docker's `--allow` flag.
+	Allow EntitlementArrayInput
+	// Glob patterns, like `docker.io/library/*` or
+	// `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+	// are matched against fully-qualified references such as
+	// `docker.io/library/alpine:latest` and their repository names, and `*`
+	// doesn't match `/`.
+	//
+	// ARGs are substituted from `buildArgs`. References to other stages and
+	// to `named` contexts are not restricted.
+	//
+	// Images must also match the provider's `allowedBaseImages`, if set.
+	AllowedBaseImages pulumi.StringArrayInput
+	// Attach arbitrary key/value annotations to the image's manifests.
+	//
+	// Keys may be prefixed with the level to annotate -- `manifest`,
+	// `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+	// qualified with a platform, for example
+	// `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+	// levels can be separated by commas. Defaults to `manifest`.
+	//
+	// Annotations are applied to every `image`, `registry`, `oci` and
+	// `docker` export. Annotations set directly on an export take
+	// precedence.
+	//
+	// Equivalent to Docker's `--annotation` flag.
+	Annotations pulumi.StringMapInput
+	// Attestations to attach to the image, such as an SBOM or SLSA
+	// provenance.
+	//
+	// Attestations are only exported with `image`, `registry` or `oci`
+	// exports, and require a builder which supports them (the legacy
+	// `docker` driver does not).
+	//
+	// Equivalent to Docker's `--attest` flag.
+	Attestations AttestationsPtrInput
+	// Pin every external `FROM` and `COPY --from` image to the digest it
+	// resolved to on the first build.
+	//
+	// Later builds substitute `docker-image://<ref>@<digest>` named
+	// contexts for those images, so they don't change until the lock's
+	// `refresh` value does.
+	BaseImageLock BaseImageLockPtrInput
 	// `ARG` names and values to set during the build.
 	//
 	// These variables are accessed like environment variables inside `RUN`
@@ -951,6 +1272,12 @@ type ImageArgs struct {
 	//
 	// Equivalent to Docker's `--build-arg` flag.
 	BuildArgs pulumi.StringMapInput
+	// A file to write the build's full log to, including anything written to
+	// stderr. The log is written whether or not the build succeeds, which is
+	// useful for archiving logs from CI.
+	//
+	// Defaults to a file in the provider's `buildLogDir`, if set.
+	BuildLogPath pulumi.StringPtrInput
 	// Setting this to `false` will always skip image builds during previews,
 	// and setting it to `true` will always build images during previews.
 	//
@@ -963,6 +1290,13 @@ type ImageArgs struct {
 	// Defaults to `true` as a safeguard against broken images merging as part
 	// of CI pipelines.
 	BuildOnPreview pulumi.BoolPtrInput
+	// A directory to export the build's history record to after it succeeds
+	// or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+	// `buildRecordPath`.
+	//
+	// Equivalent to running `docker buildx history export` after the build.
+	// Records of failed builds are not available when `exec` is `true`.
+	BuildRecordDir pulumi.StringPtrInput
 	// Builder configuration.
 	Builder BuilderConfigPtrInput
 	// Cache export configuration.
@@ -973,6 +1307,10 @@ type ImageArgs struct {
 	//
 	// Equivalent to Docker's `--cache-to` flag.
 	CacheTo CacheToArrayInput
+	// Set the parent cgroup for `RUN` instructions.
+	//
+	// Equivalent to Docker's `--cgroup-parent` flag.
+	CgroupParent pulumi.StringPtrInput
 	// Build context settings. Defaults to the current directory.
 	//
 	// Equivalent to Docker's `PATH | URL | -` positional argument.
@@ -1019,10 +1357,21 @@ type ImageArgs struct {
 	//
 	// This is useful when you want to avoid unnecessary rebuilds caused by short-lived secrets that change on every run.
 	IgnoreSecretsInDiffCalculation pulumi.StringArrayInput
+	// Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+	// warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+	// These warnings are still logged.
+	IgnoreWarnings pulumi.StringArrayInput
 	// Attach arbitrary key/value metadata to the image.
 	//
 	// Equivalent to Docker's `--label` flag.
 	Labels pulumi.StringMapInput
+	// Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+	// `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+	// building. Violations are reported as warnings unless configured to be
+	// errors.
+	//
+	// Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+	Lint LintPtrInput
 	// When `true` the build will automatically include a `docker` export.
 	//
 	// Defaults to `false`.
@@ -1033,6 +1382,9 @@ type ImageArgs struct {
 	//
 	// For custom networks, configure your builder with `--driver-opt network=...`.
 	//
+	// The `host` network mode requires the `network.host` entitlement to be
+	// included in `allow`.
+	//
 	// Equivalent to Docker's `--network` flag.
 	Network NetworkModePtrInput
 	// Do not import cache manifests when building the image.
@@ -1043,8 +1395,16 @@ type ImageArgs struct {
 	//
 	// Equivalent to Docker's `--platform` flag.
 	Platforms PlatformArrayInput
+	// How to report build progress. Defaults to the provider's `progress`
+	// setting.
+	//
+	// Equivalent to Docker's `--progress` flag.
+	Progress ProgressModePtrInput
 	// Always pull referenced images.
 	//
+	// Images with `pull` are only rebuilt when one of their
+	// `baseImageDigests` has moved, or always if those aren't known.
+	//
 	// Equivalent to Docker's `--pull` flag.
 	Pull pulumi.BoolPtrInput
 	// When `true` the build will automatically include a `registry` export.
@@ -1061,6 +1421,13 @@ type ImageArgs struct {
 	//
 	// Similar to `docker login`.
 	Registries RegistryArrayInput
+	// Require every external `FROM` and `COPY --from` image to be pinned
+	// with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+	// Failures suggest the image's current digest when it can be looked up
+	// with the configured registry credentials.
+	//
+	// Defaults to the provider's `requirePinnedBaseImages` setting.
+	RequirePinnedBaseImages pulumi.BoolPtrInput
 	// A mapping of secret names to their corresponding values.
 	//
 	// Unlike the Docker CLI, these can be passed by value and do not need to
@@ -1071,6 +1438,10 @@ type ImageArgs struct {
 	//
 	// Similar to Docker's `--secret` flag.
 	Secrets pulumi.StringMapInput
+	// Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+	//
+	// Equivalent to Docker's `--shm-size` flag.
+	ShmSize pulumi.StringPtrInput
 	// SSH agent socket or keys to expose to the build.
 	//
 	// Equivalent to Docker's `--ssh` flag.
@@ -1088,6 +1459,16 @@ type ImageArgs struct {
 	//
 	// Equivalent to Docker's `--target` flag.
 	Target pulumi.StringPtrInput
+	// Resource limits for `RUN` instructions.
+	//
+	// Equivalent to Docker's `--ulimit` flag.
+	Ulimits UlimitArrayInput
+	// Fail the build if BuildKit reports any warnings, like Dockerfile lint
+	// rule violations or deprecated syntax. Warnings from rules listed in
+	// `ignoreWarnings` are exempt.
+	//
+	// Defaults to the provider's `warningsAsErrors` setting.
+	WarningsAsErrors pulumi.BoolPtrInput
 }
 
 func (ImageArgs) ElementType() reflect.Type {
@@ -1146,6 +1527,78 @@ func (o ImageOutput) AddHosts() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *Image) pulumi.StringArrayOutput { return v.AddHosts }).(pulumi.StringArrayOutput)
 }
 
+// Extra privileges to grant to the build.
+//
+// The builder must also be configured to permit these entitlements,
+// for example with `--allow-insecure-entitlement`.
+//
+// Equivalent to Docker's `--allow` flag.
+func (o ImageOutput) Allow() EntitlementArrayOutput {
+	return o.ApplyT(func(v *Image) EntitlementArrayOutput { return v.Allow }).(EntitlementArrayOutput)
+}
+
+// Glob patterns, like `docker.io/library/*` or
+// `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+// are matched against fully-qualified references such as
+// `docker.io/library/alpine:latest` and their repository names, and `*`
+// doesn't match `/`.
+//
+// ARGs are substituted from `buildArgs`. References to other stages and
+// to `named` contexts are not restricted.
+//
+// Images must also match the provider's `allowedBaseImages`, if set.
+func (o ImageOutput) AllowedBaseImages() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringArrayOutput { return v.AllowedBaseImages }).(pulumi.StringArrayOutput)
+}
+
+// Attach arbitrary key/value annotations to the image's manifests.
+//
+// Keys may be prefixed with the level to annotate -- `manifest`,
+// `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+// qualified with a platform, for example
+// `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+// levels can be separated by commas. Defaults to `manifest`.
+//
+// Annotations are applied to every `image`, `registry`, `oci` and
+// `docker` export. Annotations set directly on an export take
+// precedence.
+//
+// Equivalent to Docker's `--annotation` flag.
+func (o ImageOutput) Annotations() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringMapOutput { return v.Annotations }).(pulumi.StringMapOutput)
+}
+
+// Attestations to attach to the image, such as an SBOM or SLSA
+// provenance.
+//
+// Attestations are only exported with `image`, `registry` or `oci`
+// exports, and require a builder which supports them (the legacy
+// `docker` driver does not).
+//
+// Equivalent to Docker's `--attest` flag.
+func (o ImageOutput) Attestations() AttestationsPtrOutput {
+	return o.ApplyT(func(v *Image) AttestationsPtrOutput { return v.Attestations }).(AttestationsPtrOutput)
+}
+
+// The digest each external `FROM` image resolved to when the image was
+// built, keyed by its fully-qualified reference.
+//
+// Only recorded for images with `pull`, which are rebuilt when any of
+// these digests has moved.
+func (o ImageOutput) BaseImageDigests() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringMapOutput { return v.BaseImageDigests }).(pulumi.StringMapOutput)
+}
+
+// Pin every external `FROM` and `COPY --from` image to the digest it
+// resolved to on the first build.
+//
+// Later builds substitute `docker-image://<ref>@<digest>` named
+// contexts for those images, so they don't change until the lock's
+// `refresh` value does.
+func (o ImageOutput) BaseImageLock() BaseImageLockPtrOutput {
+	return o.ApplyT(func(v *Image) BaseImageLockPtrOutput { return v.BaseImageLock }).(BaseImageLockPtrOutput)
+}
+
 // `ARG` names and values to set during the build.
 //
 // These variables are accessed like environment variables inside `RUN`
@@ -1159,6 +1612,21 @@ func (o ImageOutput) BuildArgs() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *Image) pulumi.StringMapOutput { return v.BuildArgs }).(pulumi.StringMapOutput)
 }
 
+// A file to write the build's full log to, including anything written to
+// stderr. The log is written whether or not the build succeeds, which is
+// useful for archiving logs from CI.
+//
+// Defaults to a file in the provider's `buildLogDir`, if set.
+func (o ImageOutput) BuildLogPath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringPtrOutput { return v.BuildLogPath }).(pulumi.StringPtrOutput)
+}
+
+// Metadata returned by buildkit after the build, useful for tracing an
+// image back to its buildx history.
+func (o ImageOutput) BuildMetadata() BuildMetadataPtrOutput {
+	return o.ApplyT(func(v *Image) BuildMetadataPtrOutput { return v.BuildMetadata }).(BuildMetadataPtrOutput)
+}
+
 // Setting this to `false` will always skip image builds during previews,
 // and setting it to `true` will always build images during previews.
 //
@@ -1174,6 +1642,33 @@ func (o ImageOutput) BuildOnPreview() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *Image) pulumi.BoolPtrOutput { return v.BuildOnPreview }).(pulumi.BoolPtrOutput)
 }
 
+// A directory to export the build's history record to after it succeeds
+// or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+// `buildRecordPath`.
+//
+// Equivalent to running `docker buildx history export` after the build.
+// Records of failed builds are not available when `exec` is `true`.
+func (o ImageOutput) BuildRecordDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringPtrOutput { return v.BuildRecordDir }).(pulumi.StringPtrOutput)
+}
+
+// The path of the most recent build's exported history record, if
+// `buildRecordDir` is set.
+//
+// The record can be opened with Docker Desktop's Builds view or imported
+// with `docker buildx history import`.
+func (o ImageOutput) BuildRecordPath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringPtrOutput { return v.BuildRecordPath }).(pulumi.StringPtrOutput)
+}
+
+// Statistics about the most recent build, like its duration and how many
+// steps were cached.
+//
+// Not available when `exec` is `true`.
+func (o ImageOutput) BuildStats() BuildStatsPtrOutput {
+	return o.ApplyT(func(v *Image) BuildStatsPtrOutput { return v.BuildStats }).(BuildStatsPtrOutput)
+}
+
 // Builder configuration.
 func (o ImageOutput) Builder() BuilderConfigPtrOutput {
 	return o.ApplyT(func(v *Image) BuilderConfigPtrOutput { return v.Builder }).(BuilderConfigPtrOutput)
@@ -1193,6 +1688,30 @@ func (o ImageOutput) CacheTo() CacheToArrayOutput {
 	return o.ApplyT(func(v *Image) CacheToArrayOutput { return v.CacheTo }).(CacheToArrayOutput)
 }
 
+// Set the parent cgroup for `RUN` instructions.
+//
+// Equivalent to Docker's `--cgroup-parent` flag.
+func (o ImageOutput) CgroupParent() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringPtrOutput { return v.CgroupParent }).(pulumi.StringPtrOutput)
+}
+
+// The total compressed size in bytes of each platform's pushed layers,
+// keyed by platform in the same way as `platformDigests`.
+//
+// Empty if the image was not pushed to a registry.
+func (o ImageOutput) CompressedSize() pulumi.IntMapOutput {
+	return o.ApplyT(func(v *Image) pulumi.IntMapOutput { return v.CompressedSize }).(pulumi.IntMapOutput)
+}
+
+// Runtime configuration recorded in each platform's pushed image, such
+// as its entrypoint, environment and exposed ports. Keyed by platform
+// in the same way as `platformDigests`.
+//
+// Empty if the image was not pushed to a registry.
+func (o ImageOutput) Config() ImageConfigMapOutput {
+	return o.ApplyT(func(v *Image) ImageConfigMapOutput { return v.Config }).(ImageConfigMapOutput)
+}
+
 // Build context settings. Defaults to the current directory.
 //
 // Equivalent to Docker's `PATH | URL | -` positional argument.
@@ -1207,6 +1726,14 @@ func (o ImageOutput) ContextHash() pulumi.StringOutput {
 	return o.ApplyT(func(v *Image) pulumi.StringOutput { return v.ContextHash }).(pulumi.StringOutput)
 }
 
+// A compressed record of each file contributing to `contextHash`, used
+// to explain which files changed when the image needs to be re-built.
+//
+// Empty for contexts with more than 5000 files.
+func (o ImageOutput) ContextManifest() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringPtrOutput { return v.ContextManifest }).(pulumi.StringPtrOutput)
+}
+
 // A SHA256 digest of the image if it was exported to a registry or
 // elsewhere.
 //
@@ -1272,6 +1799,13 @@ func (o ImageOutput) IgnoreSecretsInDiffCalculation() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *Image) pulumi.StringArrayOutput { return v.IgnoreSecretsInDiffCalculation }).(pulumi.StringArrayOutput)
 }
 
+// Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+// warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+// These warnings are still logged.
+func (o ImageOutput) IgnoreWarnings() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringArrayOutput { return v.IgnoreWarnings }).(pulumi.StringArrayOutput)
+}
+
 // Attach arbitrary key/value metadata to the image.
 //
 // Equivalent to Docker's `--label` flag.
@@ -1279,6 +1813,24 @@ func (o ImageOutput) Labels() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *Image) pulumi.StringMapOutput { return v.Labels }).(pulumi.StringMapOutput)
 }
 
+// The layers of each platform's pushed image, keyed by platform in the
+// same way as `platformDigests`.
+//
+// Empty if the image was not pushed to a registry.
+func (o ImageOutput) Layers() LayerArrayMapOutput {
+	return o.ApplyT(func(v *Image) LayerArrayMapOutput { return v.Layers }).(LayerArrayMapOutput)
+}
+
+// Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+// `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+// building. Violations are reported as warnings unless configured to be
+// errors.
+//
+// Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+func (o ImageOutput) Lint() LintPtrOutput {
+	return o.ApplyT(func(v *Image) LintPtrOutput { return v.Lint }).(LintPtrOutput)
+}
+
 // When `true` the build will automatically include a `docker` export.
 //
 // Defaults to `false`.
@@ -1288,10 +1840,19 @@ func (o ImageOutput) Load() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *Image) pulumi.BoolPtrOutput { return v.Load }).(pulumi.BoolPtrOutput)
 }
 
+// The digests each base image is pinned to by `baseImageLock`, keyed by
+// its fully-qualified reference.
+func (o ImageOutput) LockedBaseImages() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringMapOutput { return v.LockedBaseImages }).(pulumi.StringMapOutput)
+}
+
 // Set the network mode for `RUN` instructions. Defaults to `default`.
 //
 // For custom networks, configure your builder with `--driver-opt network=...`.
 //
+// The `host` network mode requires the `network.host` entitlement to be
+// included in `allow`.
+//
 // Equivalent to Docker's `--network` flag.
 func (o ImageOutput) Network() NetworkModePtrOutput {
 	return o.ApplyT(func(v *Image) NetworkModePtrOutput { return v.Network }).(NetworkModePtrOutput)
@@ -1304,6 +1865,19 @@ func (o ImageOutput) NoCache() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *Image) pulumi.BoolPtrOutput { return v.NoCache }).(pulumi.BoolPtrOutput)
 }
 
+// The digest of each platform-specific manifest pushed to a registry,
+// keyed by platform (for example `linux/arm64`).
+//
+// This is useful when a downstream consumer requires a single-platform
+// image. Attestation manifests are not included. Single-platform images
+// are keyed by the requested platform, or by the platform recorded in
+// their config if `platforms` wasn't specified.
+//
+// Empty if the image was not pushed to a registry.
+func (o ImageOutput) PlatformDigests() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringMapOutput { return v.PlatformDigests }).(pulumi.StringMapOutput)
+}
+
 // Set target platform(s) for the build. Defaults to the host's platform.
 //
 // Equivalent to Docker's `--platform` flag.
@@ -1311,8 +1885,19 @@ func (o ImageOutput) Platforms() PlatformArrayOutput {
 	return o.ApplyT(func(v *Image) PlatformArrayOutput { return v.Platforms }).(PlatformArrayOutput)
 }
 
+// How to report build progress. Defaults to the provider's `progress`
+// setting.
+//
+// Equivalent to Docker's `--progress` flag.
+func (o ImageOutput) Progress() ProgressModePtrOutput {
+	return o.ApplyT(func(v *Image) ProgressModePtrOutput { return v.Progress }).(ProgressModePtrOutput)
+}
+
 // Always pull referenced images.
 //
+// Images with `pull` are only rebuilt when one of their
+// `baseImageDigests` has moved, or always if those aren't known.
+//
 // Equivalent to Docker's `--pull` flag.
 func (o ImageOutput) Pull() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *Image) pulumi.BoolPtrOutput { return v.Pull }).(pulumi.BoolPtrOutput)
@@ -1356,6 +1941,16 @@ func (o ImageOutput) Registries() RegistryArrayOutput {
 	return o.ApplyT(func(v *Image) RegistryArrayOutput { return v.Registries }).(RegistryArrayOutput)
 }
 
+// Require every external `FROM` and `COPY --from` image to be pinned
+// with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+// Failures suggest the image's current digest when it can be looked up
+// with the configured registry credentials.
+//
+// Defaults to the provider's `requirePinnedBaseImages` setting.
+func (o ImageOutput) RequirePinnedBaseImages() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Image) pulumi.BoolPtrOutput { return v.RequirePinnedBaseImages }).(pulumi.BoolPtrOutput)
+}
+
 // A mapping of secret names to their corresponding values.
 //
 // Unlike the Docker CLI, these can be passed by value and do not need to
@@ -1369,6 +1964,13 @@ func (o ImageOutput) Secrets() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *Image) pulumi.StringMapOutput { return v.Secrets }).(pulumi.StringMapOutput)
 }
 
+// Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+//
+// Equivalent to Docker's `--shm-size` flag.
+func (o ImageOutput) ShmSize() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringPtrOutput { return v.ShmSize }).(pulumi.StringPtrOutput)
+}
+
 // SSH agent socket or keys to expose to the build.
 //
 // Equivalent to Docker's `--ssh` flag.
@@ -1395,6 +1997,22 @@ func (o ImageOutput) Target() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Image) pulumi.StringPtrOutput { return v.Target }).(pulumi.StringPtrOutput)
 }
 
+// Resource limits for `RUN` instructions.
+//
+// Equivalent to Docker's `--ulimit` flag.
+func (o ImageOutput) Ulimits() UlimitArrayOutput {
+	return o.ApplyT(func(v *Image) UlimitArrayOutput { return v.Ulimits }).(UlimitArrayOutput)
+}
+
+// Fail the build if BuildKit reports any warnings, like Dockerfile lint
+// rule violations or deprecated syntax. Warnings from rules listed in
+// `ignoreWarnings` are exempt.
+//
+// Defaults to the provider's `warningsAsErrors` setting.
+func (o ImageOutput) WarningsAsErrors() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Image) pulumi.BoolPtrOutput { return v.WarningsAsErrors }).(pulumi.BoolPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ImageInput)(nil)).Elem(), &Image{})
 	pulumi.RegisterOutputType(ImageOutput{})
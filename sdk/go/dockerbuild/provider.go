@@ -15,8 +15,29 @@ import (
 type Provider struct {
 	pulumi.ProviderResourceState
 
+	// A directory to write the full log of each image build to, for images
+	// which don't specify their own `buildLogPath`.
+	//
+	// Logs are named after the image's first tag, or the resource's name if
+	// it has no tags.
+	BuildLogDir pulumi.StringPtrOutput `pulumi:"buildLogDir"`
+	// A directory to cache build context hashes in, so files which haven't
+	// changed aren't read again on later runs.
+	//
+	// Files are considered unchanged if their size, modification time and
+	// inode are the same. The resulting `contextHash` is unaffected.
+	ContextHashCacheDir pulumi.StringPtrOutput `pulumi:"contextHashCacheDir"`
 	// The build daemon's address.
 	Host pulumi.StringPtrOutput `pulumi:"host"`
+	// An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+	// of resource operations and builds to. gRPC endpoints aren't supported.
+	//
+	// Spans join the trace in the `TRACEPARENT` environment variable, if set,
+	// and trace context is propagated to the build daemon.
+	OtlpEndpoint pulumi.StringPtrOutput `pulumi:"otlpEndpoint"`
+	// How to report build progress for images which don't specify their own
+	// `progress`. Defaults to `plain`.
+	Progress ProgressModePtrOutput `pulumi:"progress"`
 }
 
 // NewProvider registers a new resource with the given unique name, arguments, and options.
@@ -31,6 +52,11 @@ func NewProvider(ctx *pulumi.Context,
 			args.Host = pulumi.StringPtr(d.(string))
 		}
 	}
+	if args.OtlpEndpoint == nil {
+		if d := internal.GetEnvOrDefault("", nil, "PULUMI_DOCKER_BUILD_OTLP_ENDPOINT"); d != nil {
+			args.OtlpEndpoint = pulumi.StringPtr(d.(string))
+		}
+	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Provider
 	err := ctx.RegisterResource("pulumi:providers:docker-build", name, args, &resource, opts...)
@@ -41,16 +67,80 @@ func NewProvider(ctx *pulumi.Context,
 }
 
 type providerArgs struct {
+	// Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+	// instructions must match. This applies in addition to each image's own
+	// `allowedBaseImages`.
+	AllowedBaseImages []string `pulumi:"allowedBaseImages"`
+	// A directory to write the full log of each image build to, for images
+	// which don't specify their own `buildLogPath`.
+	//
+	// Logs are named after the image's first tag, or the resource's name if
+	// it has no tags.
+	BuildLogDir *string `pulumi:"buildLogDir"`
+	// A directory to cache build context hashes in, so files which haven't
+	// changed aren't read again on later runs.
+	//
+	// Files are considered unchanged if their size, modification time and
+	// inode are the same. The resulting `contextHash` is unaffected.
+	ContextHashCacheDir *string `pulumi:"contextHashCacheDir"`
 	// The build daemon's address.
-	Host       *string    `pulumi:"host"`
-	Registries []Registry `pulumi:"registries"`
+	Host *string `pulumi:"host"`
+	// An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+	// of resource operations and builds to. gRPC endpoints aren't supported.
+	//
+	// Spans join the trace in the `TRACEPARENT` environment variable, if set,
+	// and trace context is propagated to the build daemon.
+	OtlpEndpoint *string `pulumi:"otlpEndpoint"`
+	// How to report build progress for images which don't specify their own
+	// `progress`. Defaults to `plain`.
+	Progress   *ProgressMode `pulumi:"progress"`
+	Registries []Registry    `pulumi:"registries"`
+	// Require external `FROM` and `COPY --from` images to be pinned with an
+	// `@sha256:` digest, for images which don't specify their own
+	// `requirePinnedBaseImages`.
+	RequirePinnedBaseImages *bool `pulumi:"requirePinnedBaseImages"`
+	// Fail image builds if BuildKit reports any warnings, for images which
+	// don't specify their own `warningsAsErrors`.
+	WarningsAsErrors *bool `pulumi:"warningsAsErrors"`
 }
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
+	// Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+	// instructions must match. This applies in addition to each image's own
+	// `allowedBaseImages`.
+	AllowedBaseImages pulumi.StringArrayInput
+	// A directory to write the full log of each image build to, for images
+	// which don't specify their own `buildLogPath`.
+	//
+	// Logs are named after the image's first tag, or the resource's name if
+	// it has no tags.
+	BuildLogDir pulumi.StringPtrInput
+	// A directory to cache build context hashes in, so files which haven't
+	// changed aren't read again on later runs.
+	//
+	// Files are considered unchanged if their size, modification time and
+	// inode are the same. The resulting `contextHash` is unaffected.
+	ContextHashCacheDir pulumi.StringPtrInput
 	// The build daemon's address.
-	Host       pulumi.StringPtrInput
+	Host pulumi.StringPtrInput
+	// An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+	// of resource operations and builds to. gRPC endpoints aren't supported.
+	//
+	// Spans join the trace in the `TRACEPARENT` environment variable, if set,
+	// and trace context is propagated to the build daemon.
+	OtlpEndpoint pulumi.StringPtrInput
+	// How to report build progress for images which don't specify their own
+	// `progress`. Defaults to `plain`.
+	Progress   ProgressModePtrInput
 	Registries RegistryArrayInput
+	// Require external `FROM` and `COPY --from` images to be pinned with an
+	// `@sha256:` digest, for images which don't specify their own
+	// `requirePinnedBaseImages`.
+	RequirePinnedBaseImages pulumi.BoolPtrInput
+	// Fail image builds if BuildKit reports any warnings, for images which
+	// don't specify their own `warningsAsErrors`.
+	WarningsAsErrors pulumi.BoolPtrInput
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
@@ -102,11 +192,44 @@ func (o ProviderOutput) ToOutput(ctx context.Context) pulumix.Output[*Provider]
 	}
 }
 
+// A directory to write the full log of each image build to, for images
+// which don't specify their own `buildLogPath`.
+//
+// Logs are named after the image's first tag, or the resource's name if
+// it has no tags.
+func (o ProviderOutput) BuildLogDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.BuildLogDir }).(pulumi.StringPtrOutput)
+}
+
+// A directory to cache build context hashes in, so files which haven't
+// changed aren't read again on later runs.
+//
+// Files are considered unchanged if their size, modification time and
+// inode are the same. The resulting `contextHash` is unaffected.
+func (o ProviderOutput) ContextHashCacheDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.ContextHashCacheDir }).(pulumi.StringPtrOutput)
+}
+
 // The build daemon's address.
 func (o ProviderOutput) Host() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.Host }).(pulumi.StringPtrOutput)
 }
 
+// An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+// of resource operations and builds to. gRPC endpoints aren't supported.
+//
+// Spans join the trace in the `TRACEPARENT` environment variable, if set,
+// and trace context is propagated to the build daemon.
+func (o ProviderOutput) OtlpEndpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.OtlpEndpoint }).(pulumi.StringPtrOutput)
+}
+
+// How to report build progress for images which don't specify their own
+// `progress`. Defaults to `plain`.
+func (o ProviderOutput) Progress() ProgressModePtrOutput {
+	return o.ApplyT(func(v *Provider) ProgressModePtrOutput { return v.Progress }).(ProgressModePtrOutput)
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ProviderInput)(nil)).Elem(), &Provider{})
 	pulumi.RegisterOutputType(ProviderOutput{})
@@ -384,6 +384,433 @@ func (in *compressionTypePtr) ToOutput(ctx context.Context) pulumix.Output[*Comp
 	}
 }
 
+type ContextHashMode string
+
+const (
+	// Hash every file in the context which isn't excluded by ".dockerignore".
+	ContextHashModeAll = ContextHashMode("all")
+	// Hash only the files reachable from "COPY" and "ADD" sources, falling back to every file when they can't be determined statically.
+	ContextHashModeReferenced = ContextHashMode("referenced")
+)
+
+func (ContextHashMode) ElementType() reflect.Type {
+	return reflect.TypeOf((*ContextHashMode)(nil)).Elem()
+}
+
+func (e ContextHashMode) ToContextHashModeOutput() ContextHashModeOutput {
+	return pulumi.ToOutput(e).(ContextHashModeOutput)
+}
+
+func (e ContextHashMode) ToContextHashModeOutputWithContext(ctx context.Context) ContextHashModeOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(ContextHashModeOutput)
+}
+
+func (e ContextHashMode) ToContextHashModePtrOutput() ContextHashModePtrOutput {
+	return e.ToContextHashModePtrOutputWithContext(context.Background())
+}
+
+func (e ContextHashMode) ToContextHashModePtrOutputWithContext(ctx context.Context) ContextHashModePtrOutput {
+	return ContextHashMode(e).ToContextHashModeOutputWithContext(ctx).ToContextHashModePtrOutputWithContext(ctx)
+}
+
+func (e ContextHashMode) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ContextHashMode) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ContextHashMode) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e ContextHashMode) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type ContextHashModeOutput struct{ *pulumi.OutputState }
+
+func (ContextHashModeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ContextHashMode)(nil)).Elem()
+}
+
+func (o ContextHashModeOutput) ToContextHashModeOutput() ContextHashModeOutput {
+	return o
+}
+
+func (o ContextHashModeOutput) ToContextHashModeOutputWithContext(ctx context.Context) ContextHashModeOutput {
+	return o
+}
+
+func (o ContextHashModeOutput) ToContextHashModePtrOutput() ContextHashModePtrOutput {
+	return o.ToContextHashModePtrOutputWithContext(context.Background())
+}
+
+func (o ContextHashModeOutput) ToContextHashModePtrOutputWithContext(ctx context.Context) ContextHashModePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ContextHashMode) *ContextHashMode {
+		return &v
+	}).(ContextHashModePtrOutput)
+}
+
+func (o ContextHashModeOutput) ToOutput(ctx context.Context) pulumix.Output[ContextHashMode] {
+	return pulumix.Output[ContextHashMode]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ContextHashModeOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o ContextHashModeOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ContextHashMode) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o ContextHashModeOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ContextHashModeOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ContextHashMode) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type ContextHashModePtrOutput struct{ *pulumi.OutputState }
+
+func (ContextHashModePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ContextHashMode)(nil)).Elem()
+}
+
+func (o ContextHashModePtrOutput) ToContextHashModePtrOutput() ContextHashModePtrOutput {
+	return o
+}
+
+func (o ContextHashModePtrOutput) ToContextHashModePtrOutputWithContext(ctx context.Context) ContextHashModePtrOutput {
+	return o
+}
+
+func (o ContextHashModePtrOutput) ToOutput(ctx context.Context) pulumix.Output[*ContextHashMode] {
+	return pulumix.Output[*ContextHashMode]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ContextHashModePtrOutput) Elem() ContextHashModeOutput {
+	return o.ApplyT(func(v *ContextHashMode) ContextHashMode {
+		if v != nil {
+			return *v
+		}
+		var ret ContextHashMode
+		return ret
+	}).(ContextHashModeOutput)
+}
+
+func (o ContextHashModePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ContextHashModePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *ContextHashMode) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// ContextHashModeInput is an input type that accepts values of the ContextHashMode enum
+// A concrete instance of `ContextHashModeInput` can be one of the following:
+//
+//	ContextHashModeAll
+//	ContextHashModeReferenced
+type ContextHashModeInput interface {
+	pulumi.Input
+
+	ToContextHashModeOutput() ContextHashModeOutput
+	ToContextHashModeOutputWithContext(context.Context) ContextHashModeOutput
+}
+
+var contextHashModePtrType = reflect.TypeOf((**ContextHashMode)(nil)).Elem()
+
+type ContextHashModePtrInput interface {
+	pulumi.Input
+
+	ToContextHashModePtrOutput() ContextHashModePtrOutput
+	ToContextHashModePtrOutputWithContext(context.Context) ContextHashModePtrOutput
+}
+
+type contextHashModePtr string
+
+func ContextHashModePtr(v string) ContextHashModePtrInput {
+	return (*contextHashModePtr)(&v)
+}
+
+func (*contextHashModePtr) ElementType() reflect.Type {
+	return contextHashModePtrType
+}
+
+func (in *contextHashModePtr) ToContextHashModePtrOutput() ContextHashModePtrOutput {
+	return pulumi.ToOutput(in).(ContextHashModePtrOutput)
+}
+
+func (in *contextHashModePtr) ToContextHashModePtrOutputWithContext(ctx context.Context) ContextHashModePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(ContextHashModePtrOutput)
+}
+
+func (in *contextHashModePtr) ToOutput(ctx context.Context) pulumix.Output[*ContextHashMode] {
+	return pulumix.Output[*ContextHashMode]{
+		OutputState: in.ToContextHashModePtrOutputWithContext(ctx).OutputState,
+	}
+}
+
+type Entitlement string
+
+const (
+	// Allow "RUN --network=host" and the "host" network mode.
+	Entitlement_Network_host = Entitlement("network.host")
+	// Allow "RUN --security=insecure" to run privileged containers.
+	Entitlement_Security_insecure = Entitlement("security.insecure")
+)
+
+func (Entitlement) ElementType() reflect.Type {
+	return reflect.TypeOf((*Entitlement)(nil)).Elem()
+}
+
+func (e Entitlement) ToEntitlementOutput() EntitlementOutput {
+	return pulumi.ToOutput(e).(EntitlementOutput)
+}
+
+func (e Entitlement) ToEntitlementOutputWithContext(ctx context.Context) EntitlementOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(EntitlementOutput)
+}
+
+func (e Entitlement) ToEntitlementPtrOutput() EntitlementPtrOutput {
+	return e.ToEntitlementPtrOutputWithContext(context.Background())
+}
+
+func (e Entitlement) ToEntitlementPtrOutputWithContext(ctx context.Context) EntitlementPtrOutput {
+	return Entitlement(e).ToEntitlementOutputWithContext(ctx).ToEntitlementPtrOutputWithContext(ctx)
+}
+
+func (e Entitlement) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e Entitlement) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e Entitlement) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e Entitlement) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type EntitlementOutput struct{ *pulumi.OutputState }
+
+func (EntitlementOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Entitlement)(nil)).Elem()
+}
+
+func (o EntitlementOutput) ToEntitlementOutput() EntitlementOutput {
+	return o
+}
+
+func (o EntitlementOutput) ToEntitlementOutputWithContext(ctx context.Context) EntitlementOutput {
+	return o
+}
+
+func (o EntitlementOutput) ToEntitlementPtrOutput() EntitlementPtrOutput {
+	return o.ToEntitlementPtrOutputWithContext(context.Background())
+}
+
+func (o EntitlementOutput) ToEntitlementPtrOutputWithContext(ctx context.Context) EntitlementPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Entitlement) *Entitlement {
+		return &v
+	}).(EntitlementPtrOutput)
+}
+
+func (o EntitlementOutput) ToOutput(ctx context.Context) pulumix.Output[Entitlement] {
+	return pulumix.Output[Entitlement]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o EntitlementOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o EntitlementOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e Entitlement) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o EntitlementOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o EntitlementOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e Entitlement) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type EntitlementPtrOutput struct{ *pulumi.OutputState }
+
+func (EntitlementPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Entitlement)(nil)).Elem()
+}
+
+func (o EntitlementPtrOutput) ToEntitlementPtrOutput() EntitlementPtrOutput {
+	return o
+}
+
+func (o EntitlementPtrOutput) ToEntitlementPtrOutputWithContext(ctx context.Context) EntitlementPtrOutput {
+	return o
+}
+
+func (o EntitlementPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*Entitlement] {
+	return pulumix.Output[*Entitlement]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o EntitlementPtrOutput) Elem() EntitlementOutput {
+	return o.ApplyT(func(v *Entitlement) Entitlement {
+		if v != nil {
+			return *v
+		}
+		var ret Entitlement
+		return ret
+	}).(EntitlementOutput)
+}
+
+func (o EntitlementPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o EntitlementPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *Entitlement) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// EntitlementInput is an input type that accepts values of the Entitlement enum
+// A concrete instance of `EntitlementInput` can be one of the following:
+//
+//	Entitlement_Network_host
+//	Entitlement_Security_insecure
+type EntitlementInput interface {
+	pulumi.Input
+
+	ToEntitlementOutput() EntitlementOutput
+	ToEntitlementOutputWithContext(context.Context) EntitlementOutput
+}
+
+var entitlementPtrType = reflect.TypeOf((**Entitlement)(nil)).Elem()
+
+type EntitlementPtrInput interface {
+	pulumi.Input
+
+	ToEntitlementPtrOutput() EntitlementPtrOutput
+	ToEntitlementPtrOutputWithContext(context.Context) EntitlementPtrOutput
+}
+
+type entitlementPtr string
+
+func EntitlementPtr(v string) EntitlementPtrInput {
+	return (*entitlementPtr)(&v)
+}
+
+func (*entitlementPtr) ElementType() reflect.Type {
+	return entitlementPtrType
+}
+
+func (in *entitlementPtr) ToEntitlementPtrOutput() EntitlementPtrOutput {
+	return pulumi.ToOutput(in).(EntitlementPtrOutput)
+}
+
+func (in *entitlementPtr) ToEntitlementPtrOutputWithContext(ctx context.Context) EntitlementPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(EntitlementPtrOutput)
+}
+
+func (in *entitlementPtr) ToOutput(ctx context.Context) pulumix.Output[*Entitlement] {
+	return pulumix.Output[*Entitlement]{
+		OutputState: in.ToEntitlementPtrOutputWithContext(ctx).OutputState,
+	}
+}
+
+// EntitlementArrayInput is an input type that accepts EntitlementArray and EntitlementArrayOutput values.
+// You can construct a concrete instance of `EntitlementArrayInput` via:
+//
+//	EntitlementArray{ EntitlementArgs{...} }
+type EntitlementArrayInput interface {
+	pulumi.Input
+
+	ToEntitlementArrayOutput() EntitlementArrayOutput
+	ToEntitlementArrayOutputWithContext(context.Context) EntitlementArrayOutput
+}
+
+type EntitlementArray []Entitlement
+
+func (EntitlementArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Entitlement)(nil)).Elem()
+}
+
+func (i EntitlementArray) ToEntitlementArrayOutput() EntitlementArrayOutput {
+	return i.ToEntitlementArrayOutputWithContext(context.Background())
+}
+
+func (i EntitlementArray) ToEntitlementArrayOutputWithContext(ctx context.Context) EntitlementArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EntitlementArrayOutput)
+}
+
+func (i EntitlementArray) ToOutput(ctx context.Context) pulumix.Output[[]Entitlement] {
+	return pulumix.Output[[]Entitlement]{
+		OutputState: i.ToEntitlementArrayOutputWithContext(ctx).OutputState,
+	}
+}
+
+type EntitlementArrayOutput struct{ *pulumi.OutputState }
+
+func (EntitlementArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Entitlement)(nil)).Elem()
+}
+
+func (o EntitlementArrayOutput) ToEntitlementArrayOutput() EntitlementArrayOutput {
+	return o
+}
+
+func (o EntitlementArrayOutput) ToEntitlementArrayOutputWithContext(ctx context.Context) EntitlementArrayOutput {
+	return o
+}
+
+func (o EntitlementArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]Entitlement] {
+	return pulumix.Output[[]Entitlement]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o EntitlementArrayOutput) Index(i pulumi.IntInput) EntitlementOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Entitlement {
+		return vs[0].([]Entitlement)[vs[1].(int)]
+	}).(EntitlementOutput)
+}
+
 type NetworkMode string
 
 const (
@@ -864,23 +1291,414 @@ func (o PlatformArrayOutput) Index(i pulumi.IntInput) PlatformOutput {
 	}).(PlatformOutput)
 }
 
+type ProgressMode string
+
+const (
+	// Stream plain-text progress as the build runs.
+	ProgressModePlain = ProgressMode("plain")
+	// Log each build status update as a line of JSON.
+	ProgressModeRawjson = ProgressMode("rawjson")
+	// Only report progress if the build fails.
+	ProgressModeQuiet = ProgressMode("quiet")
+)
+
+func (ProgressMode) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProgressMode)(nil)).Elem()
+}
+
+func (e ProgressMode) ToProgressModeOutput() ProgressModeOutput {
+	return pulumi.ToOutput(e).(ProgressModeOutput)
+}
+
+func (e ProgressMode) ToProgressModeOutputWithContext(ctx context.Context) ProgressModeOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(ProgressModeOutput)
+}
+
+func (e ProgressMode) ToProgressModePtrOutput() ProgressModePtrOutput {
+	return e.ToProgressModePtrOutputWithContext(context.Background())
+}
+
+func (e ProgressMode) ToProgressModePtrOutputWithContext(ctx context.Context) ProgressModePtrOutput {
+	return ProgressMode(e).ToProgressModeOutputWithContext(ctx).ToProgressModePtrOutputWithContext(ctx)
+}
+
+func (e ProgressMode) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ProgressMode) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ProgressMode) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e ProgressMode) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type ProgressModeOutput struct{ *pulumi.OutputState }
+
+func (ProgressModeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProgressMode)(nil)).Elem()
+}
+
+func (o ProgressModeOutput) ToProgressModeOutput() ProgressModeOutput {
+	return o
+}
+
+func (o ProgressModeOutput) ToProgressModeOutputWithContext(ctx context.Context) ProgressModeOutput {
+	return o
+}
+
+func (o ProgressModeOutput) ToProgressModePtrOutput() ProgressModePtrOutput {
+	return o.ToProgressModePtrOutputWithContext(context.Background())
+}
+
+func (o ProgressModeOutput) ToProgressModePtrOutputWithContext(ctx context.Context) ProgressModePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ProgressMode) *ProgressMode {
+		return &v
+	}).(ProgressModePtrOutput)
+}
+
+func (o ProgressModeOutput) ToOutput(ctx context.Context) pulumix.Output[ProgressMode] {
+	return pulumix.Output[ProgressMode]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ProgressModeOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o ProgressModeOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ProgressMode) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o ProgressModeOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ProgressModeOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ProgressMode) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type ProgressModePtrOutput struct{ *pulumi.OutputState }
+
+func (ProgressModePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProgressMode)(nil)).Elem()
+}
+
+func (o ProgressModePtrOutput) ToProgressModePtrOutput() ProgressModePtrOutput {
+	return o
+}
+
+func (o ProgressModePtrOutput) ToProgressModePtrOutputWithContext(ctx context.Context) ProgressModePtrOutput {
+	return o
+}
+
+func (o ProgressModePtrOutput) ToOutput(ctx context.Context) pulumix.Output[*ProgressMode] {
+	return pulumix.Output[*ProgressMode]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ProgressModePtrOutput) Elem() ProgressModeOutput {
+	return o.ApplyT(func(v *ProgressMode) ProgressMode {
+		if v != nil {
+			return *v
+		}
+		var ret ProgressMode
+		return ret
+	}).(ProgressModeOutput)
+}
+
+func (o ProgressModePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ProgressModePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *ProgressMode) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// ProgressModeInput is an input type that accepts values of the ProgressMode enum
+// A concrete instance of `ProgressModeInput` can be one of the following:
+//
+//	ProgressModePlain
+//	ProgressModeRawjson
+//	ProgressModeQuiet
+type ProgressModeInput interface {
+	pulumi.Input
+
+	ToProgressModeOutput() ProgressModeOutput
+	ToProgressModeOutputWithContext(context.Context) ProgressModeOutput
+}
+
+var progressModePtrType = reflect.TypeOf((**ProgressMode)(nil)).Elem()
+
+type ProgressModePtrInput interface {
+	pulumi.Input
+
+	ToProgressModePtrOutput() ProgressModePtrOutput
+	ToProgressModePtrOutputWithContext(context.Context) ProgressModePtrOutput
+}
+
+type progressModePtr string
+
+func ProgressModePtr(v string) ProgressModePtrInput {
+	return (*progressModePtr)(&v)
+}
+
+func (*progressModePtr) ElementType() reflect.Type {
+	return progressModePtrType
+}
+
+func (in *progressModePtr) ToProgressModePtrOutput() ProgressModePtrOutput {
+	return pulumi.ToOutput(in).(ProgressModePtrOutput)
+}
+
+func (in *progressModePtr) ToProgressModePtrOutputWithContext(ctx context.Context) ProgressModePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(ProgressModePtrOutput)
+}
+
+func (in *progressModePtr) ToOutput(ctx context.Context) pulumix.Output[*ProgressMode] {
+	return pulumix.Output[*ProgressMode]{
+		OutputState: in.ToProgressModePtrOutputWithContext(ctx).OutputState,
+	}
+}
+
+type ProvenanceMode string
+
+const (
+	// Only include a minimal description of the build.
+	ProvenanceModeMin = ProvenanceMode("min")
+	// Include detailed information about the build, including build arguments and source mappings.
+	ProvenanceModeMax = ProvenanceMode("max")
+)
+
+func (ProvenanceMode) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProvenanceMode)(nil)).Elem()
+}
+
+func (e ProvenanceMode) ToProvenanceModeOutput() ProvenanceModeOutput {
+	return pulumi.ToOutput(e).(ProvenanceModeOutput)
+}
+
+func (e ProvenanceMode) ToProvenanceModeOutputWithContext(ctx context.Context) ProvenanceModeOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(ProvenanceModeOutput)
+}
+
+func (e ProvenanceMode) ToProvenanceModePtrOutput() ProvenanceModePtrOutput {
+	return e.ToProvenanceModePtrOutputWithContext(context.Background())
+}
+
+func (e ProvenanceMode) ToProvenanceModePtrOutputWithContext(ctx context.Context) ProvenanceModePtrOutput {
+	return ProvenanceMode(e).ToProvenanceModeOutputWithContext(ctx).ToProvenanceModePtrOutputWithContext(ctx)
+}
+
+func (e ProvenanceMode) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ProvenanceMode) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ProvenanceMode) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e ProvenanceMode) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type ProvenanceModeOutput struct{ *pulumi.OutputState }
+
+func (ProvenanceModeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProvenanceMode)(nil)).Elem()
+}
+
+func (o ProvenanceModeOutput) ToProvenanceModeOutput() ProvenanceModeOutput {
+	return o
+}
+
+func (o ProvenanceModeOutput) ToProvenanceModeOutputWithContext(ctx context.Context) ProvenanceModeOutput {
+	return o
+}
+
+func (o ProvenanceModeOutput) ToProvenanceModePtrOutput() ProvenanceModePtrOutput {
+	return o.ToProvenanceModePtrOutputWithContext(context.Background())
+}
+
+func (o ProvenanceModeOutput) ToProvenanceModePtrOutputWithContext(ctx context.Context) ProvenanceModePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ProvenanceMode) *ProvenanceMode {
+		return &v
+	}).(ProvenanceModePtrOutput)
+}
+
+func (o ProvenanceModeOutput) ToOutput(ctx context.Context) pulumix.Output[ProvenanceMode] {
+	return pulumix.Output[ProvenanceMode]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ProvenanceModeOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o ProvenanceModeOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ProvenanceMode) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o ProvenanceModeOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ProvenanceModeOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ProvenanceMode) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type ProvenanceModePtrOutput struct{ *pulumi.OutputState }
+
+func (ProvenanceModePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProvenanceMode)(nil)).Elem()
+}
+
+func (o ProvenanceModePtrOutput) ToProvenanceModePtrOutput() ProvenanceModePtrOutput {
+	return o
+}
+
+func (o ProvenanceModePtrOutput) ToProvenanceModePtrOutputWithContext(ctx context.Context) ProvenanceModePtrOutput {
+	return o
+}
+
+func (o ProvenanceModePtrOutput) ToOutput(ctx context.Context) pulumix.Output[*ProvenanceMode] {
+	return pulumix.Output[*ProvenanceMode]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ProvenanceModePtrOutput) Elem() ProvenanceModeOutput {
+	return o.ApplyT(func(v *ProvenanceMode) ProvenanceMode {
+		if v != nil {
+			return *v
+		}
+		var ret ProvenanceMode
+		return ret
+	}).(ProvenanceModeOutput)
+}
+
+func (o ProvenanceModePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ProvenanceModePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *ProvenanceMode) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// ProvenanceModeInput is an input type that accepts values of the ProvenanceMode enum
+// A concrete instance of `ProvenanceModeInput` can be one of the following:
+//
+//	ProvenanceModeMin
+//	ProvenanceModeMax
+type ProvenanceModeInput interface {
+	pulumi.Input
+
+	ToProvenanceModeOutput() ProvenanceModeOutput
+	ToProvenanceModeOutputWithContext(context.Context) ProvenanceModeOutput
+}
+
+var provenanceModePtrType = reflect.TypeOf((**ProvenanceMode)(nil)).Elem()
+
+type ProvenanceModePtrInput interface {
+	pulumi.Input
+
+	ToProvenanceModePtrOutput() ProvenanceModePtrOutput
+	ToProvenanceModePtrOutputWithContext(context.Context) ProvenanceModePtrOutput
+}
+
+type provenanceModePtr string
+
+func ProvenanceModePtr(v string) ProvenanceModePtrInput {
+	return (*provenanceModePtr)(&v)
+}
+
+func (*provenanceModePtr) ElementType() reflect.Type {
+	return provenanceModePtrType
+}
+
+func (in *provenanceModePtr) ToProvenanceModePtrOutput() ProvenanceModePtrOutput {
+	return pulumi.ToOutput(in).(ProvenanceModePtrOutput)
+}
+
+func (in *provenanceModePtr) ToProvenanceModePtrOutputWithContext(ctx context.Context) ProvenanceModePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(ProvenanceModePtrOutput)
+}
+
+func (in *provenanceModePtr) ToOutput(ctx context.Context) pulumix.Output[*ProvenanceMode] {
+	return pulumix.Output[*ProvenanceMode]{
+		OutputState: in.ToProvenanceModePtrOutputWithContext(ctx).OutputState,
+	}
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*CacheModeInput)(nil)).Elem(), CacheMode("min"))
 	pulumi.RegisterInputType(reflect.TypeOf((*CacheModePtrInput)(nil)).Elem(), CacheMode("min"))
 	pulumi.RegisterInputType(reflect.TypeOf((*CompressionTypeInput)(nil)).Elem(), CompressionType("gzip"))
 	pulumi.RegisterInputType(reflect.TypeOf((*CompressionTypePtrInput)(nil)).Elem(), CompressionType("gzip"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ContextHashModeInput)(nil)).Elem(), ContextHashMode("all"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ContextHashModePtrInput)(nil)).Elem(), ContextHashMode("all"))
+	pulumi.RegisterInputType(reflect.TypeOf((*EntitlementInput)(nil)).Elem(), Entitlement("network.host"))
+	pulumi.RegisterInputType(reflect.TypeOf((*EntitlementPtrInput)(nil)).Elem(), Entitlement("network.host"))
+	pulumi.RegisterInputType(reflect.TypeOf((*EntitlementArrayInput)(nil)).Elem(), EntitlementArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*NetworkModeInput)(nil)).Elem(), NetworkMode("default"))
 	pulumi.RegisterInputType(reflect.TypeOf((*NetworkModePtrInput)(nil)).Elem(), NetworkMode("default"))
 	pulumi.RegisterInputType(reflect.TypeOf((*PlatformInput)(nil)).Elem(), Platform("darwin/386"))
 	pulumi.RegisterInputType(reflect.TypeOf((*PlatformPtrInput)(nil)).Elem(), Platform("darwin/386"))
 	pulumi.RegisterInputType(reflect.TypeOf((*PlatformArrayInput)(nil)).Elem(), PlatformArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProgressModeInput)(nil)).Elem(), ProgressMode("plain"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ProgressModePtrInput)(nil)).Elem(), ProgressMode("plain"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ProvenanceModeInput)(nil)).Elem(), ProvenanceMode("min"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ProvenanceModePtrInput)(nil)).Elem(), ProvenanceMode("min"))
 	pulumi.RegisterOutputType(CacheModeOutput{})
 	pulumi.RegisterOutputType(CacheModePtrOutput{})
 	pulumi.RegisterOutputType(CompressionTypeOutput{})
 	pulumi.RegisterOutputType(CompressionTypePtrOutput{})
+	pulumi.RegisterOutputType(ContextHashModeOutput{})
+	pulumi.RegisterOutputType(ContextHashModePtrOutput{})
+	pulumi.RegisterOutputType(EntitlementOutput{})
+	pulumi.RegisterOutputType(EntitlementPtrOutput{})
+	pulumi.RegisterOutputType(EntitlementArrayOutput{})
 	pulumi.RegisterOutputType(NetworkModeOutput{})
 	pulumi.RegisterOutputType(NetworkModePtrOutput{})
 	pulumi.RegisterOutputType(PlatformOutput{})
 	pulumi.RegisterOutputType(PlatformPtrOutput{})
 	pulumi.RegisterOutputType(PlatformArrayOutput{})
+	pulumi.RegisterOutputType(ProgressModeOutput{})
+	pulumi.RegisterOutputType(ProgressModePtrOutput{})
+	pulumi.RegisterOutputType(ProvenanceModeOutput{})
+	pulumi.RegisterOutputType(ProvenanceModePtrOutput{})
 }
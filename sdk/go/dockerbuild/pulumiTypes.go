@@ -14,7 +14,442 @@ import (
 
 var _ = internal.GetEnvOrDefault
 
+type Attestations struct {
+	// Generate SLSA provenance for the build.
+	//
+	// Provenance is disabled unless configured here.
+	//
+	// Equivalent to Docker's `--attest type=provenance` flag.
+	Provenance *ProvenanceAttestation `pulumi:"provenance"`
+	// Generate a Software Bill of Materials (SBOM) for the image.
+	//
+	// Equivalent to Docker's `--attest type=sbom` flag.
+	Sbom *SBOMAttestation `pulumi:"sbom"`
+}
+
+// Defaults sets the appropriate defaults for Attestations
+func (val *Attestations) Defaults() *Attestations {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	tmp.Provenance = tmp.Provenance.Defaults()
+
+	return &tmp
+}
+
+// AttestationsInput is an input type that accepts AttestationsArgs and AttestationsOutput values.
+// You can construct a concrete instance of `AttestationsInput` via:
+//
+//	AttestationsArgs{...}
+type AttestationsInput interface {
+	pulumi.Input
+
+	ToAttestationsOutput() AttestationsOutput
+	ToAttestationsOutputWithContext(context.Context) AttestationsOutput
+}
+
+type AttestationsArgs struct {
+	// Generate SLSA provenance for the build.
+	//
+	// Provenance is disabled unless configured here.
+	//
+	// Equivalent to Docker's `--attest type=provenance` flag.
+	Provenance ProvenanceAttestationPtrInput `pulumi:"provenance"`
+	// Generate a Software Bill of Materials (SBOM) for the image.
+	//
+	// Equivalent to Docker's `--attest type=sbom` flag.
+	Sbom SBOMAttestationPtrInput `pulumi:"sbom"`
+}
+
+// Defaults sets the appropriate defaults for AttestationsArgs
+func (val *AttestationsArgs) Defaults() *AttestationsArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+
+	return &tmp
+}
+func (AttestationsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Attestations)(nil)).Elem()
+}
+
+func (i AttestationsArgs) ToAttestationsOutput() AttestationsOutput {
+	return i.ToAttestationsOutputWithContext(context.Background())
+}
+
+func (i AttestationsArgs) ToAttestationsOutputWithContext(ctx context.Context) AttestationsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AttestationsOutput)
+}
+
+func (i AttestationsArgs) ToOutput(ctx context.Context) pulumix.Output[Attestations] {
+	return pulumix.Output[Attestations]{
+		OutputState: i.ToAttestationsOutputWithContext(ctx).OutputState,
+	}
+}
+
+func (i AttestationsArgs) ToAttestationsPtrOutput() AttestationsPtrOutput {
+	return i.ToAttestationsPtrOutputWithContext(context.Background())
+}
+
+func (i AttestationsArgs) ToAttestationsPtrOutputWithContext(ctx context.Context) AttestationsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AttestationsOutput).ToAttestationsPtrOutputWithContext(ctx)
+}
+
+// AttestationsPtrInput is an input type that accepts AttestationsArgs, AttestationsPtr and AttestationsPtrOutput values.
+// You can construct a concrete instance of `AttestationsPtrInput` via:
+//
+//	        AttestationsArgs{...}
+//
+//	or:
+//
+//	        nil
+type AttestationsPtrInput interface {
+	pulumi.Input
+
+	ToAttestationsPtrOutput() AttestationsPtrOutput
+	ToAttestationsPtrOutputWithContext(context.Context) AttestationsPtrOutput
+}
+
+type attestationsPtrType AttestationsArgs
+
+func AttestationsPtr(v *AttestationsArgs) AttestationsPtrInput {
+	return (*attestationsPtrType)(v)
+}
+
+func (*attestationsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Attestations)(nil)).Elem()
+}
+
+func (i *attestationsPtrType) ToAttestationsPtrOutput() AttestationsPtrOutput {
+	return i.ToAttestationsPtrOutputWithContext(context.Background())
+}
+
+func (i *attestationsPtrType) ToAttestationsPtrOutputWithContext(ctx context.Context) AttestationsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AttestationsPtrOutput)
+}
+
+func (i *attestationsPtrType) ToOutput(ctx context.Context) pulumix.Output[*Attestations] {
+	return pulumix.Output[*Attestations]{
+		OutputState: i.ToAttestationsPtrOutputWithContext(ctx).OutputState,
+	}
+}
+
+type AttestationsOutput struct{ *pulumi.OutputState }
+
+func (AttestationsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Attestations)(nil)).Elem()
+}
+
+func (o AttestationsOutput) ToAttestationsOutput() AttestationsOutput {
+	return o
+}
+
+func (o AttestationsOutput) ToAttestationsOutputWithContext(ctx context.Context) AttestationsOutput {
+	return o
+}
+
+func (o AttestationsOutput) ToAttestationsPtrOutput() AttestationsPtrOutput {
+	return o.ToAttestationsPtrOutputWithContext(context.Background())
+}
+
+func (o AttestationsOutput) ToAttestationsPtrOutputWithContext(ctx context.Context) AttestationsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Attestations) *Attestations {
+		return &v
+	}).(AttestationsPtrOutput)
+}
+
+func (o AttestationsOutput) ToOutput(ctx context.Context) pulumix.Output[Attestations] {
+	return pulumix.Output[Attestations]{
+		OutputState: o.OutputState,
+	}
+}
+
+// Generate SLSA provenance for the build.
+//
+// Provenance is disabled unless configured here.
+//
+// Equivalent to Docker's `--attest type=provenance` flag.
+func (o AttestationsOutput) Provenance() ProvenanceAttestationPtrOutput {
+	return o.ApplyT(func(v Attestations) *ProvenanceAttestation { return v.Provenance }).(ProvenanceAttestationPtrOutput)
+}
+
+// Generate a Software Bill of Materials (SBOM) for the image.
+//
+// Equivalent to Docker's `--attest type=sbom` flag.
+func (o AttestationsOutput) Sbom() SBOMAttestationPtrOutput {
+	return o.ApplyT(func(v Attestations) *SBOMAttestation { return v.Sbom }).(SBOMAttestationPtrOutput)
+}
+
+type AttestationsPtrOutput struct{ *pulumi.OutputState }
+
+func (AttestationsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Attestations)(nil)).Elem()
+}
+
+func (o AttestationsPtrOutput) ToAttestationsPtrOutput() AttestationsPtrOutput {
+	return o
+}
+
+func (o AttestationsPtrOutput) ToAttestationsPtrOutputWithContext(ctx context.Context) AttestationsPtrOutput {
+	return o
+}
+
+func (o AttestationsPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*Attestations] {
+	return pulumix.Output[*Attestations]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o AttestationsPtrOutput) Elem() AttestationsOutput {
+	return o.ApplyT(func(v *Attestations) Attestations {
+		if v != nil {
+			return *v
+		}
+		var ret Attestations
+		return ret
+	}).(AttestationsOutput)
+}
+
+// Generate SLSA provenance for the build.
+//
+// Provenance is disabled unless configured here.
+//
+// Equivalent to Docker's `--attest type=provenance` flag.
+func (o AttestationsPtrOutput) Provenance() ProvenanceAttestationPtrOutput {
+	return o.ApplyT(func(v *Attestations) *ProvenanceAttestation {
+		if v == nil {
+			return nil
+		}
+		return v.Provenance
+	}).(ProvenanceAttestationPtrOutput)
+}
+
+// Generate a Software Bill of Materials (SBOM) for the image.
+//
+// Equivalent to Docker's `--attest type=sbom` flag.
+func (o AttestationsPtrOutput) Sbom() SBOMAttestationPtrOutput {
+	return o.ApplyT(func(v *Attestations) *SBOMAttestation {
+		if v == nil {
+			return nil
+		}
+		return v.Sbom
+	}).(SBOMAttestationPtrOutput)
+}
+
+type BaseImageLock struct {
+	// A lockfile to record digests in, like `Dockerfile.lock`. Relative
+	// paths are resolved from the Dockerfile's directory, or the context's
+	// for inline Dockerfiles.
+	//
+	// Digests are recorded in the resource's state if this isn't set.
+	Path *string `pulumi:"path"`
+	// An arbitrary value, like a date, which re-resolves every base image
+	// when it changes.
+	Refresh *string `pulumi:"refresh"`
+}
+
+// BaseImageLockInput is an input type that accepts BaseImageLockArgs and BaseImageLockOutput values.
+// You can construct a concrete instance of `BaseImageLockInput` via:
+//
+//	BaseImageLockArgs{...}
+type BaseImageLockInput interface {
+	pulumi.Input
+
+	ToBaseImageLockOutput() BaseImageLockOutput
+	ToBaseImageLockOutputWithContext(context.Context) BaseImageLockOutput
+}
+
+type BaseImageLockArgs struct {
+	// A lockfile to record digests in, like `Dockerfile.lock`. Relative
+	// paths are resolved from the Dockerfile's directory, or the context's
+	// for inline Dockerfiles.
+	//
+	// Digests are recorded in the resource's state if this isn't set.
+	Path pulumi.StringPtrInput `pulumi:"path"`
+	// An arbitrary value, like a date, which re-resolves every base image
+	// when it changes.
+	Refresh pulumi.StringPtrInput `pulumi:"refresh"`
+}
+
+func (BaseImageLockArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BaseImageLock)(nil)).Elem()
+}
+
+func (i BaseImageLockArgs) ToBaseImageLockOutput() BaseImageLockOutput {
+	return i.ToBaseImageLockOutputWithContext(context.Background())
+}
+
+func (i BaseImageLockArgs) ToBaseImageLockOutputWithContext(ctx context.Context) BaseImageLockOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BaseImageLockOutput)
+}
+
+func (i BaseImageLockArgs) ToOutput(ctx context.Context) pulumix.Output[BaseImageLock] {
+	return pulumix.Output[BaseImageLock]{
+		OutputState: i.ToBaseImageLockOutputWithContext(ctx).OutputState,
+	}
+}
+
+func (i BaseImageLockArgs) ToBaseImageLockPtrOutput() BaseImageLockPtrOutput {
+	return i.ToBaseImageLockPtrOutputWithContext(context.Background())
+}
+
+func (i BaseImageLockArgs) ToBaseImageLockPtrOutputWithContext(ctx context.Context) BaseImageLockPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BaseImageLockOutput).ToBaseImageLockPtrOutputWithContext(ctx)
+}
+
+// BaseImageLockPtrInput is an input type that accepts BaseImageLockArgs, BaseImageLockPtr and BaseImageLockPtrOutput values.
+// You can construct a concrete instance of `BaseImageLockPtrInput` via:
+//
+//	        BaseImageLockArgs{...}
+//
+//	or:
+//
+//	        nil
+type BaseImageLockPtrInput interface {
+	pulumi.Input
+
+	ToBaseImageLockPtrOutput() BaseImageLockPtrOutput
+	ToBaseImageLockPtrOutputWithContext(context.Context) BaseImageLockPtrOutput
+}
+
+type baseImageLockPtrType BaseImageLockArgs
+
+func BaseImageLockPtr(v *BaseImageLockArgs) BaseImageLockPtrInput {
+	return (*baseImageLockPtrType)(v)
+}
+
+func (*baseImageLockPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**BaseImageLock)(nil)).Elem()
+}
+
+func (i *baseImageLockPtrType) ToBaseImageLockPtrOutput() BaseImageLockPtrOutput {
+	return i.ToBaseImageLockPtrOutputWithContext(context.Background())
+}
+
+func (i *baseImageLockPtrType) ToBaseImageLockPtrOutputWithContext(ctx context.Context) BaseImageLockPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BaseImageLockPtrOutput)
+}
+
+func (i *baseImageLockPtrType) ToOutput(ctx context.Context) pulumix.Output[*BaseImageLock] {
+	return pulumix.Output[*BaseImageLock]{
+		OutputState: i.ToBaseImageLockPtrOutputWithContext(ctx).OutputState,
+	}
+}
+
+type BaseImageLockOutput struct{ *pulumi.OutputState }
+
+func (BaseImageLockOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BaseImageLock)(nil)).Elem()
+}
+
+func (o BaseImageLockOutput) ToBaseImageLockOutput() BaseImageLockOutput {
+	return o
+}
+
+func (o BaseImageLockOutput) ToBaseImageLockOutputWithContext(ctx context.Context) BaseImageLockOutput {
+	return o
+}
+
+func (o BaseImageLockOutput) ToBaseImageLockPtrOutput() BaseImageLockPtrOutput {
+	return o.ToBaseImageLockPtrOutputWithContext(context.Background())
+}
+
+func (o BaseImageLockOutput) ToBaseImageLockPtrOutputWithContext(ctx context.Context) BaseImageLockPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v BaseImageLock) *BaseImageLock {
+		return &v
+	}).(BaseImageLockPtrOutput)
+}
+
+func (o BaseImageLockOutput) ToOutput(ctx context.Context) pulumix.Output[BaseImageLock] {
+	return pulumix.Output[BaseImageLock]{
+		OutputState: o.OutputState,
+	}
+}
+
+// A lockfile to record digests in, like `Dockerfile.lock`. Relative
+// paths are resolved from the Dockerfile's directory, or the context's
+// for inline Dockerfiles.
+//
+// Digests are recorded in the resource's state if this isn't set.
+func (o BaseImageLockOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BaseImageLock) *string { return v.Path }).(pulumi.StringPtrOutput)
+}
+
+// An arbitrary value, like a date, which re-resolves every base image
+// when it changes.
+func (o BaseImageLockOutput) Refresh() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BaseImageLock) *string { return v.Refresh }).(pulumi.StringPtrOutput)
+}
+
+type BaseImageLockPtrOutput struct{ *pulumi.OutputState }
+
+func (BaseImageLockPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**BaseImageLock)(nil)).Elem()
+}
+
+func (o BaseImageLockPtrOutput) ToBaseImageLockPtrOutput() BaseImageLockPtrOutput {
+	return o
+}
+
+func (o BaseImageLockPtrOutput) ToBaseImageLockPtrOutputWithContext(ctx context.Context) BaseImageLockPtrOutput {
+	return o
+}
+
+func (o BaseImageLockPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*BaseImageLock] {
+	return pulumix.Output[*BaseImageLock]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o BaseImageLockPtrOutput) Elem() BaseImageLockOutput {
+	return o.ApplyT(func(v *BaseImageLock) BaseImageLock {
+		if v != nil {
+			return *v
+		}
+		var ret BaseImageLock
+		return ret
+	}).(BaseImageLockOutput)
+}
+
+// A lockfile to record digests in, like `Dockerfile.lock`. Relative
+// paths are resolved from the Dockerfile's directory, or the context's
+// for inline Dockerfiles.
+//
+// Digests are recorded in the resource's state if this isn't set.
+func (o BaseImageLockPtrOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *BaseImageLock) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Path
+	}).(pulumi.StringPtrOutput)
+}
+
+// An arbitrary value, like a date, which re-resolves every base image
+// when it changes.
+func (o BaseImageLockPtrOutput) Refresh() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *BaseImageLock) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Refresh
+	}).(pulumi.StringPtrOutput)
+}
+
 type BuildContext struct {
+	// Which files in local contexts contribute to the `contextHash` used to
+	// decide whether the image needs to be rebuilt.
+	//
+	// With `referenced`, only the paths copied by `COPY` and `ADD`
+	// instructions are hashed, so changes to other files don't trigger a
+	// rebuild. Every file is hashed if those paths can't be determined
+	// statically -- for example when the Dockerfile is remote or a `RUN`
+	// instruction bind-mounts a context.
+	//
+	// Defaults to `all`.
+	HashMode *ContextHashMode `pulumi:"hashMode"`
 	// Resources to use for build context.
 	//
 	// The location can be:
@@ -45,6 +480,17 @@ type BuildContextInput interface {
 }
 
 type BuildContextArgs struct {
+	// Which files in local contexts contribute to the `contextHash` used to
+	// decide whether the image needs to be rebuilt.
+	//
+	// With `referenced`, only the paths copied by `COPY` and `ADD`
+	// instructions are hashed, so changes to other files don't trigger a
+	// rebuild. Every file is hashed if those paths can't be determined
+	// statically -- for example when the Dockerfile is remote or a `RUN`
+	// instruction bind-mounts a context.
+	//
+	// Defaults to `all`.
+	HashMode ContextHashModePtrInput `pulumi:"hashMode"`
 	// Resources to use for build context.
 	//
 	// The location can be:
@@ -158,6 +604,20 @@ func (o BuildContextOutput) ToOutput(ctx context.Context) pulumix.Output[BuildCo
 	}
 }
 
+// Which files in local contexts contribute to the `contextHash` used to
+// decide whether the image needs to be rebuilt.
+//
+// With `referenced`, only the paths copied by `COPY` and `ADD`
+// instructions are hashed, so changes to other files don't trigger a
+// rebuild. Every file is hashed if those paths can't be determined
+// statically -- for example when the Dockerfile is remote or a `RUN`
+// instruction bind-mounts a context.
+//
+// Defaults to `all`.
+func (o BuildContextOutput) HashMode() ContextHashModePtrOutput {
+	return o.ApplyT(func(v BuildContext) *ContextHashMode { return v.HashMode }).(ContextHashModePtrOutput)
+}
+
 // Resources to use for build context.
 //
 // The location can be:
@@ -210,6 +670,25 @@ func (o BuildContextPtrOutput) Elem() BuildContextOutput {
 	}).(BuildContextOutput)
 }
 
+// Which files in local contexts contribute to the `contextHash` used to
+// decide whether the image needs to be rebuilt.
+//
+// With `referenced`, only the paths copied by `COPY` and `ADD`
+// instructions are hashed, so changes to other files don't trigger a
+// rebuild. Every file is hashed if those paths can't be determined
+// statically -- for example when the Dockerfile is remote or a `RUN`
+// instruction bind-mounts a context.
+//
+// Defaults to `all`.
+func (o BuildContextPtrOutput) HashMode() ContextHashModePtrOutput {
+	return o.ApplyT(func(v *BuildContext) *ContextHashMode {
+		if v == nil {
+			return nil
+		}
+		return v.HashMode
+	}).(ContextHashModePtrOutput)
+}
+
 // Resources to use for build context.
 //
 // The location can be:
@@ -242,31 +721,382 @@ func (o BuildContextPtrOutput) Named() ContextMapOutput {
 	}).(ContextMapOutput)
 }
 
-type BuilderConfig struct {
-	// Name of an existing buildx builder to use.
-	//
-	// Only `docker-container`, `kubernetes`, or `remote` drivers are
-	// supported. The legacy `docker` driver is not supported.
+type BuildMetadata struct {
+	// The buildx history reference for the build, formatted as
+	// `<builder>/<node>/<ref>`.
 	//
-	// Equivalent to Docker's `--builder` flag.
-	Name *string `pulumi:"name"`
+	// This can be passed to `docker buildx history inspect` to debug the
+	// build.
+	BuildRef *string `pulumi:"buildRef"`
+	// The digest of the image's config blob.
+	ConfigDigest *string `pulumi:"configDigest"`
+	// The media type of the exported image's top-level manifest or index.
+	DescriptorMediaType *string `pulumi:"descriptorMediaType"`
+	// The size in bytes of the exported image's top-level manifest or index.
+	DescriptorSize *int `pulumi:"descriptorSize"`
+	// The comma-separated names the image was exported with.
+	ImageName *string `pulumi:"imageName"`
 }
 
-// BuilderConfigInput is an input type that accepts BuilderConfigArgs and BuilderConfigOutput values.
-// You can construct a concrete instance of `BuilderConfigInput` via:
-//
-//	BuilderConfigArgs{...}
-type BuilderConfigInput interface {
-	pulumi.Input
+type BuildMetadataOutput struct{ *pulumi.OutputState }
 
-	ToBuilderConfigOutput() BuilderConfigOutput
-	ToBuilderConfigOutputWithContext(context.Context) BuilderConfigOutput
+func (BuildMetadataOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BuildMetadata)(nil)).Elem()
 }
 
-type BuilderConfigArgs struct {
-	// Name of an existing buildx builder to use.
-	//
-	// Only `docker-container`, `kubernetes`, or `remote` drivers are
+func (o BuildMetadataOutput) ToBuildMetadataOutput() BuildMetadataOutput {
+	return o
+}
+
+func (o BuildMetadataOutput) ToBuildMetadataOutputWithContext(ctx context.Context) BuildMetadataOutput {
+	return o
+}
+
+func (o BuildMetadataOutput) ToOutput(ctx context.Context) pulumix.Output[BuildMetadata] {
+	return pulumix.Output[BuildMetadata]{
+		OutputState: o.OutputState,
+	}
+}
+
+// The buildx history reference for the build, formatted as
+// `<builder>/<node>/<ref>`.
+//
+// This can be passed to `docker buildx history inspect` to debug the
+// build.
+func (o BuildMetadataOutput) BuildRef() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BuildMetadata) *string { return v.BuildRef }).(pulumi.StringPtrOutput)
+}
+
+// The digest of the image's config blob.
+func (o BuildMetadataOutput) ConfigDigest() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BuildMetadata) *string { return v.ConfigDigest }).(pulumi.StringPtrOutput)
+}
+
+// The media type of the exported image's top-level manifest or index.
+func (o BuildMetadataOutput) DescriptorMediaType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BuildMetadata) *string { return v.DescriptorMediaType }).(pulumi.StringPtrOutput)
+}
+
+// The size in bytes of the exported image's top-level manifest or index.
+func (o BuildMetadataOutput) DescriptorSize() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v BuildMetadata) *int { return v.DescriptorSize }).(pulumi.IntPtrOutput)
+}
+
+// The comma-separated names the image was exported with.
+func (o BuildMetadataOutput) ImageName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BuildMetadata) *string { return v.ImageName }).(pulumi.StringPtrOutput)
+}
+
+type BuildMetadataPtrOutput struct{ *pulumi.OutputState }
+
+func (BuildMetadataPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**BuildMetadata)(nil)).Elem()
+}
+
+func (o BuildMetadataPtrOutput) ToBuildMetadataPtrOutput() BuildMetadataPtrOutput {
+	return o
+}
+
+func (o BuildMetadataPtrOutput) ToBuildMetadataPtrOutputWithContext(ctx context.Context) BuildMetadataPtrOutput {
+	return o
+}
+
+func (o BuildMetadataPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*BuildMetadata] {
+	return pulumix.Output[*BuildMetadata]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o BuildMetadataPtrOutput) Elem() BuildMetadataOutput {
+	return o.ApplyT(func(v *BuildMetadata) BuildMetadata {
+		if v != nil {
+			return *v
+		}
+		var ret BuildMetadata
+		return ret
+	}).(BuildMetadataOutput)
+}
+
+// The buildx history reference for the build, formatted as
+// `<builder>/<node>/<ref>`.
+//
+// This can be passed to `docker buildx history inspect` to debug the
+// build.
+func (o BuildMetadataPtrOutput) BuildRef() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *BuildMetadata) *string {
+		if v == nil {
+			return nil
+		}
+		return v.BuildRef
+	}).(pulumi.StringPtrOutput)
+}
+
+// The digest of the image's config blob.
+func (o BuildMetadataPtrOutput) ConfigDigest() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *BuildMetadata) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ConfigDigest
+	}).(pulumi.StringPtrOutput)
+}
+
+// The media type of the exported image's top-level manifest or index.
+func (o BuildMetadataPtrOutput) DescriptorMediaType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *BuildMetadata) *string {
+		if v == nil {
+			return nil
+		}
+		return v.DescriptorMediaType
+	}).(pulumi.StringPtrOutput)
+}
+
+// The size in bytes of the exported image's top-level manifest or index.
+func (o BuildMetadataPtrOutput) DescriptorSize() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *BuildMetadata) *int {
+		if v == nil {
+			return nil
+		}
+		return v.DescriptorSize
+	}).(pulumi.IntPtrOutput)
+}
+
+// The comma-separated names the image was exported with.
+func (o BuildMetadataPtrOutput) ImageName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *BuildMetadata) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ImageName
+	}).(pulumi.StringPtrOutput)
+}
+
+type BuildStats struct {
+	// The fraction of steps which were cached, between 0 and 1.
+	CacheHitRatio float64 `pulumi:"cacheHitRatio"`
+	// The number of steps which were cached.
+	CachedSteps int `pulumi:"cachedSteps"`
+	// Wall-clock duration of the build in seconds.
+	Duration float64 `pulumi:"duration"`
+	// The slowest uncached steps, in descending order of duration.
+	SlowestSteps []BuildStep `pulumi:"slowestSteps"`
+	// The number of steps (vertices) solved by the build.
+	Steps int `pulumi:"steps"`
+}
+
+type BuildStatsOutput struct{ *pulumi.OutputState }
+
+func (BuildStatsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BuildStats)(nil)).Elem()
+}
+
+func (o BuildStatsOutput) ToBuildStatsOutput() BuildStatsOutput {
+	return o
+}
+
+func (o BuildStatsOutput) ToBuildStatsOutputWithContext(ctx context.Context) BuildStatsOutput {
+	return o
+}
+
+func (o BuildStatsOutput) ToOutput(ctx context.Context) pulumix.Output[BuildStats] {
+	return pulumix.Output[BuildStats]{
+		OutputState: o.OutputState,
+	}
+}
+
+// The fraction of steps which were cached, between 0 and 1.
+func (o BuildStatsOutput) CacheHitRatio() pulumi.Float64Output {
+	return o.ApplyT(func(v BuildStats) float64 { return v.CacheHitRatio }).(pulumi.Float64Output)
+}
+
+// The number of steps which were cached.
+func (o BuildStatsOutput) CachedSteps() pulumi.IntOutput {
+	return o.ApplyT(func(v BuildStats) int { return v.CachedSteps }).(pulumi.IntOutput)
+}
+
+// Wall-clock duration of the build in seconds.
+func (o BuildStatsOutput) Duration() pulumi.Float64Output {
+	return o.ApplyT(func(v BuildStats) float64 { return v.Duration }).(pulumi.Float64Output)
+}
+
+// The slowest uncached steps, in descending order of duration.
+func (o BuildStatsOutput) SlowestSteps() BuildStepArrayOutput {
+	return o.ApplyT(func(v BuildStats) []BuildStep { return v.SlowestSteps }).(BuildStepArrayOutput)
+}
+
+// The number of steps (vertices) solved by the build.
+func (o BuildStatsOutput) Steps() pulumi.IntOutput {
+	return o.ApplyT(func(v BuildStats) int { return v.Steps }).(pulumi.IntOutput)
+}
+
+type BuildStatsPtrOutput struct{ *pulumi.OutputState }
+
+func (BuildStatsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**BuildStats)(nil)).Elem()
+}
+
+func (o BuildStatsPtrOutput) ToBuildStatsPtrOutput() BuildStatsPtrOutput {
+	return o
+}
+
+func (o BuildStatsPtrOutput) ToBuildStatsPtrOutputWithContext(ctx context.Context) BuildStatsPtrOutput {
+	return o
+}
+
+func (o BuildStatsPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*BuildStats] {
+	return pulumix.Output[*BuildStats]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o BuildStatsPtrOutput) Elem() BuildStatsOutput {
+	return o.ApplyT(func(v *BuildStats) BuildStats {
+		if v != nil {
+			return *v
+		}
+		var ret BuildStats
+		return ret
+	}).(BuildStatsOutput)
+}
+
+// The fraction of steps which were cached, between 0 and 1.
+func (o BuildStatsPtrOutput) CacheHitRatio() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v *BuildStats) *float64 {
+		if v == nil {
+			return nil
+		}
+		return &v.CacheHitRatio
+	}).(pulumi.Float64PtrOutput)
+}
+
+// The number of steps which were cached.
+func (o BuildStatsPtrOutput) CachedSteps() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *BuildStats) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.CachedSteps
+	}).(pulumi.IntPtrOutput)
+}
+
+// Wall-clock duration of the build in seconds.
+func (o BuildStatsPtrOutput) Duration() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v *BuildStats) *float64 {
+		if v == nil {
+			return nil
+		}
+		return &v.Duration
+	}).(pulumi.Float64PtrOutput)
+}
+
+// The slowest uncached steps, in descending order of duration.
+func (o BuildStatsPtrOutput) SlowestSteps() BuildStepArrayOutput {
+	return o.ApplyT(func(v *BuildStats) []BuildStep {
+		if v == nil {
+			return nil
+		}
+		return v.SlowestSteps
+	}).(BuildStepArrayOutput)
+}
+
+// The number of steps (vertices) solved by the build.
+func (o BuildStatsPtrOutput) Steps() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *BuildStats) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.Steps
+	}).(pulumi.IntPtrOutput)
+}
+
+type BuildStep struct {
+	// The step's duration in seconds.
+	Duration float64 `pulumi:"duration"`
+	// The step's name, for example "[2/3] RUN make".
+	Name string `pulumi:"name"`
+}
+
+type BuildStepOutput struct{ *pulumi.OutputState }
+
+func (BuildStepOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BuildStep)(nil)).Elem()
+}
+
+func (o BuildStepOutput) ToBuildStepOutput() BuildStepOutput {
+	return o
+}
+
+func (o BuildStepOutput) ToBuildStepOutputWithContext(ctx context.Context) BuildStepOutput {
+	return o
+}
+
+func (o BuildStepOutput) ToOutput(ctx context.Context) pulumix.Output[BuildStep] {
+	return pulumix.Output[BuildStep]{
+		OutputState: o.OutputState,
+	}
+}
+
+// The step's duration in seconds.
+func (o BuildStepOutput) Duration() pulumi.Float64Output {
+	return o.ApplyT(func(v BuildStep) float64 { return v.Duration }).(pulumi.Float64Output)
+}
+
+// The step's name, for example "[2/3] RUN make".
+func (o BuildStepOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v BuildStep) string { return v.Name }).(pulumi.StringOutput)
+}
+
+type BuildStepArrayOutput struct{ *pulumi.OutputState }
+
+func (BuildStepArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]BuildStep)(nil)).Elem()
+}
+
+func (o BuildStepArrayOutput) ToBuildStepArrayOutput() BuildStepArrayOutput {
+	return o
+}
+
+func (o BuildStepArrayOutput) ToBuildStepArrayOutputWithContext(ctx context.Context) BuildStepArrayOutput {
+	return o
+}
+
+func (o BuildStepArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]BuildStep] {
+	return pulumix.Output[[]BuildStep]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o BuildStepArrayOutput) Index(i pulumi.IntInput) BuildStepOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) BuildStep {
+		return vs[0].([]BuildStep)[vs[1].(int)]
+	}).(BuildStepOutput)
+}
+
+type BuilderConfig struct {
+	// Name of an existing buildx builder to use.
+	//
+	// Only `docker-container`, `kubernetes`, or `remote` drivers are
+	// supported. The legacy `docker` driver is not supported.
+	//
+	// Equivalent to Docker's `--builder` flag.
+	Name *string `pulumi:"name"`
+}
+
+// BuilderConfigInput is an input type that accepts BuilderConfigArgs and BuilderConfigOutput values.
+// You can construct a concrete instance of `BuilderConfigInput` via:
+//
+//	BuilderConfigArgs{...}
+type BuilderConfigInput interface {
+	pulumi.Input
+
+	ToBuilderConfigOutput() BuilderConfigOutput
+	ToBuilderConfigOutputWithContext(context.Context) BuilderConfigOutput
+}
+
+type BuilderConfigArgs struct {
+	// Name of an existing buildx builder to use.
+	//
+	// Only `docker-container`, `kubernetes`, or `remote` drivers are
 	// supported. The legacy `docker` driver is not supported.
 	//
 	// Equivalent to Docker's `--builder` flag.
@@ -6450,67 +7280,681 @@ func (o ExportTarOutput) ToExportTarOutputWithContext(ctx context.Context) Expor
 	return o
 }
 
-func (o ExportTarOutput) ToExportTarPtrOutput() ExportTarPtrOutput {
-	return o.ToExportTarPtrOutputWithContext(context.Background())
+func (o ExportTarOutput) ToExportTarPtrOutput() ExportTarPtrOutput {
+	return o.ToExportTarPtrOutputWithContext(context.Background())
+}
+
+func (o ExportTarOutput) ToExportTarPtrOutputWithContext(ctx context.Context) ExportTarPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ExportTar) *ExportTar {
+		return &v
+	}).(ExportTarPtrOutput)
+}
+
+func (o ExportTarOutput) ToOutput(ctx context.Context) pulumix.Output[ExportTar] {
+	return pulumix.Output[ExportTar]{
+		OutputState: o.OutputState,
+	}
+}
+
+// Output path.
+func (o ExportTarOutput) Dest() pulumi.StringOutput {
+	return o.ApplyT(func(v ExportTar) string { return v.Dest }).(pulumi.StringOutput)
+}
+
+type ExportTarPtrOutput struct{ *pulumi.OutputState }
+
+func (ExportTarPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ExportTar)(nil)).Elem()
+}
+
+func (o ExportTarPtrOutput) ToExportTarPtrOutput() ExportTarPtrOutput {
+	return o
+}
+
+func (o ExportTarPtrOutput) ToExportTarPtrOutputWithContext(ctx context.Context) ExportTarPtrOutput {
+	return o
+}
+
+func (o ExportTarPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*ExportTar] {
+	return pulumix.Output[*ExportTar]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ExportTarPtrOutput) Elem() ExportTarOutput {
+	return o.ApplyT(func(v *ExportTar) ExportTar {
+		if v != nil {
+			return *v
+		}
+		var ret ExportTar
+		return ret
+	}).(ExportTarOutput)
+}
+
+// Output path.
+func (o ExportTarPtrOutput) Dest() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ExportTar) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Dest
+	}).(pulumi.StringPtrOutput)
+}
+
+type ImageConfig struct {
+	// The image's "CMD".
+	Cmd []string `pulumi:"cmd"`
+	// The image's "ENTRYPOINT".
+	Entrypoint []string `pulumi:"entrypoint"`
+	// Environment variables set by "ENV", as "KEY=value" pairs.
+	Env []string `pulumi:"env"`
+	// Ports declared by `EXPOSE`, for example `8080/tcp`.
+	ExposedPorts []string `pulumi:"exposedPorts"`
+	// The image's final labels, including any inherited from base images.
+	Labels map[string]string `pulumi:"labels"`
+	// The image's "USER".
+	User *string `pulumi:"user"`
+	// The image's "WORKDIR".
+	WorkingDir *string `pulumi:"workingDir"`
+}
+
+type ImageConfigOutput struct{ *pulumi.OutputState }
+
+func (ImageConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ImageConfig)(nil)).Elem()
+}
+
+func (o ImageConfigOutput) ToImageConfigOutput() ImageConfigOutput {
+	return o
+}
+
+func (o ImageConfigOutput) ToImageConfigOutputWithContext(ctx context.Context) ImageConfigOutput {
+	return o
+}
+
+func (o ImageConfigOutput) ToOutput(ctx context.Context) pulumix.Output[ImageConfig] {
+	return pulumix.Output[ImageConfig]{
+		OutputState: o.OutputState,
+	}
+}
+
+// The image's "CMD".
+func (o ImageConfigOutput) Cmd() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ImageConfig) []string { return v.Cmd }).(pulumi.StringArrayOutput)
+}
+
+// The image's "ENTRYPOINT".
+func (o ImageConfigOutput) Entrypoint() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ImageConfig) []string { return v.Entrypoint }).(pulumi.StringArrayOutput)
+}
+
+// Environment variables set by "ENV", as "KEY=value" pairs.
+func (o ImageConfigOutput) Env() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ImageConfig) []string { return v.Env }).(pulumi.StringArrayOutput)
+}
+
+// Ports declared by `EXPOSE`, for example `8080/tcp`.
+func (o ImageConfigOutput) ExposedPorts() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ImageConfig) []string { return v.ExposedPorts }).(pulumi.StringArrayOutput)
+}
+
+// The image's final labels, including any inherited from base images.
+func (o ImageConfigOutput) Labels() pulumi.StringMapOutput {
+	return o.ApplyT(func(v ImageConfig) map[string]string { return v.Labels }).(pulumi.StringMapOutput)
+}
+
+// The image's "USER".
+func (o ImageConfigOutput) User() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ImageConfig) *string { return v.User }).(pulumi.StringPtrOutput)
+}
+
+// The image's "WORKDIR".
+func (o ImageConfigOutput) WorkingDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ImageConfig) *string { return v.WorkingDir }).(pulumi.StringPtrOutput)
+}
+
+type ImageConfigMapOutput struct{ *pulumi.OutputState }
+
+func (ImageConfigMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]ImageConfig)(nil)).Elem()
+}
+
+func (o ImageConfigMapOutput) ToImageConfigMapOutput() ImageConfigMapOutput {
+	return o
+}
+
+func (o ImageConfigMapOutput) ToImageConfigMapOutputWithContext(ctx context.Context) ImageConfigMapOutput {
+	return o
+}
+
+func (o ImageConfigMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]ImageConfig] {
+	return pulumix.Output[map[string]ImageConfig]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ImageConfigMapOutput) MapIndex(k pulumi.StringInput) ImageConfigOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) ImageConfig {
+		return vs[0].(map[string]ImageConfig)[vs[1].(string)]
+	}).(ImageConfigOutput)
+}
+
+type Layer struct {
+	// The layer's content digest.
+	Digest string `pulumi:"digest"`
+	// The layer's media type, for example "application/vnd.oci.image.layer.v1.tar+gzip".
+	MediaType string `pulumi:"mediaType"`
+	// The layer's compressed size in bytes.
+	Size int `pulumi:"size"`
+}
+
+type LayerOutput struct{ *pulumi.OutputState }
+
+func (LayerOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Layer)(nil)).Elem()
+}
+
+func (o LayerOutput) ToLayerOutput() LayerOutput {
+	return o
+}
+
+func (o LayerOutput) ToLayerOutputWithContext(ctx context.Context) LayerOutput {
+	return o
+}
+
+func (o LayerOutput) ToOutput(ctx context.Context) pulumix.Output[Layer] {
+	return pulumix.Output[Layer]{
+		OutputState: o.OutputState,
+	}
+}
+
+// The layer's content digest.
+func (o LayerOutput) Digest() pulumi.StringOutput {
+	return o.ApplyT(func(v Layer) string { return v.Digest }).(pulumi.StringOutput)
+}
+
+// The layer's media type, for example "application/vnd.oci.image.layer.v1.tar+gzip".
+func (o LayerOutput) MediaType() pulumi.StringOutput {
+	return o.ApplyT(func(v Layer) string { return v.MediaType }).(pulumi.StringOutput)
+}
+
+// The layer's compressed size in bytes.
+func (o LayerOutput) Size() pulumi.IntOutput {
+	return o.ApplyT(func(v Layer) int { return v.Size }).(pulumi.IntOutput)
+}
+
+type LayerArrayOutput struct{ *pulumi.OutputState }
+
+func (LayerArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Layer)(nil)).Elem()
+}
+
+func (o LayerArrayOutput) ToLayerArrayOutput() LayerArrayOutput {
+	return o
+}
+
+func (o LayerArrayOutput) ToLayerArrayOutputWithContext(ctx context.Context) LayerArrayOutput {
+	return o
+}
+
+func (o LayerArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]Layer] {
+	return pulumix.Output[[]Layer]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o LayerArrayOutput) Index(i pulumi.IntInput) LayerOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Layer {
+		return vs[0].([]Layer)[vs[1].(int)]
+	}).(LayerOutput)
+}
+
+type Lint struct {
+	// Report lint violations as errors instead of warnings.
+	//
+	// Defaults to the Dockerfile's `# check=error=...` directive.
+	Error *bool `pulumi:"error"`
+	// Names of lint rules to skip, like `StageNameCasing`, or `all` to
+	// disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+	// directive are also skipped.
+	Skip []string `pulumi:"skip"`
+}
+
+// LintInput is an input type that accepts LintArgs and LintOutput values.
+// You can construct a concrete instance of `LintInput` via:
+//
+//	LintArgs{...}
+type LintInput interface {
+	pulumi.Input
+
+	ToLintOutput() LintOutput
+	ToLintOutputWithContext(context.Context) LintOutput
+}
+
+type LintArgs struct {
+	// Report lint violations as errors instead of warnings.
+	//
+	// Defaults to the Dockerfile's `# check=error=...` directive.
+	Error pulumi.BoolPtrInput `pulumi:"error"`
+	// Names of lint rules to skip, like `StageNameCasing`, or `all` to
+	// disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+	// directive are also skipped.
+	Skip pulumi.StringArrayInput `pulumi:"skip"`
+}
+
+func (LintArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Lint)(nil)).Elem()
+}
+
+func (i LintArgs) ToLintOutput() LintOutput {
+	return i.ToLintOutputWithContext(context.Background())
+}
+
+func (i LintArgs) ToLintOutputWithContext(ctx context.Context) LintOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LintOutput)
+}
+
+func (i LintArgs) ToOutput(ctx context.Context) pulumix.Output[Lint] {
+	return pulumix.Output[Lint]{
+		OutputState: i.ToLintOutputWithContext(ctx).OutputState,
+	}
+}
+
+func (i LintArgs) ToLintPtrOutput() LintPtrOutput {
+	return i.ToLintPtrOutputWithContext(context.Background())
+}
+
+func (i LintArgs) ToLintPtrOutputWithContext(ctx context.Context) LintPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LintOutput).ToLintPtrOutputWithContext(ctx)
+}
+
+// LintPtrInput is an input type that accepts LintArgs, LintPtr and LintPtrOutput values.
+// You can construct a concrete instance of `LintPtrInput` via:
+//
+//	        LintArgs{...}
+//
+//	or:
+//
+//	        nil
+type LintPtrInput interface {
+	pulumi.Input
+
+	ToLintPtrOutput() LintPtrOutput
+	ToLintPtrOutputWithContext(context.Context) LintPtrOutput
+}
+
+type lintPtrType LintArgs
+
+func LintPtr(v *LintArgs) LintPtrInput {
+	return (*lintPtrType)(v)
+}
+
+func (*lintPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Lint)(nil)).Elem()
+}
+
+func (i *lintPtrType) ToLintPtrOutput() LintPtrOutput {
+	return i.ToLintPtrOutputWithContext(context.Background())
+}
+
+func (i *lintPtrType) ToLintPtrOutputWithContext(ctx context.Context) LintPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LintPtrOutput)
+}
+
+func (i *lintPtrType) ToOutput(ctx context.Context) pulumix.Output[*Lint] {
+	return pulumix.Output[*Lint]{
+		OutputState: i.ToLintPtrOutputWithContext(ctx).OutputState,
+	}
+}
+
+type LintOutput struct{ *pulumi.OutputState }
+
+func (LintOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Lint)(nil)).Elem()
+}
+
+func (o LintOutput) ToLintOutput() LintOutput {
+	return o
+}
+
+func (o LintOutput) ToLintOutputWithContext(ctx context.Context) LintOutput {
+	return o
+}
+
+func (o LintOutput) ToLintPtrOutput() LintPtrOutput {
+	return o.ToLintPtrOutputWithContext(context.Background())
+}
+
+func (o LintOutput) ToLintPtrOutputWithContext(ctx context.Context) LintPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Lint) *Lint {
+		return &v
+	}).(LintPtrOutput)
+}
+
+func (o LintOutput) ToOutput(ctx context.Context) pulumix.Output[Lint] {
+	return pulumix.Output[Lint]{
+		OutputState: o.OutputState,
+	}
+}
+
+// Report lint violations as errors instead of warnings.
+//
+// Defaults to the Dockerfile's `# check=error=...` directive.
+func (o LintOutput) Error() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Lint) *bool { return v.Error }).(pulumi.BoolPtrOutput)
+}
+
+// Names of lint rules to skip, like `StageNameCasing`, or `all` to
+// disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+// directive are also skipped.
+func (o LintOutput) Skip() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v Lint) []string { return v.Skip }).(pulumi.StringArrayOutput)
+}
+
+type LintPtrOutput struct{ *pulumi.OutputState }
+
+func (LintPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Lint)(nil)).Elem()
+}
+
+func (o LintPtrOutput) ToLintPtrOutput() LintPtrOutput {
+	return o
+}
+
+func (o LintPtrOutput) ToLintPtrOutputWithContext(ctx context.Context) LintPtrOutput {
+	return o
+}
+
+func (o LintPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*Lint] {
+	return pulumix.Output[*Lint]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o LintPtrOutput) Elem() LintOutput {
+	return o.ApplyT(func(v *Lint) Lint {
+		if v != nil {
+			return *v
+		}
+		var ret Lint
+		return ret
+	}).(LintOutput)
+}
+
+// Report lint violations as errors instead of warnings.
+//
+// Defaults to the Dockerfile's `# check=error=...` directive.
+func (o LintPtrOutput) Error() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Lint) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Error
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Names of lint rules to skip, like `StageNameCasing`, or `all` to
+// disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+// directive are also skipped.
+func (o LintPtrOutput) Skip() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Lint) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Skip
+	}).(pulumi.StringArrayOutput)
+}
+
+type ProvenanceAttestation struct {
+	// An explicit builder ID to record in the provenance, for example the
+	// URL of the CI job performing the build.
+	BuilderId *string `pulumi:"builderId"`
+	// When `true` no provenance will be generated. Defaults to `false`.
+	Disabled *bool `pulumi:"disabled"`
+	// Only attach provenance to exporters which embed it in the image
+	// (e.g. `image` and `registry`). Defaults to `false`.
+	InlineOnly *bool `pulumi:"inlineOnly"`
+	// The level of detail to include in the provenance. Defaults to `min`.
+	Mode *ProvenanceMode `pulumi:"mode"`
+}
+
+// Defaults sets the appropriate defaults for ProvenanceAttestation
+func (val *ProvenanceAttestation) Defaults() *ProvenanceAttestation {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Mode == nil {
+		mode_ := ProvenanceMode("min")
+		tmp.Mode = &mode_
+	}
+	return &tmp
+}
+
+// ProvenanceAttestationInput is an input type that accepts ProvenanceAttestationArgs and ProvenanceAttestationOutput values.
+// You can construct a concrete instance of `ProvenanceAttestationInput` via:
+//
+//	ProvenanceAttestationArgs{...}
+type ProvenanceAttestationInput interface {
+	pulumi.Input
+
+	ToProvenanceAttestationOutput() ProvenanceAttestationOutput
+	ToProvenanceAttestationOutputWithContext(context.Context) ProvenanceAttestationOutput
+}
+
+type ProvenanceAttestationArgs struct {
+	// An explicit builder ID to record in the provenance, for example the
+	// URL of the CI job performing the build.
+	BuilderId pulumi.StringPtrInput `pulumi:"builderId"`
+	// When `true` no provenance will be generated. Defaults to `false`.
+	Disabled pulumi.BoolPtrInput `pulumi:"disabled"`
+	// Only attach provenance to exporters which embed it in the image
+	// (e.g. `image` and `registry`). Defaults to `false`.
+	InlineOnly pulumi.BoolPtrInput `pulumi:"inlineOnly"`
+	// The level of detail to include in the provenance. Defaults to `min`.
+	Mode ProvenanceModePtrInput `pulumi:"mode"`
+}
+
+// Defaults sets the appropriate defaults for ProvenanceAttestationArgs
+func (val *ProvenanceAttestationArgs) Defaults() *ProvenanceAttestationArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Mode == nil {
+		tmp.Mode = ProvenanceMode("min")
+	}
+	return &tmp
+}
+func (ProvenanceAttestationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProvenanceAttestation)(nil)).Elem()
+}
+
+func (i ProvenanceAttestationArgs) ToProvenanceAttestationOutput() ProvenanceAttestationOutput {
+	return i.ToProvenanceAttestationOutputWithContext(context.Background())
+}
+
+func (i ProvenanceAttestationArgs) ToProvenanceAttestationOutputWithContext(ctx context.Context) ProvenanceAttestationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProvenanceAttestationOutput)
+}
+
+func (i ProvenanceAttestationArgs) ToOutput(ctx context.Context) pulumix.Output[ProvenanceAttestation] {
+	return pulumix.Output[ProvenanceAttestation]{
+		OutputState: i.ToProvenanceAttestationOutputWithContext(ctx).OutputState,
+	}
+}
+
+func (i ProvenanceAttestationArgs) ToProvenanceAttestationPtrOutput() ProvenanceAttestationPtrOutput {
+	return i.ToProvenanceAttestationPtrOutputWithContext(context.Background())
+}
+
+func (i ProvenanceAttestationArgs) ToProvenanceAttestationPtrOutputWithContext(ctx context.Context) ProvenanceAttestationPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProvenanceAttestationOutput).ToProvenanceAttestationPtrOutputWithContext(ctx)
+}
+
+// ProvenanceAttestationPtrInput is an input type that accepts ProvenanceAttestationArgs, ProvenanceAttestationPtr and ProvenanceAttestationPtrOutput values.
+// You can construct a concrete instance of `ProvenanceAttestationPtrInput` via:
+//
+//	        ProvenanceAttestationArgs{...}
+//
+//	or:
+//
+//	        nil
+type ProvenanceAttestationPtrInput interface {
+	pulumi.Input
+
+	ToProvenanceAttestationPtrOutput() ProvenanceAttestationPtrOutput
+	ToProvenanceAttestationPtrOutputWithContext(context.Context) ProvenanceAttestationPtrOutput
+}
+
+type provenanceAttestationPtrType ProvenanceAttestationArgs
+
+func ProvenanceAttestationPtr(v *ProvenanceAttestationArgs) ProvenanceAttestationPtrInput {
+	return (*provenanceAttestationPtrType)(v)
+}
+
+func (*provenanceAttestationPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProvenanceAttestation)(nil)).Elem()
+}
+
+func (i *provenanceAttestationPtrType) ToProvenanceAttestationPtrOutput() ProvenanceAttestationPtrOutput {
+	return i.ToProvenanceAttestationPtrOutputWithContext(context.Background())
+}
+
+func (i *provenanceAttestationPtrType) ToProvenanceAttestationPtrOutputWithContext(ctx context.Context) ProvenanceAttestationPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProvenanceAttestationPtrOutput)
+}
+
+func (i *provenanceAttestationPtrType) ToOutput(ctx context.Context) pulumix.Output[*ProvenanceAttestation] {
+	return pulumix.Output[*ProvenanceAttestation]{
+		OutputState: i.ToProvenanceAttestationPtrOutputWithContext(ctx).OutputState,
+	}
+}
+
+type ProvenanceAttestationOutput struct{ *pulumi.OutputState }
+
+func (ProvenanceAttestationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProvenanceAttestation)(nil)).Elem()
+}
+
+func (o ProvenanceAttestationOutput) ToProvenanceAttestationOutput() ProvenanceAttestationOutput {
+	return o
+}
+
+func (o ProvenanceAttestationOutput) ToProvenanceAttestationOutputWithContext(ctx context.Context) ProvenanceAttestationOutput {
+	return o
+}
+
+func (o ProvenanceAttestationOutput) ToProvenanceAttestationPtrOutput() ProvenanceAttestationPtrOutput {
+	return o.ToProvenanceAttestationPtrOutputWithContext(context.Background())
+}
+
+func (o ProvenanceAttestationOutput) ToProvenanceAttestationPtrOutputWithContext(ctx context.Context) ProvenanceAttestationPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ProvenanceAttestation) *ProvenanceAttestation {
+		return &v
+	}).(ProvenanceAttestationPtrOutput)
+}
+
+func (o ProvenanceAttestationOutput) ToOutput(ctx context.Context) pulumix.Output[ProvenanceAttestation] {
+	return pulumix.Output[ProvenanceAttestation]{
+		OutputState: o.OutputState,
+	}
+}
+
+// An explicit builder ID to record in the provenance, for example the
+// URL of the CI job performing the build.
+func (o ProvenanceAttestationOutput) BuilderId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProvenanceAttestation) *string { return v.BuilderId }).(pulumi.StringPtrOutput)
 }
 
-func (o ExportTarOutput) ToExportTarPtrOutputWithContext(ctx context.Context) ExportTarPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v ExportTar) *ExportTar {
-		return &v
-	}).(ExportTarPtrOutput)
+// When `true` no provenance will be generated. Defaults to `false`.
+func (o ProvenanceAttestationOutput) Disabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ProvenanceAttestation) *bool { return v.Disabled }).(pulumi.BoolPtrOutput)
 }
 
-func (o ExportTarOutput) ToOutput(ctx context.Context) pulumix.Output[ExportTar] {
-	return pulumix.Output[ExportTar]{
-		OutputState: o.OutputState,
-	}
+// Only attach provenance to exporters which embed it in the image
+// (e.g. `image` and `registry`). Defaults to `false`.
+func (o ProvenanceAttestationOutput) InlineOnly() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ProvenanceAttestation) *bool { return v.InlineOnly }).(pulumi.BoolPtrOutput)
 }
 
-// Output path.
-func (o ExportTarOutput) Dest() pulumi.StringOutput {
-	return o.ApplyT(func(v ExportTar) string { return v.Dest }).(pulumi.StringOutput)
+// The level of detail to include in the provenance. Defaults to `min`.
+func (o ProvenanceAttestationOutput) Mode() ProvenanceModePtrOutput {
+	return o.ApplyT(func(v ProvenanceAttestation) *ProvenanceMode { return v.Mode }).(ProvenanceModePtrOutput)
 }
 
-type ExportTarPtrOutput struct{ *pulumi.OutputState }
+type ProvenanceAttestationPtrOutput struct{ *pulumi.OutputState }
 
-func (ExportTarPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ExportTar)(nil)).Elem()
+func (ProvenanceAttestationPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProvenanceAttestation)(nil)).Elem()
 }
 
-func (o ExportTarPtrOutput) ToExportTarPtrOutput() ExportTarPtrOutput {
+func (o ProvenanceAttestationPtrOutput) ToProvenanceAttestationPtrOutput() ProvenanceAttestationPtrOutput {
 	return o
 }
 
-func (o ExportTarPtrOutput) ToExportTarPtrOutputWithContext(ctx context.Context) ExportTarPtrOutput {
+func (o ProvenanceAttestationPtrOutput) ToProvenanceAttestationPtrOutputWithContext(ctx context.Context) ProvenanceAttestationPtrOutput {
 	return o
 }
 
-func (o ExportTarPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*ExportTar] {
-	return pulumix.Output[*ExportTar]{
+func (o ProvenanceAttestationPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*ProvenanceAttestation] {
+	return pulumix.Output[*ProvenanceAttestation]{
 		OutputState: o.OutputState,
 	}
 }
 
-func (o ExportTarPtrOutput) Elem() ExportTarOutput {
-	return o.ApplyT(func(v *ExportTar) ExportTar {
+func (o ProvenanceAttestationPtrOutput) Elem() ProvenanceAttestationOutput {
+	return o.ApplyT(func(v *ProvenanceAttestation) ProvenanceAttestation {
 		if v != nil {
 			return *v
 		}
-		var ret ExportTar
+		var ret ProvenanceAttestation
 		return ret
-	}).(ExportTarOutput)
+	}).(ProvenanceAttestationOutput)
 }
 
-// Output path.
-func (o ExportTarPtrOutput) Dest() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ExportTar) *string {
+// An explicit builder ID to record in the provenance, for example the
+// URL of the CI job performing the build.
+func (o ProvenanceAttestationPtrOutput) BuilderId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ProvenanceAttestation) *string {
 		if v == nil {
 			return nil
 		}
-		return &v.Dest
+		return v.BuilderId
 	}).(pulumi.StringPtrOutput)
 }
 
+// When `true` no provenance will be generated. Defaults to `false`.
+func (o ProvenanceAttestationPtrOutput) Disabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ProvenanceAttestation) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Disabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Only attach provenance to exporters which embed it in the image
+// (e.g. `image` and `registry`). Defaults to `false`.
+func (o ProvenanceAttestationPtrOutput) InlineOnly() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ProvenanceAttestation) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.InlineOnly
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The level of detail to include in the provenance. Defaults to `min`.
+func (o ProvenanceAttestationPtrOutput) Mode() ProvenanceModePtrOutput {
+	return o.ApplyT(func(v *ProvenanceAttestation) *ProvenanceMode {
+		if v == nil {
+			return nil
+		}
+		return v.Mode
+	}).(ProvenanceModePtrOutput)
+}
+
 type Registry struct {
 	// The registry's address (e.g. "docker.io").
 	Address string `pulumi:"address"`
@@ -6767,6 +8211,248 @@ func (o RegistryArrayOutput) Index(i pulumi.IntInput) RegistryOutput {
 	}).(RegistryOutput)
 }
 
+type SBOMAttestation struct {
+	// When `true` no SBOM will be generated. Defaults to `false`.
+	Disabled *bool `pulumi:"disabled"`
+	// The scanner image used to generate the SBOM.
+	//
+	// Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+	Generator *string `pulumi:"generator"`
+	// Also scan the build context, in addition to the final image.
+	//
+	// Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+	ScanContext *bool `pulumi:"scanContext"`
+	// Also scan intermediate build stages, in addition to the final image.
+	//
+	// Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+	ScanStage *bool `pulumi:"scanStage"`
+}
+
+// SBOMAttestationInput is an input type that accepts SBOMAttestationArgs and SBOMAttestationOutput values.
+// You can construct a concrete instance of `SBOMAttestationInput` via:
+//
+//	SBOMAttestationArgs{...}
+type SBOMAttestationInput interface {
+	pulumi.Input
+
+	ToSBOMAttestationOutput() SBOMAttestationOutput
+	ToSBOMAttestationOutputWithContext(context.Context) SBOMAttestationOutput
+}
+
+type SBOMAttestationArgs struct {
+	// When `true` no SBOM will be generated. Defaults to `false`.
+	Disabled pulumi.BoolPtrInput `pulumi:"disabled"`
+	// The scanner image used to generate the SBOM.
+	//
+	// Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+	Generator pulumi.StringPtrInput `pulumi:"generator"`
+	// Also scan the build context, in addition to the final image.
+	//
+	// Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+	ScanContext pulumi.BoolPtrInput `pulumi:"scanContext"`
+	// Also scan intermediate build stages, in addition to the final image.
+	//
+	// Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+	ScanStage pulumi.BoolPtrInput `pulumi:"scanStage"`
+}
+
+func (SBOMAttestationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*SBOMAttestation)(nil)).Elem()
+}
+
+func (i SBOMAttestationArgs) ToSBOMAttestationOutput() SBOMAttestationOutput {
+	return i.ToSBOMAttestationOutputWithContext(context.Background())
+}
+
+func (i SBOMAttestationArgs) ToSBOMAttestationOutputWithContext(ctx context.Context) SBOMAttestationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SBOMAttestationOutput)
+}
+
+func (i SBOMAttestationArgs) ToOutput(ctx context.Context) pulumix.Output[SBOMAttestation] {
+	return pulumix.Output[SBOMAttestation]{
+		OutputState: i.ToSBOMAttestationOutputWithContext(ctx).OutputState,
+	}
+}
+
+func (i SBOMAttestationArgs) ToSBOMAttestationPtrOutput() SBOMAttestationPtrOutput {
+	return i.ToSBOMAttestationPtrOutputWithContext(context.Background())
+}
+
+func (i SBOMAttestationArgs) ToSBOMAttestationPtrOutputWithContext(ctx context.Context) SBOMAttestationPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SBOMAttestationOutput).ToSBOMAttestationPtrOutputWithContext(ctx)
+}
+
+// SBOMAttestationPtrInput is an input type that accepts SBOMAttestationArgs, SBOMAttestationPtr and SBOMAttestationPtrOutput values.
+// You can construct a concrete instance of `SBOMAttestationPtrInput` via:
+//
+//	        SBOMAttestationArgs{...}
+//
+//	or:
+//
+//	        nil
+type SBOMAttestationPtrInput interface {
+	pulumi.Input
+
+	ToSBOMAttestationPtrOutput() SBOMAttestationPtrOutput
+	ToSBOMAttestationPtrOutputWithContext(context.Context) SBOMAttestationPtrOutput
+}
+
+type sbomattestationPtrType SBOMAttestationArgs
+
+func SBOMAttestationPtr(v *SBOMAttestationArgs) SBOMAttestationPtrInput {
+	return (*sbomattestationPtrType)(v)
+}
+
+func (*sbomattestationPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**SBOMAttestation)(nil)).Elem()
+}
+
+func (i *sbomattestationPtrType) ToSBOMAttestationPtrOutput() SBOMAttestationPtrOutput {
+	return i.ToSBOMAttestationPtrOutputWithContext(context.Background())
+}
+
+func (i *sbomattestationPtrType) ToSBOMAttestationPtrOutputWithContext(ctx context.Context) SBOMAttestationPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SBOMAttestationPtrOutput)
+}
+
+func (i *sbomattestationPtrType) ToOutput(ctx context.Context) pulumix.Output[*SBOMAttestation] {
+	return pulumix.Output[*SBOMAttestation]{
+		OutputState: i.ToSBOMAttestationPtrOutputWithContext(ctx).OutputState,
+	}
+}
+
+type SBOMAttestationOutput struct{ *pulumi.OutputState }
+
+func (SBOMAttestationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SBOMAttestation)(nil)).Elem()
+}
+
+func (o SBOMAttestationOutput) ToSBOMAttestationOutput() SBOMAttestationOutput {
+	return o
+}
+
+func (o SBOMAttestationOutput) ToSBOMAttestationOutputWithContext(ctx context.Context) SBOMAttestationOutput {
+	return o
+}
+
+func (o SBOMAttestationOutput) ToSBOMAttestationPtrOutput() SBOMAttestationPtrOutput {
+	return o.ToSBOMAttestationPtrOutputWithContext(context.Background())
+}
+
+func (o SBOMAttestationOutput) ToSBOMAttestationPtrOutputWithContext(ctx context.Context) SBOMAttestationPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v SBOMAttestation) *SBOMAttestation {
+		return &v
+	}).(SBOMAttestationPtrOutput)
+}
+
+func (o SBOMAttestationOutput) ToOutput(ctx context.Context) pulumix.Output[SBOMAttestation] {
+	return pulumix.Output[SBOMAttestation]{
+		OutputState: o.OutputState,
+	}
+}
+
+// When `true` no SBOM will be generated. Defaults to `false`.
+func (o SBOMAttestationOutput) Disabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v SBOMAttestation) *bool { return v.Disabled }).(pulumi.BoolPtrOutput)
+}
+
+// The scanner image used to generate the SBOM.
+//
+// Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+func (o SBOMAttestationOutput) Generator() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SBOMAttestation) *string { return v.Generator }).(pulumi.StringPtrOutput)
+}
+
+// Also scan the build context, in addition to the final image.
+//
+// Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+func (o SBOMAttestationOutput) ScanContext() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v SBOMAttestation) *bool { return v.ScanContext }).(pulumi.BoolPtrOutput)
+}
+
+// Also scan intermediate build stages, in addition to the final image.
+//
+// Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+func (o SBOMAttestationOutput) ScanStage() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v SBOMAttestation) *bool { return v.ScanStage }).(pulumi.BoolPtrOutput)
+}
+
+type SBOMAttestationPtrOutput struct{ *pulumi.OutputState }
+
+func (SBOMAttestationPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SBOMAttestation)(nil)).Elem()
+}
+
+func (o SBOMAttestationPtrOutput) ToSBOMAttestationPtrOutput() SBOMAttestationPtrOutput {
+	return o
+}
+
+func (o SBOMAttestationPtrOutput) ToSBOMAttestationPtrOutputWithContext(ctx context.Context) SBOMAttestationPtrOutput {
+	return o
+}
+
+func (o SBOMAttestationPtrOutput) ToOutput(ctx context.Context) pulumix.Output[*SBOMAttestation] {
+	return pulumix.Output[*SBOMAttestation]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o SBOMAttestationPtrOutput) Elem() SBOMAttestationOutput {
+	return o.ApplyT(func(v *SBOMAttestation) SBOMAttestation {
+		if v != nil {
+			return *v
+		}
+		var ret SBOMAttestation
+		return ret
+	}).(SBOMAttestationOutput)
+}
+
+// When `true` no SBOM will be generated. Defaults to `false`.
+func (o SBOMAttestationPtrOutput) Disabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *SBOMAttestation) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Disabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The scanner image used to generate the SBOM.
+//
+// Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+func (o SBOMAttestationPtrOutput) Generator() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SBOMAttestation) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Generator
+	}).(pulumi.StringPtrOutput)
+}
+
+// Also scan the build context, in addition to the final image.
+//
+// Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+func (o SBOMAttestationPtrOutput) ScanContext() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *SBOMAttestation) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ScanContext
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Also scan intermediate build stages, in addition to the final image.
+//
+// Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+func (o SBOMAttestationPtrOutput) ScanStage() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *SBOMAttestation) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ScanStage
+	}).(pulumi.BoolPtrOutput)
+}
+
 type SSH struct {
 	// Useful for distinguishing different servers that are part of the same
 	// build.
@@ -6927,7 +8613,176 @@ func (o SSHArrayOutput) Index(i pulumi.IntInput) SSHOutput {
 	}).(SSHOutput)
 }
 
+type Ulimit struct {
+	// The hard limit. Defaults to the soft limit.
+	Hard *int `pulumi:"hard"`
+	// The name of the limit, for example `nofile` or `nproc`.
+	Name string `pulumi:"name"`
+	// The soft limit. Use `-1` for unlimited.
+	Soft int `pulumi:"soft"`
+}
+
+// UlimitInput is an input type that accepts UlimitArgs and UlimitOutput values.
+// You can construct a concrete instance of `UlimitInput` via:
+//
+//	UlimitArgs{...}
+type UlimitInput interface {
+	pulumi.Input
+
+	ToUlimitOutput() UlimitOutput
+	ToUlimitOutputWithContext(context.Context) UlimitOutput
+}
+
+type UlimitArgs struct {
+	// The hard limit. Defaults to the soft limit.
+	Hard pulumi.IntPtrInput `pulumi:"hard"`
+	// The name of the limit, for example `nofile` or `nproc`.
+	Name pulumi.StringInput `pulumi:"name"`
+	// The soft limit. Use `-1` for unlimited.
+	Soft pulumi.IntInput `pulumi:"soft"`
+}
+
+func (UlimitArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Ulimit)(nil)).Elem()
+}
+
+func (i UlimitArgs) ToUlimitOutput() UlimitOutput {
+	return i.ToUlimitOutputWithContext(context.Background())
+}
+
+func (i UlimitArgs) ToUlimitOutputWithContext(ctx context.Context) UlimitOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UlimitOutput)
+}
+
+func (i UlimitArgs) ToOutput(ctx context.Context) pulumix.Output[Ulimit] {
+	return pulumix.Output[Ulimit]{
+		OutputState: i.ToUlimitOutputWithContext(ctx).OutputState,
+	}
+}
+
+// UlimitArrayInput is an input type that accepts UlimitArray and UlimitArrayOutput values.
+// You can construct a concrete instance of `UlimitArrayInput` via:
+//
+//	UlimitArray{ UlimitArgs{...} }
+type UlimitArrayInput interface {
+	pulumi.Input
+
+	ToUlimitArrayOutput() UlimitArrayOutput
+	ToUlimitArrayOutputWithContext(context.Context) UlimitArrayOutput
+}
+
+type UlimitArray []UlimitInput
+
+func (UlimitArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Ulimit)(nil)).Elem()
+}
+
+func (i UlimitArray) ToUlimitArrayOutput() UlimitArrayOutput {
+	return i.ToUlimitArrayOutputWithContext(context.Background())
+}
+
+func (i UlimitArray) ToUlimitArrayOutputWithContext(ctx context.Context) UlimitArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UlimitArrayOutput)
+}
+
+func (i UlimitArray) ToOutput(ctx context.Context) pulumix.Output[[]Ulimit] {
+	return pulumix.Output[[]Ulimit]{
+		OutputState: i.ToUlimitArrayOutputWithContext(ctx).OutputState,
+	}
+}
+
+type UlimitOutput struct{ *pulumi.OutputState }
+
+func (UlimitOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Ulimit)(nil)).Elem()
+}
+
+func (o UlimitOutput) ToUlimitOutput() UlimitOutput {
+	return o
+}
+
+func (o UlimitOutput) ToUlimitOutputWithContext(ctx context.Context) UlimitOutput {
+	return o
+}
+
+func (o UlimitOutput) ToOutput(ctx context.Context) pulumix.Output[Ulimit] {
+	return pulumix.Output[Ulimit]{
+		OutputState: o.OutputState,
+	}
+}
+
+// The hard limit. Defaults to the soft limit.
+func (o UlimitOutput) Hard() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Ulimit) *int { return v.Hard }).(pulumi.IntPtrOutput)
+}
+
+// The name of the limit, for example `nofile` or `nproc`.
+func (o UlimitOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v Ulimit) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The soft limit. Use `-1` for unlimited.
+func (o UlimitOutput) Soft() pulumi.IntOutput {
+	return o.ApplyT(func(v Ulimit) int { return v.Soft }).(pulumi.IntOutput)
+}
+
+type UlimitArrayOutput struct{ *pulumi.OutputState }
+
+func (UlimitArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Ulimit)(nil)).Elem()
+}
+
+func (o UlimitArrayOutput) ToUlimitArrayOutput() UlimitArrayOutput {
+	return o
+}
+
+func (o UlimitArrayOutput) ToUlimitArrayOutputWithContext(ctx context.Context) UlimitArrayOutput {
+	return o
+}
+
+func (o UlimitArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]Ulimit] {
+	return pulumix.Output[[]Ulimit]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o UlimitArrayOutput) Index(i pulumi.IntInput) UlimitOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Ulimit {
+		return vs[0].([]Ulimit)[vs[1].(int)]
+	}).(UlimitOutput)
+}
+
+type LayerArrayMapOutput struct{ *pulumi.OutputState }
+
+func (LayerArrayMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string][]Layer)(nil)).Elem()
+}
+
+func (o LayerArrayMapOutput) ToLayerArrayMapOutput() LayerArrayMapOutput {
+	return o
+}
+
+func (o LayerArrayMapOutput) ToLayerArrayMapOutputWithContext(ctx context.Context) LayerArrayMapOutput {
+	return o
+}
+
+func (o LayerArrayMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string][]Layer] {
+	return pulumix.Output[map[string][]Layer]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o LayerArrayMapOutput) MapIndex(k pulumi.StringInput) LayerArrayOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) []Layer {
+		return vs[0].(map[string][]Layer)[vs[1].(string)]
+	}).(LayerArrayOutput)
+}
+
 func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*AttestationsInput)(nil)).Elem(), AttestationsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AttestationsPtrInput)(nil)).Elem(), AttestationsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BaseImageLockInput)(nil)).Elem(), BaseImageLockArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BaseImageLockPtrInput)(nil)).Elem(), BaseImageLockArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*BuildContextInput)(nil)).Elem(), BuildContextArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*BuildContextPtrInput)(nil)).Elem(), BuildContextArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*BuilderConfigInput)(nil)).Elem(), BuilderConfigArgs{})
@@ -6978,13 +8833,31 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ExportRegistryPtrInput)(nil)).Elem(), ExportRegistryArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ExportTarInput)(nil)).Elem(), ExportTarArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ExportTarPtrInput)(nil)).Elem(), ExportTarArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LintInput)(nil)).Elem(), LintArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LintPtrInput)(nil)).Elem(), LintArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProvenanceAttestationInput)(nil)).Elem(), ProvenanceAttestationArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProvenanceAttestationPtrInput)(nil)).Elem(), ProvenanceAttestationArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RegistryInput)(nil)).Elem(), RegistryArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RegistryPtrInput)(nil)).Elem(), RegistryArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RegistryArrayInput)(nil)).Elem(), RegistryArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SBOMAttestationInput)(nil)).Elem(), SBOMAttestationArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SBOMAttestationPtrInput)(nil)).Elem(), SBOMAttestationArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*SSHInput)(nil)).Elem(), SSHArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*SSHArrayInput)(nil)).Elem(), SSHArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*UlimitInput)(nil)).Elem(), UlimitArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*UlimitArrayInput)(nil)).Elem(), UlimitArray{})
+	pulumi.RegisterOutputType(AttestationsOutput{})
+	pulumi.RegisterOutputType(AttestationsPtrOutput{})
+	pulumi.RegisterOutputType(BaseImageLockOutput{})
+	pulumi.RegisterOutputType(BaseImageLockPtrOutput{})
 	pulumi.RegisterOutputType(BuildContextOutput{})
 	pulumi.RegisterOutputType(BuildContextPtrOutput{})
+	pulumi.RegisterOutputType(BuildMetadataOutput{})
+	pulumi.RegisterOutputType(BuildMetadataPtrOutput{})
+	pulumi.RegisterOutputType(BuildStatsOutput{})
+	pulumi.RegisterOutputType(BuildStatsPtrOutput{})
+	pulumi.RegisterOutputType(BuildStepOutput{})
+	pulumi.RegisterOutputType(BuildStepArrayOutput{})
 	pulumi.RegisterOutputType(BuilderConfigOutput{})
 	pulumi.RegisterOutputType(BuilderConfigPtrOutput{})
 	pulumi.RegisterOutputType(CacheFromOutput{})
@@ -7033,9 +8906,22 @@ func init() {
 	pulumi.RegisterOutputType(ExportRegistryPtrOutput{})
 	pulumi.RegisterOutputType(ExportTarOutput{})
 	pulumi.RegisterOutputType(ExportTarPtrOutput{})
+	pulumi.RegisterOutputType(ImageConfigOutput{})
+	pulumi.RegisterOutputType(ImageConfigMapOutput{})
+	pulumi.RegisterOutputType(LayerOutput{})
+	pulumi.RegisterOutputType(LayerArrayOutput{})
+	pulumi.RegisterOutputType(LintOutput{})
+	pulumi.RegisterOutputType(LintPtrOutput{})
+	pulumi.RegisterOutputType(ProvenanceAttestationOutput{})
+	pulumi.RegisterOutputType(ProvenanceAttestationPtrOutput{})
 	pulumi.RegisterOutputType(RegistryOutput{})
 	pulumi.RegisterOutputType(RegistryPtrOutput{})
 	pulumi.RegisterOutputType(RegistryArrayOutput{})
+	pulumi.RegisterOutputType(SBOMAttestationOutput{})
+	pulumi.RegisterOutputType(SBOMAttestationPtrOutput{})
 	pulumi.RegisterOutputType(SSHOutput{})
 	pulumi.RegisterOutputType(SSHArrayOutput{})
+	pulumi.RegisterOutputType(UlimitOutput{})
+	pulumi.RegisterOutputType(UlimitArrayOutput{})
+	pulumi.RegisterOutputType(LayerArrayMapOutput{})
 }
@@ -550,6 +550,60 @@ type Image struct {
 	//
 	// Equivalent to Docker's `--add-host` flag.
 	AddHosts pulumix.ArrayOutput[string] `pulumi:"addHosts"`
+	// Extra privileges to grant to the build.
+	//
+	// The builder must also be configured to permit these entitlements,
+	// for example with `--allow-insecure-entitlement`.
+	//
+	// Equivalent to Docker's `--allow` flag.
+	Allow pulumix.ArrayOutput[Entitlement] `pulumi:"allow"`
+	// Glob patterns, like `docker.io/library/*` or
+	// `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+	// are matched against fully-qualified references such as
+	// `docker.io/library/alpine:latest` and their repository names, and `*`
+	// doesn't match `/`.
+	//
+	// ARGs are substituted from `buildArgs`. References to other stages and
+	// to `named` contexts are not restricted.
+	//
+	// Images must also match the provider's `allowedBaseImages`, if set.
+	AllowedBaseImages pulumix.ArrayOutput[string] `pulumi:"allowedBaseImages"`
+	// Attach arbitrary key/value annotations to the image's manifests.
+	//
+	// Keys may be prefixed with the level to annotate -- `manifest`,
+	// `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+	// qualified with a platform, for example
+	// `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+	// levels can be separated by commas. Defaults to `manifest`.
+	//
+	// Annotations are applied to every `image`, `registry`, `oci` and
+	// `docker` export. Annotations set directly on an export take
+	// precedence.
+	//
+	// Equivalent to Docker's `--annotation` flag.
+	Annotations pulumix.MapOutput[string] `pulumi:"annotations"`
+	// Attestations to attach to the image, such as an SBOM or SLSA
+	// provenance.
+	//
+	// Attestations are only exported with `image`, `registry` or `oci`
+	// exports, and require a builder which supports them (the legacy
+	// `docker` driver does not).
+	//
+	// Equivalent to Docker's `--attest` flag.
+	Attestations pulumix.GPtrOutput[Attestations, AttestationsOutput] `pulumi:"attestations"`
+	// The digest each external `FROM` image resolved to when the image was
+	// built, keyed by its fully-qualified reference.
+	//
+	// Only recorded for images with `pull`, which are rebuilt when any of
+	// these digests has moved.
+	BaseImageDigests pulumix.MapOutput[string] `pulumi:"baseImageDigests"`
+	// Pin every external `FROM` and `COPY --from` image to the digest it
+	// resolved to on the first build.
+	//
+	// Later builds substitute `docker-image://<ref>@<digest>` named
+	// contexts for those images, so they don't change until the lock's
+	// `refresh` value does.
+	BaseImageLock pulumix.GPtrOutput[BaseImageLock, BaseImageLockOutput] `pulumi:"baseImageLock"`
 	// `ARG` names and values to set during the build.
 	//
 	// These variables are accessed like environment variables inside `RUN`
@@ -560,6 +614,15 @@ type Image struct {
 	//
 	// Equivalent to Docker's `--build-arg` flag.
 	BuildArgs pulumix.MapOutput[string] `pulumi:"buildArgs"`
+	// A file to write the build's full log to, including anything written to
+	// stderr. The log is written whether or not the build succeeds, which is
+	// useful for archiving logs from CI.
+	//
+	// Defaults to a file in the provider's `buildLogDir`, if set.
+	BuildLogPath pulumix.Output[*string] `pulumi:"buildLogPath"`
+	// Metadata returned by buildkit after the build, useful for tracing an
+	// image back to its buildx history.
+	BuildMetadata pulumix.GPtrOutput[BuildMetadata, BuildMetadataOutput] `pulumi:"buildMetadata"`
 	// Setting this to `false` will always skip image builds during previews,
 	// and setting it to `true` will always build images during previews.
 	//
@@ -572,6 +635,24 @@ type Image struct {
 	// Defaults to `true` as a safeguard against broken images merging as part
 	// of CI pipelines.
 	BuildOnPreview pulumix.Output[*bool] `pulumi:"buildOnPreview"`
+	// A directory to export the build's history record to after it succeeds
+	// or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+	// `buildRecordPath`.
+	//
+	// Equivalent to running `docker buildx history export` after the build.
+	// Records of failed builds are not available when `exec` is `true`.
+	BuildRecordDir pulumix.Output[*string] `pulumi:"buildRecordDir"`
+	// The path of the most recent build's exported history record, if
+	// `buildRecordDir` is set.
+	//
+	// The record can be opened with Docker Desktop's Builds view or imported
+	// with `docker buildx history import`.
+	BuildRecordPath pulumix.Output[*string] `pulumi:"buildRecordPath"`
+	// Statistics about the most recent build, like its duration and how many
+	// steps were cached.
+	//
+	// Not available when `exec` is `true`.
+	BuildStats pulumix.GPtrOutput[BuildStats, BuildStatsOutput] `pulumi:"buildStats"`
 	// Builder configuration.
 	Builder pulumix.GPtrOutput[BuilderConfig, BuilderConfigOutput] `pulumi:"builder"`
 	// Cache export configuration.
@@ -582,6 +663,21 @@ type Image struct {
 	//
 	// Equivalent to Docker's `--cache-to` flag.
 	CacheTo pulumix.GArrayOutput[CacheTo, CacheToOutput] `pulumi:"cacheTo"`
+	// Set the parent cgroup for `RUN` instructions.
+	//
+	// Equivalent to Docker's `--cgroup-parent` flag.
+	CgroupParent pulumix.Output[*string] `pulumi:"cgroupParent"`
+	// The total compressed size in bytes of each platform's pushed layers,
+	// keyed by platform in the same way as `platformDigests`.
+	//
+	// Empty if the image was not pushed to a registry.
+	CompressedSize pulumix.MapOutput[int] `pulumi:"compressedSize"`
+	// Runtime configuration recorded in each platform's pushed image, such
+	// as its entrypoint, environment and exposed ports. Keyed by platform
+	// in the same way as `platformDigests`.
+	//
+	// Empty if the image was not pushed to a registry.
+	Config pulumix.GMapOutput[ImageConfig, ImageConfigOutput] `pulumi:"config"`
 	// Build context settings. Defaults to the current directory.
 	//
 	// Equivalent to Docker's `PATH | URL | -` positional argument.
@@ -590,6 +686,11 @@ type Image struct {
 	//
 	// Pulumi uses this to determine if an image _may_ need to be re-built.
 	ContextHash pulumix.Output[string] `pulumi:"contextHash"`
+	// A compressed record of each file contributing to `contextHash`, used
+	// to explain which files changed when the image needs to be re-built.
+	//
+	// Empty for contexts with more than 5000 files.
+	ContextManifest pulumix.Output[*string] `pulumi:"contextManifest"`
 	// A SHA256 digest of the image if it was exported to a registry or
 	// elsewhere.
 	//
@@ -640,32 +741,72 @@ type Image struct {
 	//
 	// This is useful when you want to avoid unnecessary rebuilds caused by short-lived secrets that change on every run.
 	IgnoreSecretsInDiffCalculation pulumix.ArrayOutput[string] `pulumi:"ignoreSecretsInDiffCalculation"`
+	// Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+	// warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+	// These warnings are still logged.
+	IgnoreWarnings pulumix.ArrayOutput[string] `pulumi:"ignoreWarnings"`
 	// Attach arbitrary key/value metadata to the image.
 	//
 	// Equivalent to Docker's `--label` flag.
 	Labels pulumix.MapOutput[string] `pulumi:"labels"`
+	// The layers of each platform's pushed image, keyed by platform in the
+	// same way as `platformDigests`.
+	//
+	// Empty if the image was not pushed to a registry.
+	Layers pulumix.GMapOutput[[]Layer, []LayerOutput] `pulumi:"layers"`
+	// Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+	// `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+	// building. Violations are reported as warnings unless configured to be
+	// errors.
+	//
+	// Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+	Lint pulumix.GPtrOutput[Lint, LintOutput] `pulumi:"lint"`
 	// When `true` the build will automatically include a `docker` export.
 	//
 	// Defaults to `false`.
 	//
 	// Equivalent to Docker's `--load` flag.
 	Load pulumix.Output[*bool] `pulumi:"load"`
+	// The digests each base image is pinned to by `baseImageLock`, keyed by
+	// its fully-qualified reference.
+	LockedBaseImages pulumix.MapOutput[string] `pulumi:"lockedBaseImages"`
 	// Set the network mode for `RUN` instructions. Defaults to `default`.
 	//
 	// For custom networks, configure your builder with `--driver-opt network=...`.
 	//
+	// The `host` network mode requires the `network.host` entitlement to be
+	// included in `allow`.
+	//
 	// Equivalent to Docker's `--network` flag.
 	Network pulumix.Output[*NetworkMode] `pulumi:"network"`
 	// Do not import cache manifests when building the image.
 	//
 	// Equivalent to Docker's `--no-cache` flag.
 	NoCache pulumix.Output[*bool] `pulumi:"noCache"`
+	// The digest of each platform-specific manifest pushed to a registry,
+	// keyed by platform (for example `linux/arm64`).
+	//
+	// This is useful when a downstream consumer requires a single-platform
+	// image. Attestation manifests are not included. Single-platform images
+	// are keyed by the requested platform, or by the platform recorded in
+	// their config if `platforms` wasn't specified.
+	//
+	// Empty if the image was not pushed to a registry.
+	PlatformDigests pulumix.MapOutput[string] `pulumi:"platformDigests"`
 	// Set target platform(s) for the build. Defaults to the host's platform.
 	//
 	// Equivalent to Docker's `--platform` flag.
 	Platforms pulumix.ArrayOutput[Platform] `pulumi:"platforms"`
+	// How to report build progress. Defaults to the provider's `progress`
+	// setting.
+	//
+	// Equivalent to Docker's `--progress` flag.
+	Progress pulumix.Output[*ProgressMode] `pulumi:"progress"`
 	// Always pull referenced images.
 	//
+	// Images with `pull` are only rebuilt when one of their
+	// `baseImageDigests` has moved, or always if those aren't known.
+	//
 	// Equivalent to Docker's `--pull` flag.
 	Pull pulumix.Output[*bool] `pulumi:"pull"`
 	// When `true` the build will automatically include a `registry` export.
@@ -697,6 +838,13 @@ type Image struct {
 	//
 	// Similar to `docker login`.
 	Registries pulumix.GArrayOutput[Registry, RegistryOutput] `pulumi:"registries"`
+	// Require every external `FROM` and `COPY --from` image to be pinned
+	// with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+	// Failures suggest the image's current digest when it can be looked up
+	// with the configured registry credentials.
+	//
+	// Defaults to the provider's `requirePinnedBaseImages` setting.
+	RequirePinnedBaseImages pulumix.Output[*bool] `pulumi:"requirePinnedBaseImages"`
 	// A mapping of secret names to their corresponding values.
 	//
 	// Unlike the Docker CLI, these can be passed by value and do not need to
@@ -707,6 +855,10 @@ type Image struct {
 	//
 	// Similar to Docker's `--secret` flag.
 	Secrets pulumix.MapOutput[string] `pulumi:"secrets"`
+	// Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+	//
+	// Equivalent to Docker's `--shm-size` flag.
+	ShmSize pulumix.Output[*string] `pulumi:"shmSize"`
 	// SSH agent socket or keys to expose to the build.
 	//
 	// Equivalent to Docker's `--ssh` flag.
@@ -724,6 +876,16 @@ type Image struct {
 	//
 	// Equivalent to Docker's `--target` flag.
 	Target pulumix.Output[*string] `pulumi:"target"`
+	// Resource limits for `RUN` instructions.
+	//
+	// Equivalent to Docker's `--ulimit` flag.
+	Ulimits pulumix.GArrayOutput[Ulimit, UlimitOutput] `pulumi:"ulimits"`
+	// Fail the build if BuildKit reports any warnings, like Dockerfile lint
+	// rule violations or deprecated syntax. Warnings from rules listed in
+	// `ignoreWarnings` are exempt.
+	//
+	// Defaults to the provider's `warningsAsErrors` setting.
+	WarningsAsErrors pulumix.Output[*bool] `pulumi:"warningsAsErrors"`
 }
 
 // NewImage registers a new resource with the given unique name, arguments, and options.
@@ -736,6 +898,9 @@ func NewImage(ctx *pulumi.Context,
 	if args.Push == nil {
 		return nil, errors.New("invalid value for required argument 'Push'")
 	}
+	if args.Attestations != nil {
+		args.Attestations = pulumix.Apply(args.Attestations, func(o *AttestationsArgs) *AttestationsArgs { return o.Defaults() })
+	}
 	if args.BuildOnPreview == nil {
 		args.BuildOnPreview = pulumix.Ptr(true)
 	}
@@ -787,6 +952,54 @@ type imageArgs struct {
 	//
 	// Equivalent to Docker's `--add-host` flag.
 	AddHosts []string `pulumi:"addHosts"`
+	// Extra privileges to grant to the build.
+	//
+	// The builder must also be configured to permit these entitlements,
+	// for example with `--allow-insecure-entitlement`.
+	//
+	// Equivalent to Docker's `--allow` flag.
+	Allow []Entitlement `pulumi:"allow"`
+	// Glob patterns, like `docker.io/library/*` or
+	// `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+	// are matched against fully-qualified references such as
+	// `docker.io/library/alpine:latest` and their repository names, and `*`
+	// doesn't match `/`.
+	//
+	// ARGs are substituted from `buildArgs`. References to other stages and
+	// to `named` contexts are not restricted.
+	//
+	// Images must also match the provider's `allowedBaseImages`, if set.
+	AllowedBaseImages []string `pulumi:"allowedBaseImages"`
+	// Attach arbitrary key/value annotations to the image's manifests.
+	//
+	// Keys may be prefixed with the level to annotate -- `manifest`,
+	// `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+	// qualified with a platform, for example
+	// `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+	// levels can be separated by commas. Defaults to `manifest`.
+	//
+	// Annotations are applied to every `image`, `registry`, `oci` and
+	// `docker` export. Annotations set directly on an export take
+	// precedence.
+	//
+	// Equivalent to Docker's `--annotation` flag.
+	Annotations map[string]string `pulumi:"annotations"`
+	// Attestations to attach to the image, such as an SBOM or SLSA
+	// provenance.
+	//
+	// Attestations are only exported with `image`, `registry` or `oci`
+	// exports, and require a builder which supports them (the legacy
+	// `docker` driver does not).
+	//
+	// Equivalent to Docker's `--attest` flag.
+	Attestations *Attestations `pulumi:"attestations"`
+	// Pin every external `FROM` and `COPY --from` image to the digest it
+	// resolved to on the first build.
+	//
+	// Later builds substitute `docker-image://<ref>@<digest>` named
+	// contexts for those images, so they don't change until the lock's
+	// `refresh` value does.
+	BaseImageLock *BaseImageLock `pulumi:"baseImageLock"`
 	// `ARG` names and values to set during the build.
 	//
 	// These variables are accessed like environment variables inside `RUN`
@@ -797,6 +1010,12 @@ type imageArgs struct {
 	//
 	// Equivalent to Docker's `--build-arg` flag.
 	BuildArgs map[string]string `pulumi:"buildArgs"`
+	// A file to write the build's full log to, including anything written to
+	// stderr. The log is written whether or not the build succeeds, which is
+	// useful for archiving logs from CI.
+	//
+	// Defaults to a file in the provider's `buildLogDir`, if set.
+	BuildLogPath *string `pulumi:"buildLogPath"`
 	// Setting this to `false` will always skip image builds during previews,
 	// and setting it to `true` will always build images during previews.
 	//
@@ -809,6 +1028,13 @@ type imageArgs struct {
 	// Defaults to `true` as a safeguard against broken images merging as part
 	// of CI pipelines.
 	BuildOnPreview *bool `pulumi:"buildOnPreview"`
+	// A directory to export the build's history record to after it succeeds
+	// or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+	// `buildRecordPath`.
+	//
+	// Equivalent to running `docker buildx history export` after the build.
+	// Records of failed builds are not available when `exec` is `true`.
+	BuildRecordDir *string `pulumi:"buildRecordDir"`
 	// Builder configuration.
 	Builder *BuilderConfig `pulumi:"builder"`
 	// Cache export configuration.
@@ -819,6 +1045,10 @@ type imageArgs struct {
 	//
 	// Equivalent to Docker's `--cache-to` flag.
 	CacheTo []CacheTo `pulumi:"cacheTo"`
+	// Set the parent cgroup for `RUN` instructions.
+	//
+	// Equivalent to Docker's `--cgroup-parent` flag.
+	CgroupParent *string `pulumi:"cgroupParent"`
 	// Build context settings. Defaults to the current directory.
 	//
 	// Equivalent to Docker's `PATH | URL | -` positional argument.
@@ -865,10 +1095,21 @@ type imageArgs struct {
 	//
 	// This is useful when you want to avoid unnecessary rebuilds caused by short-lived secrets that change on every run.
 	IgnoreSecretsInDiffCalculation []string `pulumi:"ignoreSecretsInDiffCalculation"`
+	// Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+	// warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+	// These warnings are still logged.
+	IgnoreWarnings []string `pulumi:"ignoreWarnings"`
 	// Attach arbitrary key/value metadata to the image.
 	//
 	// Equivalent to Docker's `--label` flag.
 	Labels map[string]string `pulumi:"labels"`
+	// Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+	// `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+	// building. Violations are reported as warnings unless configured to be
+	// errors.
+	//
+	// Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+	Lint *Lint `pulumi:"lint"`
 	// When `true` the build will automatically include a `docker` export.
 	//
 	// Defaults to `false`.
@@ -879,6 +1120,9 @@ type imageArgs struct {
 	//
 	// For custom networks, configure your builder with `--driver-opt network=...`.
 	//
+	// The `host` network mode requires the `network.host` entitlement to be
+	// included in `allow`.
+	//
 	// Equivalent to Docker's `--network` flag.
 	Network *NetworkMode `pulumi:"network"`
 	// Do not import cache manifests when building the image.
@@ -889,8 +1133,16 @@ type imageArgs struct {
 	//
 	// Equivalent to Docker's `--platform` flag.
 	Platforms []Platform `pulumi:"platforms"`
+	// How to report build progress. Defaults to the provider's `progress`
+	// setting.
+	//
+	// Equivalent to Docker's `--progress` flag.
+	Progress *ProgressMode `pulumi:"progress"`
 	// Always pull referenced images.
 	//
+	// Images with `pull` are only rebuilt when one of their
+	// `baseImageDigests` has moved, or always if those aren't known.
+	//
 	// Equivalent to Docker's `--pull` flag.
 	Pull *bool `pulumi:"pull"`
 	// When `true` the build will automatically include a `registry` export.
@@ -907,6 +1159,13 @@ type imageArgs struct {
 	//
 	// Similar to `docker login`.
 	Registries []Registry `pulumi:"registries"`
+	// Require every external `FROM` and `COPY --from` image to be pinned
+	// with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+	// Failures suggest the image's current digest when it can be looked up
+	// with the configured registry credentials.
+	//
+	// Defaults to the provider's `requirePinnedBaseImages` setting.
+	RequirePinnedBaseImages *bool `pulumi:"requirePinnedBaseImages"`
 	// A mapping of secret names to their corresponding values.
 	//
 	// Unlike the Docker CLI, these can be passed by value and do not need to
@@ -917,6 +1176,10 @@ type imageArgs struct {
 	//
 	// Similar to Docker's `--secret` flag.
 	Secrets map[string]string `pulumi:"secrets"`
+	// Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+	//
+	// Equivalent to Docker's `--shm-size` flag.
+	ShmSize *string `pulumi:"shmSize"`
 	// SSH agent socket or keys to expose to the build.
 	//
 	// Equivalent to Docker's `--ssh` flag.
@@ -934,6 +1197,16 @@ type imageArgs struct {
 	//
 	// Equivalent to Docker's `--target` flag.
 	Target *string `pulumi:"target"`
+	// Resource limits for `RUN` instructions.
+	//
+	// Equivalent to Docker's `--ulimit` flag.
+	Ulimits []Ulimit `pulumi:"ulimits"`
+	// Fail the build if BuildKit reports any warnings, like Dockerfile lint
+	// rule violations or deprecated syntax. Warnings from rules listed in
+	// `ignoreWarnings` are exempt.
+	//
+	// Defaults to the provider's `warningsAsErrors` setting.
+	WarningsAsErrors *bool `pulumi:"warningsAsErrors"`
 }
 
 // The set of arguments for constructing a Image resource.
@@ -942,6 +1215,54 @@ type ImageArgs struct {
 	//
 	// Equivalent to Docker's `--add-host` flag.
 	AddHosts pulumix.Input[[]string]
+	// Extra privileges to grant to the build.
+	//
+	// The builder must also be configured to permit these entitlements,
+	// for example with `--allow-insecure-entitlement`.
+	//
+	// Equivalent to Docker's `--allow` flag.
+	Allow pulumix.Input[[]Entitlement]
+	// Glob patterns, like `docker.io/library/*` or
+	// `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+	// are matched against fully-qualified references such as
+	// `docker.io/library/alpine:latest` and their repository names, and `*`
+	// doesn't match `/`.
+	//
+	// ARGs are substituted from `buildArgs`. References to other stages and
+	// to `named` contexts are not restricted.
+	//
+	// Images must also match the provider's `allowedBaseImages`, if set.
+	AllowedBaseImages pulumix.Input[[]string]
+	// Attach arbitrary key/value annotations to the image's manifests.
+	//
+	// Keys may be prefixed with the level to annotate -- `manifest`,
+	// `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+	// qualified with a platform, for example
+	// `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+	// levels can be separated by commas. Defaults to `manifest`.
+	//
+	// Annotations are applied to every `image`, `registry`, `oci` and
+	// `docker` export. Annotations set directly on an export take
+	// precedence.
+	//
+	// Equivalent to Docker's `--annotation` flag.
+	Annotations pulumix.Input[map[string]string]
+	// Attestations to attach to the image, such as an SBOM or SLSA
+	// provenance.
+	//
+	// Attestations are only exported with `image`, `registry` or `oci`
+	// exports, and require a builder which supports them (the legacy
+	// `docker` driver does not).
+	//
+	// Equivalent to Docker's `--attest` flag.
+	Attestations pulumix.Input[*AttestationsArgs]
+	// Pin every external `FROM` and `COPY --from` image to the digest it
+	// resolved to on the first build.
+	//
+	// Later builds substitute `docker-image://<ref>@<digest>` named
+	// contexts for those images, so they don't change until the lock's
+	// `refresh` value does.
+	BaseImageLock pulumix.Input[*BaseImageLockArgs]
 	// `ARG` names and values to set during the build.
 	//
 	// These variables are accessed like environment variables inside `RUN`
@@ -952,6 +1273,12 @@ type ImageArgs struct {
 	//
 	// Equivalent to Docker's `--build-arg` flag.
 	BuildArgs pulumix.Input[map[string]string]
+	// A file to write the build's full log to, including anything written to
+	// stderr. The log is written whether or not the build succeeds, which is
+	// useful for archiving logs from CI.
+	//
+	// Defaults to a file in the provider's `buildLogDir`, if set.
+	BuildLogPath pulumix.Input[*string]
 	// Setting this to `false` will always skip image builds during previews,
 	// and setting it to `true` will always build images during previews.
 	//
@@ -964,6 +1291,13 @@ type ImageArgs struct {
 	// Defaults to `true` as a safeguard against broken images merging as part
 	// of CI pipelines.
 	BuildOnPreview pulumix.Input[*bool]
+	// A directory to export the build's history record to after it succeeds
+	// or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+	// `buildRecordPath`.
+	//
+	// Equivalent to running `docker buildx history export` after the build.
+	// Records of failed builds are not available when `exec` is `true`.
+	BuildRecordDir pulumix.Input[*string]
 	// Builder configuration.
 	Builder pulumix.Input[*BuilderConfigArgs]
 	// Cache export configuration.
@@ -974,6 +1308,10 @@ type ImageArgs struct {
 	//
 	// Equivalent to Docker's `--cache-to` flag.
 	CacheTo pulumix.Input[[]*CacheToArgs]
+	// Set the parent cgroup for `RUN` instructions.
+	//
+	// Equivalent to Docker's `--cgroup-parent` flag.
+	CgroupParent pulumix.Input[*string]
 	// Build context settings. Defaults to the current directory.
 	//
 	// Equivalent to Docker's `PATH | URL | -` positional argument.
@@ -1020,10 +1358,21 @@ type ImageArgs struct {
 	//
 	// This is useful when you want to avoid unnecessary rebuilds caused by short-lived secrets that change on every run.
 	IgnoreSecretsInDiffCalculation pulumix.Input[[]string]
+	// Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+	// warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+	// These warnings are still logged.
+	IgnoreWarnings pulumix.Input[[]string]
 	// Attach arbitrary key/value metadata to the image.
 	//
 	// Equivalent to Docker's `--label` flag.
 	Labels pulumix.Input[map[string]string]
+	// Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+	// `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+	// building. Violations are reported as warnings unless configured to be
+	// errors.
+	//
+	// Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+	Lint pulumix.Input[*LintArgs]
 	// When `true` the build will automatically include a `docker` export.
 	//
 	// Defaults to `false`.
@@ -1034,6 +1383,9 @@ type ImageArgs struct {
 	//
 	// For custom networks, configure your builder with `--driver-opt network=...`.
 	//
+	// The `host` network mode requires the `network.host` entitlement to be
+	// included in `allow`.
+	//
 	// Equivalent to Docker's `--network` flag.
 	Network pulumix.Input[*NetworkMode]
 	// Do not import cache manifests when building the image.
@@ -1044,8 +1396,16 @@ type ImageArgs struct {
 	//
 	// Equivalent to Docker's `--platform` flag.
 	Platforms pulumix.Input[[]Platform]
+	// How to report build progress. Defaults to the provider's `progress`
+	// setting.
+	//
+	// Equivalent to Docker's `--progress` flag.
+	Progress pulumix.Input[*ProgressMode]
 	// Always pull referenced images.
 	//
+	// Images with `pull` are only rebuilt when one of their
+	// `baseImageDigests` has moved, or always if those aren't known.
+	//
 	// Equivalent to Docker's `--pull` flag.
 	Pull pulumix.Input[*bool]
 	// When `true` the build will automatically include a `registry` export.
@@ -1062,6 +1422,13 @@ type ImageArgs struct {
 	//
 	// Similar to `docker login`.
 	Registries pulumix.Input[[]*RegistryArgs]
+	// Require every external `FROM` and `COPY --from` image to be pinned
+	// with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+	// Failures suggest the image's current digest when it can be looked up
+	// with the configured registry credentials.
+	//
+	// Defaults to the provider's `requirePinnedBaseImages` setting.
+	RequirePinnedBaseImages pulumix.Input[*bool]
 	// A mapping of secret names to their corresponding values.
 	//
 	// Unlike the Docker CLI, these can be passed by value and do not need to
@@ -1072,6 +1439,10 @@ type ImageArgs struct {
 	//
 	// Similar to Docker's `--secret` flag.
 	Secrets pulumix.Input[map[string]string]
+	// Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+	//
+	// Equivalent to Docker's `--shm-size` flag.
+	ShmSize pulumix.Input[*string]
 	// SSH agent socket or keys to expose to the build.
 	//
 	// Equivalent to Docker's `--ssh` flag.
@@ -1089,6 +1460,16 @@ type ImageArgs struct {
 	//
 	// Equivalent to Docker's `--target` flag.
 	Target pulumix.Input[*string]
+	// Resource limits for `RUN` instructions.
+	//
+	// Equivalent to Docker's `--ulimit` flag.
+	Ulimits pulumix.Input[[]*UlimitArgs]
+	// Fail the build if BuildKit reports any warnings, like Dockerfile lint
+	// rule violations or deprecated syntax. Warnings from rules listed in
+	// `ignoreWarnings` are exempt.
+	//
+	// Defaults to the provider's `warningsAsErrors` setting.
+	WarningsAsErrors pulumix.Input[*bool]
 }
 
 func (ImageArgs) ElementType() reflect.Type {
@@ -1124,6 +1505,90 @@ func (o ImageOutput) AddHosts() pulumix.ArrayOutput[string] {
 	return pulumix.ArrayOutput[string]{OutputState: unwrapped.OutputState}
 }
 
+// Extra privileges to grant to the build.
+//
+// The builder must also be configured to permit these entitlements,
+// for example with `--allow-insecure-entitlement`.
+//
+// Equivalent to Docker's `--allow` flag.
+func (o ImageOutput) Allow() pulumix.ArrayOutput[Entitlement] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.ArrayOutput[Entitlement] { return v.Allow })
+	unwrapped := pulumix.Flatten[[]Entitlement, pulumix.ArrayOutput[Entitlement]](value)
+	return pulumix.ArrayOutput[Entitlement]{OutputState: unwrapped.OutputState}
+}
+
+// Glob patterns, like `docker.io/library/*` or
+// `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+// are matched against fully-qualified references such as
+// `docker.io/library/alpine:latest` and their repository names, and `*`
+// doesn't match `/`.
+//
+// ARGs are substituted from `buildArgs`. References to other stages and
+// to `named` contexts are not restricted.
+//
+// Images must also match the provider's `allowedBaseImages`, if set.
+func (o ImageOutput) AllowedBaseImages() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.ArrayOutput[string] { return v.AllowedBaseImages })
+	unwrapped := pulumix.Flatten[[]string, pulumix.ArrayOutput[string]](value)
+	return pulumix.ArrayOutput[string]{OutputState: unwrapped.OutputState}
+}
+
+// Attach arbitrary key/value annotations to the image's manifests.
+//
+// Keys may be prefixed with the level to annotate -- `manifest`,
+// `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+// qualified with a platform, for example
+// `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+// levels can be separated by commas. Defaults to `manifest`.
+//
+// Annotations are applied to every `image`, `registry`, `oci` and
+// `docker` export. Annotations set directly on an export take
+// precedence.
+//
+// Equivalent to Docker's `--annotation` flag.
+func (o ImageOutput) Annotations() pulumix.MapOutput[string] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.MapOutput[string] { return v.Annotations })
+	unwrapped := pulumix.Flatten[map[string]string, pulumix.MapOutput[string]](value)
+	return pulumix.MapOutput[string]{OutputState: unwrapped.OutputState}
+}
+
+// Attestations to attach to the image, such as an SBOM or SLSA
+// provenance.
+//
+// Attestations are only exported with `image`, `registry` or `oci`
+// exports, and require a builder which supports them (the legacy
+// `docker` driver does not).
+//
+// Equivalent to Docker's `--attest` flag.
+func (o ImageOutput) Attestations() pulumix.GPtrOutput[Attestations, AttestationsOutput] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.GPtrOutput[Attestations, AttestationsOutput] { return v.Attestations })
+	unwrapped := pulumix.Flatten[*Attestations, pulumix.GPtrOutput[Attestations, AttestationsOutput]](value)
+	return pulumix.GPtrOutput[Attestations, AttestationsOutput]{OutputState: unwrapped.OutputState}
+}
+
+// The digest each external `FROM` image resolved to when the image was
+// built, keyed by its fully-qualified reference.
+//
+// Only recorded for images with `pull`, which are rebuilt when any of
+// these digests has moved.
+func (o ImageOutput) BaseImageDigests() pulumix.MapOutput[string] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.MapOutput[string] { return v.BaseImageDigests })
+	unwrapped := pulumix.Flatten[map[string]string, pulumix.MapOutput[string]](value)
+	return pulumix.MapOutput[string]{OutputState: unwrapped.OutputState}
+}
+
+// Pin every external `FROM` and `COPY --from` image to the digest it
+// resolved to on the first build.
+//
+// Later builds substitute `docker-image://<ref>@<digest>` named
+// contexts for those images, so they don't change until the lock's
+// `refresh` value does.
+func (o ImageOutput) BaseImageLock() pulumix.GPtrOutput[BaseImageLock, BaseImageLockOutput] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.GPtrOutput[BaseImageLock, BaseImageLockOutput] { return v.BaseImageLock })
+	unwrapped := pulumix.Flatten[*BaseImageLock, pulumix.GPtrOutput[BaseImageLock, BaseImageLockOutput]](value)
+	return pulumix.GPtrOutput[BaseImageLock, BaseImageLockOutput]{OutputState: unwrapped.OutputState}
+}
+
 // `ARG` names and values to set during the build.
 //
 // These variables are accessed like environment variables inside `RUN`
@@ -1139,6 +1604,24 @@ func (o ImageOutput) BuildArgs() pulumix.MapOutput[string] {
 	return pulumix.MapOutput[string]{OutputState: unwrapped.OutputState}
 }
 
+// A file to write the build's full log to, including anything written to
+// stderr. The log is written whether or not the build succeeds, which is
+// useful for archiving logs from CI.
+//
+// Defaults to a file in the provider's `buildLogDir`, if set.
+func (o ImageOutput) BuildLogPath() pulumix.Output[*string] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.Output[*string] { return v.BuildLogPath })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
+}
+
+// Metadata returned by buildkit after the build, useful for tracing an
+// image back to its buildx history.
+func (o ImageOutput) BuildMetadata() pulumix.GPtrOutput[BuildMetadata, BuildMetadataOutput] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.GPtrOutput[BuildMetadata, BuildMetadataOutput] { return v.BuildMetadata })
+	unwrapped := pulumix.Flatten[*BuildMetadata, pulumix.GPtrOutput[BuildMetadata, BuildMetadataOutput]](value)
+	return pulumix.GPtrOutput[BuildMetadata, BuildMetadataOutput]{OutputState: unwrapped.OutputState}
+}
+
 // Setting this to `false` will always skip image builds during previews,
 // and setting it to `true` will always build images during previews.
 //
@@ -1155,6 +1638,37 @@ func (o ImageOutput) BuildOnPreview() pulumix.Output[*bool] {
 	return pulumix.Flatten[*bool, pulumix.Output[*bool]](value)
 }
 
+// A directory to export the build's history record to after it succeeds
+// or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+// `buildRecordPath`.
+//
+// Equivalent to running `docker buildx history export` after the build.
+// Records of failed builds are not available when `exec` is `true`.
+func (o ImageOutput) BuildRecordDir() pulumix.Output[*string] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.Output[*string] { return v.BuildRecordDir })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
+}
+
+// The path of the most recent build's exported history record, if
+// `buildRecordDir` is set.
+//
+// The record can be opened with Docker Desktop's Builds view or imported
+// with `docker buildx history import`.
+func (o ImageOutput) BuildRecordPath() pulumix.Output[*string] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.Output[*string] { return v.BuildRecordPath })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
+}
+
+// Statistics about the most recent build, like its duration and how many
+// steps were cached.
+//
+// Not available when `exec` is `true`.
+func (o ImageOutput) BuildStats() pulumix.GPtrOutput[BuildStats, BuildStatsOutput] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.GPtrOutput[BuildStats, BuildStatsOutput] { return v.BuildStats })
+	unwrapped := pulumix.Flatten[*BuildStats, pulumix.GPtrOutput[BuildStats, BuildStatsOutput]](value)
+	return pulumix.GPtrOutput[BuildStats, BuildStatsOutput]{OutputState: unwrapped.OutputState}
+}
+
 // Builder configuration.
 func (o ImageOutput) Builder() pulumix.GPtrOutput[BuilderConfig, BuilderConfigOutput] {
 	value := pulumix.Apply[Image](o, func(v Image) pulumix.GPtrOutput[BuilderConfig, BuilderConfigOutput] { return v.Builder })
@@ -1180,6 +1694,35 @@ func (o ImageOutput) CacheTo() pulumix.GArrayOutput[CacheTo, CacheToOutput] {
 	return pulumix.GArrayOutput[CacheTo, CacheToOutput]{OutputState: unwrapped.OutputState}
 }
 
+// Set the parent cgroup for `RUN` instructions.
+//
+// Equivalent to Docker's `--cgroup-parent` flag.
+func (o ImageOutput) CgroupParent() pulumix.Output[*string] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.Output[*string] { return v.CgroupParent })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
+}
+
+// The total compressed size in bytes of each platform's pushed layers,
+// keyed by platform in the same way as `platformDigests`.
+//
+// Empty if the image was not pushed to a registry.
+func (o ImageOutput) CompressedSize() pulumix.MapOutput[int] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.MapOutput[int] { return v.CompressedSize })
+	unwrapped := pulumix.Flatten[map[string]int, pulumix.MapOutput[int]](value)
+	return pulumix.MapOutput[int]{OutputState: unwrapped.OutputState}
+}
+
+// Runtime configuration recorded in each platform's pushed image, such
+// as its entrypoint, environment and exposed ports. Keyed by platform
+// in the same way as `platformDigests`.
+//
+// Empty if the image was not pushed to a registry.
+func (o ImageOutput) Config() pulumix.GMapOutput[ImageConfig, ImageConfigOutput] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.GMapOutput[ImageConfig, ImageConfigOutput] { return v.Config })
+	unwrapped := pulumix.Flatten[map[string]ImageConfig, pulumix.GMapOutput[ImageConfig, ImageConfigOutput]](value)
+	return pulumix.GMapOutput[ImageConfig, ImageConfigOutput]{OutputState: unwrapped.OutputState}
+}
+
 // Build context settings. Defaults to the current directory.
 //
 // Equivalent to Docker's `PATH | URL | -` positional argument.
@@ -1197,6 +1740,15 @@ func (o ImageOutput) ContextHash() pulumix.Output[string] {
 	return pulumix.Flatten[string, pulumix.Output[string]](value)
 }
 
+// A compressed record of each file contributing to `contextHash`, used
+// to explain which files changed when the image needs to be re-built.
+//
+// Empty for contexts with more than 5000 files.
+func (o ImageOutput) ContextManifest() pulumix.Output[*string] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.Output[*string] { return v.ContextManifest })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
+}
+
 // A SHA256 digest of the image if it was exported to a registry or
 // elsewhere.
 //
@@ -1270,6 +1822,15 @@ func (o ImageOutput) IgnoreSecretsInDiffCalculation() pulumix.ArrayOutput[string
 	return pulumix.ArrayOutput[string]{OutputState: unwrapped.OutputState}
 }
 
+// Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+// warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+// These warnings are still logged.
+func (o ImageOutput) IgnoreWarnings() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.ArrayOutput[string] { return v.IgnoreWarnings })
+	unwrapped := pulumix.Flatten[[]string, pulumix.ArrayOutput[string]](value)
+	return pulumix.ArrayOutput[string]{OutputState: unwrapped.OutputState}
+}
+
 // Attach arbitrary key/value metadata to the image.
 //
 // Equivalent to Docker's `--label` flag.
@@ -1279,6 +1840,28 @@ func (o ImageOutput) Labels() pulumix.MapOutput[string] {
 	return pulumix.MapOutput[string]{OutputState: unwrapped.OutputState}
 }
 
+// The layers of each platform's pushed image, keyed by platform in the
+// same way as `platformDigests`.
+//
+// Empty if the image was not pushed to a registry.
+func (o ImageOutput) Layers() pulumix.GMapOutput[[]Layer, []LayerOutput] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.GMapOutput[[]Layer, []LayerOutput] { return v.Layers })
+	unwrapped := pulumix.Flatten[map[string][]Layer, pulumix.GMapOutput[[]Layer, []LayerOutput]](value)
+	return pulumix.GMapOutput[[]Layer, []LayerOutput]{OutputState: unwrapped.OutputState}
+}
+
+// Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+// `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+// building. Violations are reported as warnings unless configured to be
+// errors.
+//
+// Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+func (o ImageOutput) Lint() pulumix.GPtrOutput[Lint, LintOutput] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.GPtrOutput[Lint, LintOutput] { return v.Lint })
+	unwrapped := pulumix.Flatten[*Lint, pulumix.GPtrOutput[Lint, LintOutput]](value)
+	return pulumix.GPtrOutput[Lint, LintOutput]{OutputState: unwrapped.OutputState}
+}
+
 // When `true` the build will automatically include a `docker` export.
 //
 // Defaults to `false`.
@@ -1289,10 +1872,21 @@ func (o ImageOutput) Load() pulumix.Output[*bool] {
 	return pulumix.Flatten[*bool, pulumix.Output[*bool]](value)
 }
 
+// The digests each base image is pinned to by `baseImageLock`, keyed by
+// its fully-qualified reference.
+func (o ImageOutput) LockedBaseImages() pulumix.MapOutput[string] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.MapOutput[string] { return v.LockedBaseImages })
+	unwrapped := pulumix.Flatten[map[string]string, pulumix.MapOutput[string]](value)
+	return pulumix.MapOutput[string]{OutputState: unwrapped.OutputState}
+}
+
 // Set the network mode for `RUN` instructions. Defaults to `default`.
 //
 // For custom networks, configure your builder with `--driver-opt network=...`.
 //
+// The `host` network mode requires the `network.host` entitlement to be
+// included in `allow`.
+//
 // Equivalent to Docker's `--network` flag.
 func (o ImageOutput) Network() pulumix.Output[*NetworkMode] {
 	value := pulumix.Apply[Image](o, func(v Image) pulumix.Output[*NetworkMode] { return v.Network })
@@ -1307,6 +1901,21 @@ func (o ImageOutput) NoCache() pulumix.Output[*bool] {
 	return pulumix.Flatten[*bool, pulumix.Output[*bool]](value)
 }
 
+// The digest of each platform-specific manifest pushed to a registry,
+// keyed by platform (for example `linux/arm64`).
+//
+// This is useful when a downstream consumer requires a single-platform
+// image. Attestation manifests are not included. Single-platform images
+// are keyed by the requested platform, or by the platform recorded in
+// their config if `platforms` wasn't specified.
+//
+// Empty if the image was not pushed to a registry.
+func (o ImageOutput) PlatformDigests() pulumix.MapOutput[string] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.MapOutput[string] { return v.PlatformDigests })
+	unwrapped := pulumix.Flatten[map[string]string, pulumix.MapOutput[string]](value)
+	return pulumix.MapOutput[string]{OutputState: unwrapped.OutputState}
+}
+
 // Set target platform(s) for the build. Defaults to the host's platform.
 //
 // Equivalent to Docker's `--platform` flag.
@@ -1316,8 +1925,20 @@ func (o ImageOutput) Platforms() pulumix.ArrayOutput[Platform] {
 	return pulumix.ArrayOutput[Platform]{OutputState: unwrapped.OutputState}
 }
 
+// How to report build progress. Defaults to the provider's `progress`
+// setting.
+//
+// Equivalent to Docker's `--progress` flag.
+func (o ImageOutput) Progress() pulumix.Output[*ProgressMode] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.Output[*ProgressMode] { return v.Progress })
+	return pulumix.Flatten[*ProgressMode, pulumix.Output[*ProgressMode]](value)
+}
+
 // Always pull referenced images.
 //
+// Images with `pull` are only rebuilt when one of their
+// `baseImageDigests` has moved, or always if those aren't known.
+//
 // Equivalent to Docker's `--pull` flag.
 func (o ImageOutput) Pull() pulumix.Output[*bool] {
 	value := pulumix.Apply[Image](o, func(v Image) pulumix.Output[*bool] { return v.Pull })
@@ -1366,6 +1987,17 @@ func (o ImageOutput) Registries() pulumix.GArrayOutput[Registry, RegistryOutput]
 	return pulumix.GArrayOutput[Registry, RegistryOutput]{OutputState: unwrapped.OutputState}
 }
 
+// Require every external `FROM` and `COPY --from` image to be pinned
+// with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+// Failures suggest the image's current digest when it can be looked up
+// with the configured registry credentials.
+//
+// Defaults to the provider's `requirePinnedBaseImages` setting.
+func (o ImageOutput) RequirePinnedBaseImages() pulumix.Output[*bool] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.Output[*bool] { return v.RequirePinnedBaseImages })
+	return pulumix.Flatten[*bool, pulumix.Output[*bool]](value)
+}
+
 // A mapping of secret names to their corresponding values.
 //
 // Unlike the Docker CLI, these can be passed by value and do not need to
@@ -1381,6 +2013,14 @@ func (o ImageOutput) Secrets() pulumix.MapOutput[string] {
 	return pulumix.MapOutput[string]{OutputState: unwrapped.OutputState}
 }
 
+// Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+//
+// Equivalent to Docker's `--shm-size` flag.
+func (o ImageOutput) ShmSize() pulumix.Output[*string] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.Output[*string] { return v.ShmSize })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
+}
+
 // SSH agent socket or keys to expose to the build.
 //
 // Equivalent to Docker's `--ssh` flag.
@@ -1412,6 +2052,25 @@ func (o ImageOutput) Target() pulumix.Output[*string] {
 	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
 }
 
+// Resource limits for `RUN` instructions.
+//
+// Equivalent to Docker's `--ulimit` flag.
+func (o ImageOutput) Ulimits() pulumix.GArrayOutput[Ulimit, UlimitOutput] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.GArrayOutput[Ulimit, UlimitOutput] { return v.Ulimits })
+	unwrapped := pulumix.Flatten[[]Ulimit, pulumix.GArrayOutput[Ulimit, UlimitOutput]](value)
+	return pulumix.GArrayOutput[Ulimit, UlimitOutput]{OutputState: unwrapped.OutputState}
+}
+
+// Fail the build if BuildKit reports any warnings, like Dockerfile lint
+// rule violations or deprecated syntax. Warnings from rules listed in
+// `ignoreWarnings` are exempt.
+//
+// Defaults to the provider's `warningsAsErrors` setting.
+func (o ImageOutput) WarningsAsErrors() pulumix.Output[*bool] {
+	value := pulumix.Apply[Image](o, func(v Image) pulumix.Output[*bool] { return v.WarningsAsErrors })
+	return pulumix.Flatten[*bool, pulumix.Output[*bool]](value)
+}
+
 func init() {
 	pulumi.RegisterOutputType(ImageOutput{})
 }
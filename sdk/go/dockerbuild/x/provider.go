@@ -15,8 +15,29 @@ import (
 type Provider struct {
 	pulumi.ProviderResourceState
 
+	// A directory to write the full log of each image build to, for images
+	// which don't specify their own `buildLogPath`.
+	//
+	// Logs are named after the image's first tag, or the resource's name if
+	// it has no tags.
+	BuildLogDir pulumix.Output[*string] `pulumi:"buildLogDir"`
+	// A directory to cache build context hashes in, so files which haven't
+	// changed aren't read again on later runs.
+	//
+	// Files are considered unchanged if their size, modification time and
+	// inode are the same. The resulting `contextHash` is unaffected.
+	ContextHashCacheDir pulumix.Output[*string] `pulumi:"contextHashCacheDir"`
 	// The build daemon's address.
 	Host pulumix.Output[*string] `pulumi:"host"`
+	// An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+	// of resource operations and builds to. gRPC endpoints aren't supported.
+	//
+	// Spans join the trace in the `TRACEPARENT` environment variable, if set,
+	// and trace context is propagated to the build daemon.
+	OtlpEndpoint pulumix.Output[*string] `pulumi:"otlpEndpoint"`
+	// How to report build progress for images which don't specify their own
+	// `progress`. Defaults to `plain`.
+	Progress pulumix.Output[*ProgressMode] `pulumi:"progress"`
 }
 
 // NewProvider registers a new resource with the given unique name, arguments, and options.
@@ -31,6 +52,11 @@ func NewProvider(ctx *pulumi.Context,
 			args.Host = pulumix.Ptr(d.(string))
 		}
 	}
+	if args.OtlpEndpoint == nil {
+		if d := internal.GetEnvOrDefault("", nil, "PULUMI_DOCKER_BUILD_OTLP_ENDPOINT"); d != nil {
+			args.OtlpEndpoint = pulumix.Ptr(d.(string))
+		}
+	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Provider
 	err := ctx.RegisterResource("pulumi:providers:docker-build", name, args, &resource, opts...)
@@ -41,16 +67,80 @@ func NewProvider(ctx *pulumi.Context,
 }
 
 type providerArgs struct {
+	// Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+	// instructions must match. This applies in addition to each image's own
+	// `allowedBaseImages`.
+	AllowedBaseImages []string `pulumi:"allowedBaseImages"`
+	// A directory to write the full log of each image build to, for images
+	// which don't specify their own `buildLogPath`.
+	//
+	// Logs are named after the image's first tag, or the resource's name if
+	// it has no tags.
+	BuildLogDir *string `pulumi:"buildLogDir"`
+	// A directory to cache build context hashes in, so files which haven't
+	// changed aren't read again on later runs.
+	//
+	// Files are considered unchanged if their size, modification time and
+	// inode are the same. The resulting `contextHash` is unaffected.
+	ContextHashCacheDir *string `pulumi:"contextHashCacheDir"`
 	// The build daemon's address.
-	Host       *string    `pulumi:"host"`
-	Registries []Registry `pulumi:"registries"`
+	Host *string `pulumi:"host"`
+	// An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+	// of resource operations and builds to. gRPC endpoints aren't supported.
+	//
+	// Spans join the trace in the `TRACEPARENT` environment variable, if set,
+	// and trace context is propagated to the build daemon.
+	OtlpEndpoint *string `pulumi:"otlpEndpoint"`
+	// How to report build progress for images which don't specify their own
+	// `progress`. Defaults to `plain`.
+	Progress   *ProgressMode `pulumi:"progress"`
+	Registries []Registry    `pulumi:"registries"`
+	// Require external `FROM` and `COPY --from` images to be pinned with an
+	// `@sha256:` digest, for images which don't specify their own
+	// `requirePinnedBaseImages`.
+	RequirePinnedBaseImages *bool `pulumi:"requirePinnedBaseImages"`
+	// Fail image builds if BuildKit reports any warnings, for images which
+	// don't specify their own `warningsAsErrors`.
+	WarningsAsErrors *bool `pulumi:"warningsAsErrors"`
 }
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
+	// Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+	// instructions must match. This applies in addition to each image's own
+	// `allowedBaseImages`.
+	AllowedBaseImages pulumix.Input[[]string]
+	// A directory to write the full log of each image build to, for images
+	// which don't specify their own `buildLogPath`.
+	//
+	// Logs are named after the image's first tag, or the resource's name if
+	// it has no tags.
+	BuildLogDir pulumix.Input[*string]
+	// A directory to cache build context hashes in, so files which haven't
+	// changed aren't read again on later runs.
+	//
+	// Files are considered unchanged if their size, modification time and
+	// inode are the same. The resulting `contextHash` is unaffected.
+	ContextHashCacheDir pulumix.Input[*string]
 	// The build daemon's address.
-	Host       pulumix.Input[*string]
+	Host pulumix.Input[*string]
+	// An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+	// of resource operations and builds to. gRPC endpoints aren't supported.
+	//
+	// Spans join the trace in the `TRACEPARENT` environment variable, if set,
+	// and trace context is propagated to the build daemon.
+	OtlpEndpoint pulumix.Input[*string]
+	// How to report build progress for images which don't specify their own
+	// `progress`. Defaults to `plain`.
+	Progress   pulumix.Input[*ProgressMode]
 	Registries pulumix.Input[[]*RegistryArgs]
+	// Require external `FROM` and `COPY --from` images to be pinned with an
+	// `@sha256:` digest, for images which don't specify their own
+	// `requirePinnedBaseImages`.
+	RequirePinnedBaseImages pulumix.Input[*bool]
+	// Fail image builds if BuildKit reports any warnings, for images which
+	// don't specify their own `warningsAsErrors`.
+	WarningsAsErrors pulumix.Input[*bool]
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
@@ -77,12 +167,49 @@ func (o ProviderOutput) ToOutput(ctx context.Context) pulumix.Output[Provider] {
 	}
 }
 
+// A directory to write the full log of each image build to, for images
+// which don't specify their own `buildLogPath`.
+//
+// Logs are named after the image's first tag, or the resource's name if
+// it has no tags.
+func (o ProviderOutput) BuildLogDir() pulumix.Output[*string] {
+	value := pulumix.Apply[Provider](o, func(v Provider) pulumix.Output[*string] { return v.BuildLogDir })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
+}
+
+// A directory to cache build context hashes in, so files which haven't
+// changed aren't read again on later runs.
+//
+// Files are considered unchanged if their size, modification time and
+// inode are the same. The resulting `contextHash` is unaffected.
+func (o ProviderOutput) ContextHashCacheDir() pulumix.Output[*string] {
+	value := pulumix.Apply[Provider](o, func(v Provider) pulumix.Output[*string] { return v.ContextHashCacheDir })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
+}
+
 // The build daemon's address.
 func (o ProviderOutput) Host() pulumix.Output[*string] {
 	value := pulumix.Apply[Provider](o, func(v Provider) pulumix.Output[*string] { return v.Host })
 	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
 }
 
+// An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+// of resource operations and builds to. gRPC endpoints aren't supported.
+//
+// Spans join the trace in the `TRACEPARENT` environment variable, if set,
+// and trace context is propagated to the build daemon.
+func (o ProviderOutput) OtlpEndpoint() pulumix.Output[*string] {
+	value := pulumix.Apply[Provider](o, func(v Provider) pulumix.Output[*string] { return v.OtlpEndpoint })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
+}
+
+// How to report build progress for images which don't specify their own
+// `progress`. Defaults to `plain`.
+func (o ProviderOutput) Progress() pulumix.Output[*ProgressMode] {
+	value := pulumix.Apply[Provider](o, func(v Provider) pulumix.Output[*ProgressMode] { return v.Progress })
+	return pulumix.Flatten[*ProgressMode, pulumix.Output[*ProgressMode]](value)
+}
+
 func init() {
 	pulumi.RegisterOutputType(ProviderOutput{})
 }
@@ -23,6 +23,24 @@ const (
 	CompressionTypeCompressionTypeZstd = CompressionType("zstd")
 )
 
+type ContextHashMode string
+
+const (
+	// Hash every file in the context which isn't excluded by ".dockerignore".
+	ContextHashModeContextHashModeAll = ContextHashMode("all")
+	// Hash only the files reachable from "COPY" and "ADD" sources, falling back to every file when they can't be determined statically.
+	ContextHashModeContextHashModeReferenced = ContextHashMode("referenced")
+)
+
+type Entitlement string
+
+const (
+	// Allow "RUN --network=host" and the "host" network mode.
+	Entitlement_Entitlement_Network_host = Entitlement("network.host")
+	// Allow "RUN --security=insecure" to run privileged containers.
+	Entitlement_Entitlement_Security_insecure = Entitlement("security.insecure")
+)
+
 type NetworkMode string
 
 const (
@@ -66,3 +84,23 @@ const (
 	Platform_Platform_Windows_386     = Platform("windows/386")
 	Platform_Platform_Windows_amd64   = Platform("windows/amd64")
 )
+
+type ProgressMode string
+
+const (
+	// Stream plain-text progress as the build runs.
+	ProgressModeProgressModePlain = ProgressMode("plain")
+	// Log each build status update as a line of JSON.
+	ProgressModeProgressModeRawjson = ProgressMode("rawjson")
+	// Only report progress if the build fails.
+	ProgressModeProgressModeQuiet = ProgressMode("quiet")
+)
+
+type ProvenanceMode string
+
+const (
+	// Only include a minimal description of the build.
+	ProvenanceModeProvenanceModeMin = ProvenanceMode("min")
+	// Include detailed information about the build, including build arguments and source mappings.
+	ProvenanceModeProvenanceModeMax = ProvenanceMode("max")
+)
@@ -14,7 +14,193 @@ import (
 
 var _ = internal.GetEnvOrDefault
 
+type Attestations struct {
+	// Generate SLSA provenance for the build.
+	//
+	// Provenance is disabled unless configured here.
+	//
+	// Equivalent to Docker's `--attest type=provenance` flag.
+	Provenance *ProvenanceAttestation `pulumi:"provenance"`
+	// Generate a Software Bill of Materials (SBOM) for the image.
+	//
+	// Equivalent to Docker's `--attest type=sbom` flag.
+	Sbom *SBOMAttestation `pulumi:"sbom"`
+}
+
+// Defaults sets the appropriate defaults for Attestations
+func (val *Attestations) Defaults() *Attestations {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	tmp.Provenance = tmp.Provenance.Defaults()
+
+	return &tmp
+}
+
+type AttestationsArgs struct {
+	// Generate SLSA provenance for the build.
+	//
+	// Provenance is disabled unless configured here.
+	//
+	// Equivalent to Docker's `--attest type=provenance` flag.
+	Provenance pulumix.Input[*ProvenanceAttestationArgs] `pulumi:"provenance"`
+	// Generate a Software Bill of Materials (SBOM) for the image.
+	//
+	// Equivalent to Docker's `--attest type=sbom` flag.
+	Sbom pulumix.Input[*SBOMAttestationArgs] `pulumi:"sbom"`
+}
+
+// Defaults sets the appropriate defaults for AttestationsArgs
+func (val *AttestationsArgs) Defaults() *AttestationsArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+
+	return &tmp
+}
+func (AttestationsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Attestations)(nil)).Elem()
+}
+
+func (i AttestationsArgs) ToAttestationsOutput() AttestationsOutput {
+	return i.ToAttestationsOutputWithContext(context.Background())
+}
+
+func (i AttestationsArgs) ToAttestationsOutputWithContext(ctx context.Context) AttestationsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AttestationsOutput)
+}
+
+func (i *AttestationsArgs) ToOutput(ctx context.Context) pulumix.Output[*AttestationsArgs] {
+	return pulumix.Val(i)
+}
+
+type AttestationsOutput struct{ *pulumi.OutputState }
+
+func (AttestationsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Attestations)(nil)).Elem()
+}
+
+func (o AttestationsOutput) ToAttestationsOutput() AttestationsOutput {
+	return o
+}
+
+func (o AttestationsOutput) ToAttestationsOutputWithContext(ctx context.Context) AttestationsOutput {
+	return o
+}
+
+func (o AttestationsOutput) ToOutput(ctx context.Context) pulumix.Output[Attestations] {
+	return pulumix.Output[Attestations]{
+		OutputState: o.OutputState,
+	}
+}
+
+// Generate SLSA provenance for the build.
+//
+// Provenance is disabled unless configured here.
+//
+// Equivalent to Docker's `--attest type=provenance` flag.
+func (o AttestationsOutput) Provenance() pulumix.GPtrOutput[ProvenanceAttestation, ProvenanceAttestationOutput] {
+	value := pulumix.Apply[Attestations](o, func(v Attestations) *ProvenanceAttestation { return v.Provenance })
+	return pulumix.GPtrOutput[ProvenanceAttestation, ProvenanceAttestationOutput]{OutputState: value.OutputState}
+}
+
+// Generate a Software Bill of Materials (SBOM) for the image.
+//
+// Equivalent to Docker's `--attest type=sbom` flag.
+func (o AttestationsOutput) Sbom() pulumix.GPtrOutput[SBOMAttestation, SBOMAttestationOutput] {
+	value := pulumix.Apply[Attestations](o, func(v Attestations) *SBOMAttestation { return v.Sbom })
+	return pulumix.GPtrOutput[SBOMAttestation, SBOMAttestationOutput]{OutputState: value.OutputState}
+}
+
+type BaseImageLock struct {
+	// A lockfile to record digests in, like `Dockerfile.lock`. Relative
+	// paths are resolved from the Dockerfile's directory, or the context's
+	// for inline Dockerfiles.
+	//
+	// Digests are recorded in the resource's state if this isn't set.
+	Path *string `pulumi:"path"`
+	// An arbitrary value, like a date, which re-resolves every base image
+	// when it changes.
+	Refresh *string `pulumi:"refresh"`
+}
+
+type BaseImageLockArgs struct {
+	// A lockfile to record digests in, like `Dockerfile.lock`. Relative
+	// paths are resolved from the Dockerfile's directory, or the context's
+	// for inline Dockerfiles.
+	//
+	// Digests are recorded in the resource's state if this isn't set.
+	Path pulumix.Input[*string] `pulumi:"path"`
+	// An arbitrary value, like a date, which re-resolves every base image
+	// when it changes.
+	Refresh pulumix.Input[*string] `pulumi:"refresh"`
+}
+
+func (BaseImageLockArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BaseImageLock)(nil)).Elem()
+}
+
+func (i BaseImageLockArgs) ToBaseImageLockOutput() BaseImageLockOutput {
+	return i.ToBaseImageLockOutputWithContext(context.Background())
+}
+
+func (i BaseImageLockArgs) ToBaseImageLockOutputWithContext(ctx context.Context) BaseImageLockOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BaseImageLockOutput)
+}
+
+func (i *BaseImageLockArgs) ToOutput(ctx context.Context) pulumix.Output[*BaseImageLockArgs] {
+	return pulumix.Val(i)
+}
+
+type BaseImageLockOutput struct{ *pulumi.OutputState }
+
+func (BaseImageLockOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BaseImageLock)(nil)).Elem()
+}
+
+func (o BaseImageLockOutput) ToBaseImageLockOutput() BaseImageLockOutput {
+	return o
+}
+
+func (o BaseImageLockOutput) ToBaseImageLockOutputWithContext(ctx context.Context) BaseImageLockOutput {
+	return o
+}
+
+func (o BaseImageLockOutput) ToOutput(ctx context.Context) pulumix.Output[BaseImageLock] {
+	return pulumix.Output[BaseImageLock]{
+		OutputState: o.OutputState,
+	}
+}
+
+// A lockfile to record digests in, like `Dockerfile.lock`. Relative
+// paths are resolved from the Dockerfile's directory, or the context's
+// for inline Dockerfiles.
+//
+// Digests are recorded in the resource's state if this isn't set.
+func (o BaseImageLockOutput) Path() pulumix.Output[*string] {
+	return pulumix.Apply[BaseImageLock](o, func(v BaseImageLock) *string { return v.Path })
+}
+
+// An arbitrary value, like a date, which re-resolves every base image
+// when it changes.
+func (o BaseImageLockOutput) Refresh() pulumix.Output[*string] {
+	return pulumix.Apply[BaseImageLock](o, func(v BaseImageLock) *string { return v.Refresh })
+}
+
 type BuildContext struct {
+	// Which files in local contexts contribute to the `contextHash` used to
+	// decide whether the image needs to be rebuilt.
+	//
+	// With `referenced`, only the paths copied by `COPY` and `ADD`
+	// instructions are hashed, so changes to other files don't trigger a
+	// rebuild. Every file is hashed if those paths can't be determined
+	// statically -- for example when the Dockerfile is remote or a `RUN`
+	// instruction bind-mounts a context.
+	//
+	// Defaults to `all`.
+	HashMode *ContextHashMode `pulumi:"hashMode"`
 	// Resources to use for build context.
 	//
 	// The location can be:
@@ -34,6 +220,17 @@ type BuildContext struct {
 }
 
 type BuildContextArgs struct {
+	// Which files in local contexts contribute to the `contextHash` used to
+	// decide whether the image needs to be rebuilt.
+	//
+	// With `referenced`, only the paths copied by `COPY` and `ADD`
+	// instructions are hashed, so changes to other files don't trigger a
+	// rebuild. Every file is hashed if those paths can't be determined
+	// statically -- for example when the Dockerfile is remote or a `RUN`
+	// instruction bind-mounts a context.
+	//
+	// Defaults to `all`.
+	HashMode pulumix.Input[*ContextHashMode] `pulumi:"hashMode"`
 	// Resources to use for build context.
 	//
 	// The location can be:
@@ -88,6 +285,20 @@ func (o BuildContextOutput) ToOutput(ctx context.Context) pulumix.Output[BuildCo
 	}
 }
 
+// Which files in local contexts contribute to the `contextHash` used to
+// decide whether the image needs to be rebuilt.
+//
+// With `referenced`, only the paths copied by `COPY` and `ADD`
+// instructions are hashed, so changes to other files don't trigger a
+// rebuild. Every file is hashed if those paths can't be determined
+// statically -- for example when the Dockerfile is remote or a `RUN`
+// instruction bind-mounts a context.
+//
+// Defaults to `all`.
+func (o BuildContextOutput) HashMode() pulumix.Output[*ContextHashMode] {
+	return pulumix.Apply[BuildContext](o, func(v BuildContext) *ContextHashMode { return v.HashMode })
+}
+
 // Resources to use for build context.
 //
 // The location can be:
@@ -111,6 +322,168 @@ func (o BuildContextOutput) Named() pulumix.GMapOutput[Context, ContextOutput] {
 	return pulumix.GMapOutput[Context, ContextOutput]{OutputState: value.OutputState}
 }
 
+type BuildMetadata struct {
+	// The buildx history reference for the build, formatted as
+	// `<builder>/<node>/<ref>`.
+	//
+	// This can be passed to `docker buildx history inspect` to debug the
+	// build.
+	BuildRef *string `pulumi:"buildRef"`
+	// The digest of the image's config blob.
+	ConfigDigest *string `pulumi:"configDigest"`
+	// The media type of the exported image's top-level manifest or index.
+	DescriptorMediaType *string `pulumi:"descriptorMediaType"`
+	// The size in bytes of the exported image's top-level manifest or index.
+	DescriptorSize *int `pulumi:"descriptorSize"`
+	// The comma-separated names the image was exported with.
+	ImageName *string `pulumi:"imageName"`
+}
+
+type BuildMetadataOutput struct{ *pulumi.OutputState }
+
+func (BuildMetadataOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BuildMetadata)(nil)).Elem()
+}
+
+func (o BuildMetadataOutput) ToBuildMetadataOutput() BuildMetadataOutput {
+	return o
+}
+
+func (o BuildMetadataOutput) ToBuildMetadataOutputWithContext(ctx context.Context) BuildMetadataOutput {
+	return o
+}
+
+func (o BuildMetadataOutput) ToOutput(ctx context.Context) pulumix.Output[BuildMetadata] {
+	return pulumix.Output[BuildMetadata]{
+		OutputState: o.OutputState,
+	}
+}
+
+// The buildx history reference for the build, formatted as
+// `<builder>/<node>/<ref>`.
+//
+// This can be passed to `docker buildx history inspect` to debug the
+// build.
+func (o BuildMetadataOutput) BuildRef() pulumix.Output[*string] {
+	return pulumix.Apply[BuildMetadata](o, func(v BuildMetadata) *string { return v.BuildRef })
+}
+
+// The digest of the image's config blob.
+func (o BuildMetadataOutput) ConfigDigest() pulumix.Output[*string] {
+	return pulumix.Apply[BuildMetadata](o, func(v BuildMetadata) *string { return v.ConfigDigest })
+}
+
+// The media type of the exported image's top-level manifest or index.
+func (o BuildMetadataOutput) DescriptorMediaType() pulumix.Output[*string] {
+	return pulumix.Apply[BuildMetadata](o, func(v BuildMetadata) *string { return v.DescriptorMediaType })
+}
+
+// The size in bytes of the exported image's top-level manifest or index.
+func (o BuildMetadataOutput) DescriptorSize() pulumix.Output[*int] {
+	return pulumix.Apply[BuildMetadata](o, func(v BuildMetadata) *int { return v.DescriptorSize })
+}
+
+// The comma-separated names the image was exported with.
+func (o BuildMetadataOutput) ImageName() pulumix.Output[*string] {
+	return pulumix.Apply[BuildMetadata](o, func(v BuildMetadata) *string { return v.ImageName })
+}
+
+type BuildStats struct {
+	// The fraction of steps which were cached, between 0 and 1.
+	CacheHitRatio float64 `pulumi:"cacheHitRatio"`
+	// The number of steps which were cached.
+	CachedSteps int `pulumi:"cachedSteps"`
+	// Wall-clock duration of the build in seconds.
+	Duration float64 `pulumi:"duration"`
+	// The slowest uncached steps, in descending order of duration.
+	SlowestSteps []*BuildStep `pulumi:"slowestSteps"`
+	// The number of steps (vertices) solved by the build.
+	Steps int `pulumi:"steps"`
+}
+
+type BuildStatsOutput struct{ *pulumi.OutputState }
+
+func (BuildStatsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BuildStats)(nil)).Elem()
+}
+
+func (o BuildStatsOutput) ToBuildStatsOutput() BuildStatsOutput {
+	return o
+}
+
+func (o BuildStatsOutput) ToBuildStatsOutputWithContext(ctx context.Context) BuildStatsOutput {
+	return o
+}
+
+func (o BuildStatsOutput) ToOutput(ctx context.Context) pulumix.Output[BuildStats] {
+	return pulumix.Output[BuildStats]{
+		OutputState: o.OutputState,
+	}
+}
+
+// The fraction of steps which were cached, between 0 and 1.
+func (o BuildStatsOutput) CacheHitRatio() pulumix.Output[float64] {
+	return pulumix.Apply[BuildStats](o, func(v BuildStats) float64 { return v.CacheHitRatio })
+}
+
+// The number of steps which were cached.
+func (o BuildStatsOutput) CachedSteps() pulumix.Output[int] {
+	return pulumix.Apply[BuildStats](o, func(v BuildStats) int { return v.CachedSteps })
+}
+
+// Wall-clock duration of the build in seconds.
+func (o BuildStatsOutput) Duration() pulumix.Output[float64] {
+	return pulumix.Apply[BuildStats](o, func(v BuildStats) float64 { return v.Duration })
+}
+
+// The slowest uncached steps, in descending order of duration.
+func (o BuildStatsOutput) SlowestSteps() pulumix.GArrayOutput[BuildStep, BuildStepOutput] {
+	value := pulumix.Apply[BuildStats](o, func(v BuildStats) []*BuildStep { return v.SlowestSteps })
+	return pulumix.GArrayOutput[BuildStep, BuildStepOutput]{OutputState: value.OutputState}
+}
+
+// The number of steps (vertices) solved by the build.
+func (o BuildStatsOutput) Steps() pulumix.Output[int] {
+	return pulumix.Apply[BuildStats](o, func(v BuildStats) int { return v.Steps })
+}
+
+type BuildStep struct {
+	// The step's duration in seconds.
+	Duration float64 `pulumi:"duration"`
+	// The step's name, for example "[2/3] RUN make".
+	Name string `pulumi:"name"`
+}
+
+type BuildStepOutput struct{ *pulumi.OutputState }
+
+func (BuildStepOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BuildStep)(nil)).Elem()
+}
+
+func (o BuildStepOutput) ToBuildStepOutput() BuildStepOutput {
+	return o
+}
+
+func (o BuildStepOutput) ToBuildStepOutputWithContext(ctx context.Context) BuildStepOutput {
+	return o
+}
+
+func (o BuildStepOutput) ToOutput(ctx context.Context) pulumix.Output[BuildStep] {
+	return pulumix.Output[BuildStep]{
+		OutputState: o.OutputState,
+	}
+}
+
+// The step's duration in seconds.
+func (o BuildStepOutput) Duration() pulumix.Output[float64] {
+	return pulumix.Apply[BuildStep](o, func(v BuildStep) float64 { return v.Duration })
+}
+
+// The step's name, for example "[2/3] RUN make".
+func (o BuildStepOutput) Name() pulumix.Output[string] {
+	return pulumix.Apply[BuildStep](o, func(v BuildStep) string { return v.Name })
+}
+
 type BuilderConfig struct {
 	// Name of an existing buildx builder to use.
 	//
@@ -3104,6 +3477,308 @@ func (o ExportTarOutput) Dest() pulumix.Output[string] {
 	return pulumix.Apply[ExportTar](o, func(v ExportTar) string { return v.Dest })
 }
 
+type ImageConfig struct {
+	// The image's "CMD".
+	Cmd []string `pulumi:"cmd"`
+	// The image's "ENTRYPOINT".
+	Entrypoint []string `pulumi:"entrypoint"`
+	// Environment variables set by "ENV", as "KEY=value" pairs.
+	Env []string `pulumi:"env"`
+	// Ports declared by `EXPOSE`, for example `8080/tcp`.
+	ExposedPorts []string `pulumi:"exposedPorts"`
+	// The image's final labels, including any inherited from base images.
+	Labels map[string]string `pulumi:"labels"`
+	// The image's "USER".
+	User *string `pulumi:"user"`
+	// The image's "WORKDIR".
+	WorkingDir *string `pulumi:"workingDir"`
+}
+
+type ImageConfigOutput struct{ *pulumi.OutputState }
+
+func (ImageConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ImageConfig)(nil)).Elem()
+}
+
+func (o ImageConfigOutput) ToImageConfigOutput() ImageConfigOutput {
+	return o
+}
+
+func (o ImageConfigOutput) ToImageConfigOutputWithContext(ctx context.Context) ImageConfigOutput {
+	return o
+}
+
+func (o ImageConfigOutput) ToOutput(ctx context.Context) pulumix.Output[ImageConfig] {
+	return pulumix.Output[ImageConfig]{
+		OutputState: o.OutputState,
+	}
+}
+
+// The image's "CMD".
+func (o ImageConfigOutput) Cmd() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[ImageConfig](o, func(v ImageConfig) []string { return v.Cmd })
+	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
+}
+
+// The image's "ENTRYPOINT".
+func (o ImageConfigOutput) Entrypoint() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[ImageConfig](o, func(v ImageConfig) []string { return v.Entrypoint })
+	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
+}
+
+// Environment variables set by "ENV", as "KEY=value" pairs.
+func (o ImageConfigOutput) Env() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[ImageConfig](o, func(v ImageConfig) []string { return v.Env })
+	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
+}
+
+// Ports declared by `EXPOSE`, for example `8080/tcp`.
+func (o ImageConfigOutput) ExposedPorts() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[ImageConfig](o, func(v ImageConfig) []string { return v.ExposedPorts })
+	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
+}
+
+// The image's final labels, including any inherited from base images.
+func (o ImageConfigOutput) Labels() pulumix.MapOutput[string] {
+	value := pulumix.Apply[ImageConfig](o, func(v ImageConfig) map[string]string { return v.Labels })
+	return pulumix.MapOutput[string]{OutputState: value.OutputState}
+}
+
+// The image's "USER".
+func (o ImageConfigOutput) User() pulumix.Output[*string] {
+	return pulumix.Apply[ImageConfig](o, func(v ImageConfig) *string { return v.User })
+}
+
+// The image's "WORKDIR".
+func (o ImageConfigOutput) WorkingDir() pulumix.Output[*string] {
+	return pulumix.Apply[ImageConfig](o, func(v ImageConfig) *string { return v.WorkingDir })
+}
+
+type Layer struct {
+	// The layer's content digest.
+	Digest string `pulumi:"digest"`
+	// The layer's media type, for example "application/vnd.oci.image.layer.v1.tar+gzip".
+	MediaType string `pulumi:"mediaType"`
+	// The layer's compressed size in bytes.
+	Size int `pulumi:"size"`
+}
+
+type LayerOutput struct{ *pulumi.OutputState }
+
+func (LayerOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Layer)(nil)).Elem()
+}
+
+func (o LayerOutput) ToLayerOutput() LayerOutput {
+	return o
+}
+
+func (o LayerOutput) ToLayerOutputWithContext(ctx context.Context) LayerOutput {
+	return o
+}
+
+func (o LayerOutput) ToOutput(ctx context.Context) pulumix.Output[Layer] {
+	return pulumix.Output[Layer]{
+		OutputState: o.OutputState,
+	}
+}
+
+// The layer's content digest.
+func (o LayerOutput) Digest() pulumix.Output[string] {
+	return pulumix.Apply[Layer](o, func(v Layer) string { return v.Digest })
+}
+
+// The layer's media type, for example "application/vnd.oci.image.layer.v1.tar+gzip".
+func (o LayerOutput) MediaType() pulumix.Output[string] {
+	return pulumix.Apply[Layer](o, func(v Layer) string { return v.MediaType })
+}
+
+// The layer's compressed size in bytes.
+func (o LayerOutput) Size() pulumix.Output[int] {
+	return pulumix.Apply[Layer](o, func(v Layer) int { return v.Size })
+}
+
+type Lint struct {
+	// Report lint violations as errors instead of warnings.
+	//
+	// Defaults to the Dockerfile's `# check=error=...` directive.
+	Error *bool `pulumi:"error"`
+	// Names of lint rules to skip, like `StageNameCasing`, or `all` to
+	// disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+	// directive are also skipped.
+	Skip []string `pulumi:"skip"`
+}
+
+type LintArgs struct {
+	// Report lint violations as errors instead of warnings.
+	//
+	// Defaults to the Dockerfile's `# check=error=...` directive.
+	Error pulumix.Input[*bool] `pulumi:"error"`
+	// Names of lint rules to skip, like `StageNameCasing`, or `all` to
+	// disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+	// directive are also skipped.
+	Skip pulumix.Input[[]string] `pulumi:"skip"`
+}
+
+func (LintArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Lint)(nil)).Elem()
+}
+
+func (i LintArgs) ToLintOutput() LintOutput {
+	return i.ToLintOutputWithContext(context.Background())
+}
+
+func (i LintArgs) ToLintOutputWithContext(ctx context.Context) LintOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LintOutput)
+}
+
+func (i *LintArgs) ToOutput(ctx context.Context) pulumix.Output[*LintArgs] {
+	return pulumix.Val(i)
+}
+
+type LintOutput struct{ *pulumi.OutputState }
+
+func (LintOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Lint)(nil)).Elem()
+}
+
+func (o LintOutput) ToLintOutput() LintOutput {
+	return o
+}
+
+func (o LintOutput) ToLintOutputWithContext(ctx context.Context) LintOutput {
+	return o
+}
+
+func (o LintOutput) ToOutput(ctx context.Context) pulumix.Output[Lint] {
+	return pulumix.Output[Lint]{
+		OutputState: o.OutputState,
+	}
+}
+
+// Report lint violations as errors instead of warnings.
+//
+// Defaults to the Dockerfile's `# check=error=...` directive.
+func (o LintOutput) Error() pulumix.Output[*bool] {
+	return pulumix.Apply[Lint](o, func(v Lint) *bool { return v.Error })
+}
+
+// Names of lint rules to skip, like `StageNameCasing`, or `all` to
+// disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+// directive are also skipped.
+func (o LintOutput) Skip() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[Lint](o, func(v Lint) []string { return v.Skip })
+	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
+}
+
+type ProvenanceAttestation struct {
+	// An explicit builder ID to record in the provenance, for example the
+	// URL of the CI job performing the build.
+	BuilderId *string `pulumi:"builderId"`
+	// When `true` no provenance will be generated. Defaults to `false`.
+	Disabled *bool `pulumi:"disabled"`
+	// Only attach provenance to exporters which embed it in the image
+	// (e.g. `image` and `registry`). Defaults to `false`.
+	InlineOnly *bool `pulumi:"inlineOnly"`
+	// The level of detail to include in the provenance. Defaults to `min`.
+	Mode *ProvenanceMode `pulumi:"mode"`
+}
+
+// Defaults sets the appropriate defaults for ProvenanceAttestation
+func (val *ProvenanceAttestation) Defaults() *ProvenanceAttestation {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Mode == nil {
+		mode_ := ProvenanceMode("min")
+		tmp.Mode = &mode_
+	}
+	return &tmp
+}
+
+type ProvenanceAttestationArgs struct {
+	// An explicit builder ID to record in the provenance, for example the
+	// URL of the CI job performing the build.
+	BuilderId pulumix.Input[*string] `pulumi:"builderId"`
+	// When `true` no provenance will be generated. Defaults to `false`.
+	Disabled pulumix.Input[*bool] `pulumi:"disabled"`
+	// Only attach provenance to exporters which embed it in the image
+	// (e.g. `image` and `registry`). Defaults to `false`.
+	InlineOnly pulumix.Input[*bool] `pulumi:"inlineOnly"`
+	// The level of detail to include in the provenance. Defaults to `min`.
+	Mode pulumix.Input[*ProvenanceMode] `pulumi:"mode"`
+}
+
+// Defaults sets the appropriate defaults for ProvenanceAttestationArgs
+func (val *ProvenanceAttestationArgs) Defaults() *ProvenanceAttestationArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Mode == nil {
+		tmp.Mode = pulumix.Ptr(ProvenanceMode("min"))
+	}
+	return &tmp
+}
+func (ProvenanceAttestationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProvenanceAttestation)(nil)).Elem()
+}
+
+func (i ProvenanceAttestationArgs) ToProvenanceAttestationOutput() ProvenanceAttestationOutput {
+	return i.ToProvenanceAttestationOutputWithContext(context.Background())
+}
+
+func (i ProvenanceAttestationArgs) ToProvenanceAttestationOutputWithContext(ctx context.Context) ProvenanceAttestationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProvenanceAttestationOutput)
+}
+
+func (i *ProvenanceAttestationArgs) ToOutput(ctx context.Context) pulumix.Output[*ProvenanceAttestationArgs] {
+	return pulumix.Val(i)
+}
+
+type ProvenanceAttestationOutput struct{ *pulumi.OutputState }
+
+func (ProvenanceAttestationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProvenanceAttestation)(nil)).Elem()
+}
+
+func (o ProvenanceAttestationOutput) ToProvenanceAttestationOutput() ProvenanceAttestationOutput {
+	return o
+}
+
+func (o ProvenanceAttestationOutput) ToProvenanceAttestationOutputWithContext(ctx context.Context) ProvenanceAttestationOutput {
+	return o
+}
+
+func (o ProvenanceAttestationOutput) ToOutput(ctx context.Context) pulumix.Output[ProvenanceAttestation] {
+	return pulumix.Output[ProvenanceAttestation]{
+		OutputState: o.OutputState,
+	}
+}
+
+// An explicit builder ID to record in the provenance, for example the
+// URL of the CI job performing the build.
+func (o ProvenanceAttestationOutput) BuilderId() pulumix.Output[*string] {
+	return pulumix.Apply[ProvenanceAttestation](o, func(v ProvenanceAttestation) *string { return v.BuilderId })
+}
+
+// When `true` no provenance will be generated. Defaults to `false`.
+func (o ProvenanceAttestationOutput) Disabled() pulumix.Output[*bool] {
+	return pulumix.Apply[ProvenanceAttestation](o, func(v ProvenanceAttestation) *bool { return v.Disabled })
+}
+
+// Only attach provenance to exporters which embed it in the image
+// (e.g. `image` and `registry`). Defaults to `false`.
+func (o ProvenanceAttestationOutput) InlineOnly() pulumix.Output[*bool] {
+	return pulumix.Apply[ProvenanceAttestation](o, func(v ProvenanceAttestation) *bool { return v.InlineOnly })
+}
+
+// The level of detail to include in the provenance. Defaults to `min`.
+func (o ProvenanceAttestationOutput) Mode() pulumix.Output[*ProvenanceMode] {
+	return pulumix.Apply[ProvenanceAttestation](o, func(v ProvenanceAttestation) *ProvenanceMode { return v.Mode })
+}
+
 type Registry struct {
 	// The registry's address (e.g. "docker.io").
 	Address string `pulumi:"address"`
@@ -3173,6 +3848,102 @@ func (o RegistryOutput) Username() pulumix.Output[*string] {
 	return pulumix.Apply[Registry](o, func(v Registry) *string { return v.Username })
 }
 
+type SBOMAttestation struct {
+	// When `true` no SBOM will be generated. Defaults to `false`.
+	Disabled *bool `pulumi:"disabled"`
+	// The scanner image used to generate the SBOM.
+	//
+	// Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+	Generator *string `pulumi:"generator"`
+	// Also scan the build context, in addition to the final image.
+	//
+	// Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+	ScanContext *bool `pulumi:"scanContext"`
+	// Also scan intermediate build stages, in addition to the final image.
+	//
+	// Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+	ScanStage *bool `pulumi:"scanStage"`
+}
+
+type SBOMAttestationArgs struct {
+	// When `true` no SBOM will be generated. Defaults to `false`.
+	Disabled pulumix.Input[*bool] `pulumi:"disabled"`
+	// The scanner image used to generate the SBOM.
+	//
+	// Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+	Generator pulumix.Input[*string] `pulumi:"generator"`
+	// Also scan the build context, in addition to the final image.
+	//
+	// Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+	ScanContext pulumix.Input[*bool] `pulumi:"scanContext"`
+	// Also scan intermediate build stages, in addition to the final image.
+	//
+	// Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+	ScanStage pulumix.Input[*bool] `pulumi:"scanStage"`
+}
+
+func (SBOMAttestationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*SBOMAttestation)(nil)).Elem()
+}
+
+func (i SBOMAttestationArgs) ToSBOMAttestationOutput() SBOMAttestationOutput {
+	return i.ToSBOMAttestationOutputWithContext(context.Background())
+}
+
+func (i SBOMAttestationArgs) ToSBOMAttestationOutputWithContext(ctx context.Context) SBOMAttestationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SBOMAttestationOutput)
+}
+
+func (i *SBOMAttestationArgs) ToOutput(ctx context.Context) pulumix.Output[*SBOMAttestationArgs] {
+	return pulumix.Val(i)
+}
+
+type SBOMAttestationOutput struct{ *pulumi.OutputState }
+
+func (SBOMAttestationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SBOMAttestation)(nil)).Elem()
+}
+
+func (o SBOMAttestationOutput) ToSBOMAttestationOutput() SBOMAttestationOutput {
+	return o
+}
+
+func (o SBOMAttestationOutput) ToSBOMAttestationOutputWithContext(ctx context.Context) SBOMAttestationOutput {
+	return o
+}
+
+func (o SBOMAttestationOutput) ToOutput(ctx context.Context) pulumix.Output[SBOMAttestation] {
+	return pulumix.Output[SBOMAttestation]{
+		OutputState: o.OutputState,
+	}
+}
+
+// When `true` no SBOM will be generated. Defaults to `false`.
+func (o SBOMAttestationOutput) Disabled() pulumix.Output[*bool] {
+	return pulumix.Apply[SBOMAttestation](o, func(v SBOMAttestation) *bool { return v.Disabled })
+}
+
+// The scanner image used to generate the SBOM.
+//
+// Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+func (o SBOMAttestationOutput) Generator() pulumix.Output[*string] {
+	return pulumix.Apply[SBOMAttestation](o, func(v SBOMAttestation) *string { return v.Generator })
+}
+
+// Also scan the build context, in addition to the final image.
+//
+// Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+func (o SBOMAttestationOutput) ScanContext() pulumix.Output[*bool] {
+	return pulumix.Apply[SBOMAttestation](o, func(v SBOMAttestation) *bool { return v.ScanContext })
+}
+
+// Also scan intermediate build stages, in addition to the final image.
+//
+// Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+func (o SBOMAttestationOutput) ScanStage() pulumix.Output[*bool] {
+	return pulumix.Apply[SBOMAttestation](o, func(v SBOMAttestation) *bool { return v.ScanStage })
+}
+
 type SSH struct {
 	// Useful for distinguishing different servers that are part of the same
 	// build.
@@ -3264,8 +4035,82 @@ func (o SSHOutput) Paths() pulumix.ArrayOutput[string] {
 	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
 }
 
+type Ulimit struct {
+	// The hard limit. Defaults to the soft limit.
+	Hard *int `pulumi:"hard"`
+	// The name of the limit, for example `nofile` or `nproc`.
+	Name string `pulumi:"name"`
+	// The soft limit. Use `-1` for unlimited.
+	Soft int `pulumi:"soft"`
+}
+
+type UlimitArgs struct {
+	// The hard limit. Defaults to the soft limit.
+	Hard pulumix.Input[*int] `pulumi:"hard"`
+	// The name of the limit, for example `nofile` or `nproc`.
+	Name pulumix.Input[string] `pulumi:"name"`
+	// The soft limit. Use `-1` for unlimited.
+	Soft pulumix.Input[int] `pulumi:"soft"`
+}
+
+func (UlimitArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Ulimit)(nil)).Elem()
+}
+
+func (i UlimitArgs) ToUlimitOutput() UlimitOutput {
+	return i.ToUlimitOutputWithContext(context.Background())
+}
+
+func (i UlimitArgs) ToUlimitOutputWithContext(ctx context.Context) UlimitOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UlimitOutput)
+}
+
+func (i *UlimitArgs) ToOutput(ctx context.Context) pulumix.Output[*UlimitArgs] {
+	return pulumix.Val(i)
+}
+
+type UlimitOutput struct{ *pulumi.OutputState }
+
+func (UlimitOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Ulimit)(nil)).Elem()
+}
+
+func (o UlimitOutput) ToUlimitOutput() UlimitOutput {
+	return o
+}
+
+func (o UlimitOutput) ToUlimitOutputWithContext(ctx context.Context) UlimitOutput {
+	return o
+}
+
+func (o UlimitOutput) ToOutput(ctx context.Context) pulumix.Output[Ulimit] {
+	return pulumix.Output[Ulimit]{
+		OutputState: o.OutputState,
+	}
+}
+
+// The hard limit. Defaults to the soft limit.
+func (o UlimitOutput) Hard() pulumix.Output[*int] {
+	return pulumix.Apply[Ulimit](o, func(v Ulimit) *int { return v.Hard })
+}
+
+// The name of the limit, for example `nofile` or `nproc`.
+func (o UlimitOutput) Name() pulumix.Output[string] {
+	return pulumix.Apply[Ulimit](o, func(v Ulimit) string { return v.Name })
+}
+
+// The soft limit. Use `-1` for unlimited.
+func (o UlimitOutput) Soft() pulumix.Output[int] {
+	return pulumix.Apply[Ulimit](o, func(v Ulimit) int { return v.Soft })
+}
+
 func init() {
+	pulumi.RegisterOutputType(AttestationsOutput{})
+	pulumi.RegisterOutputType(BaseImageLockOutput{})
 	pulumi.RegisterOutputType(BuildContextOutput{})
+	pulumi.RegisterOutputType(BuildMetadataOutput{})
+	pulumi.RegisterOutputType(BuildStatsOutput{})
+	pulumi.RegisterOutputType(BuildStepOutput{})
 	pulumi.RegisterOutputType(BuilderConfigOutput{})
 	pulumi.RegisterOutputType(CacheFromOutput{})
 	pulumi.RegisterOutputType(CacheFromAzureBlobOutput{})
@@ -3290,6 +4135,12 @@ func init() {
 	pulumi.RegisterOutputType(ExportOCIOutput{})
 	pulumi.RegisterOutputType(ExportRegistryOutput{})
 	pulumi.RegisterOutputType(ExportTarOutput{})
+	pulumi.RegisterOutputType(ImageConfigOutput{})
+	pulumi.RegisterOutputType(LayerOutput{})
+	pulumi.RegisterOutputType(LintOutput{})
+	pulumi.RegisterOutputType(ProvenanceAttestationOutput{})
 	pulumi.RegisterOutputType(RegistryOutput{})
+	pulumi.RegisterOutputType(SBOMAttestationOutput{})
 	pulumi.RegisterOutputType(SSHOutput{})
+	pulumi.RegisterOutputType(UlimitOutput{})
 }
@@ -5,7 +5,9 @@ package com.pulumi.dockerbuild;
 
 import com.pulumi.core.TypeShape;
 import com.pulumi.core.internal.Codegen;
+import com.pulumi.dockerbuild.enums.ProgressMode;
 import com.pulumi.dockerbuild.inputs.Registry;
+import java.lang.Boolean;
 import java.lang.String;
 import java.util.List;
 import java.util.Optional;
@@ -13,6 +15,37 @@ import java.util.Optional;
 public final class Config {
 
     private static final com.pulumi.Config config = com.pulumi.Config.of("docker-build");
+/**
+ * Glob patterns, like `docker.io/library/*`, that every image&#39;s `FROM`
+ * instructions must match. This applies in addition to each image&#39;s own
+ * `allowedBaseImages`.
+ * 
+ */
+    public Optional<List<String>> allowedBaseImages() {
+        return Codegen.objectProp("allowedBaseImages", TypeShape.<List<String>>builder(List.class).addParameter(String.class).build()).config(config).get();
+    }
+/**
+ * A directory to write the full log of each image build to, for images
+ * which don&#39;t specify their own `buildLogPath`.
+ * 
+ * Logs are named after the image&#39;s first tag, or the resource&#39;s name if
+ * it has no tags.
+ * 
+ */
+    public Optional<String> buildLogDir() {
+        return Codegen.stringProp("buildLogDir").config(config).get();
+    }
+/**
+ * A directory to cache build context hashes in, so files which haven&#39;t
+ * changed aren&#39;t read again on later runs.
+ * 
+ * Files are considered unchanged if their size, modification time and
+ * inode are the same. The resulting `contextHash` is unaffected.
+ * 
+ */
+    public Optional<String> contextHashCacheDir() {
+        return Codegen.stringProp("contextHashCacheDir").config(config).get();
+    }
 /**
  * The build daemon&#39;s address.
  * 
@@ -20,7 +53,43 @@ public final class Config {
     public Optional<String> host() {
         return Codegen.stringProp("host").config(config).env("DOCKER_HOST").def("").get();
     }
+/**
+ * An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+ * of resource operations and builds to. gRPC endpoints aren&#39;t supported.
+ * 
+ * Spans join the trace in the `TRACEPARENT` environment variable, if set,
+ * and trace context is propagated to the build daemon.
+ * 
+ */
+    public Optional<String> otlpEndpoint() {
+        return Codegen.stringProp("otlpEndpoint").config(config).env("PULUMI_DOCKER_BUILD_OTLP_ENDPOINT").def("").get();
+    }
+/**
+ * How to report build progress for images which don&#39;t specify their own
+ * `progress`. Defaults to `plain`.
+ * 
+ */
+    public Optional<ProgressMode> progress() {
+        return Codegen.objectProp("progress", ProgressMode.class).config(config).get();
+    }
     public Optional<List<Registry>> registries() {
         return Codegen.objectProp("registries", TypeShape.<List<Registry>>builder(List.class).addParameter(Registry.class).build()).config(config).get();
     }
+/**
+ * Require external `FROM` and `COPY --from` images to be pinned with an
+ * `{@literal @}sha256:` digest, for images which don&#39;t specify their own
+ * `requirePinnedBaseImages`.
+ * 
+ */
+    public Optional<Boolean> requirePinnedBaseImages() {
+        return Codegen.booleanProp("requirePinnedBaseImages").config(config).get();
+    }
+/**
+ * Fail image builds if BuildKit reports any warnings, for images which
+ * don&#39;t specify their own `warningsAsErrors`.
+ * 
+ */
+    public Optional<Boolean> warningsAsErrors() {
+        return Codegen.booleanProp("warningsAsErrors").config(config).get();
+    }
 }
@@ -9,16 +9,27 @@ import com.pulumi.core.annotations.ResourceType;
 import com.pulumi.core.internal.Codegen;
 import com.pulumi.dockerbuild.ImageArgs;
 import com.pulumi.dockerbuild.Utilities;
+import com.pulumi.dockerbuild.enums.Entitlement;
 import com.pulumi.dockerbuild.enums.NetworkMode;
 import com.pulumi.dockerbuild.enums.Platform;
+import com.pulumi.dockerbuild.enums.ProgressMode;
+import com.pulumi.dockerbuild.outputs.Attestations;
+import com.pulumi.dockerbuild.outputs.BaseImageLock;
 import com.pulumi.dockerbuild.outputs.BuildContext;
+import com.pulumi.dockerbuild.outputs.BuildMetadata;
+import com.pulumi.dockerbuild.outputs.BuildStats;
 import com.pulumi.dockerbuild.outputs.BuilderConfig;
 import com.pulumi.dockerbuild.outputs.CacheFrom;
 import com.pulumi.dockerbuild.outputs.CacheTo;
 import com.pulumi.dockerbuild.outputs.Dockerfile;
+import com.pulumi.dockerbuild.outputs.ImageConfig;
+import com.pulumi.dockerbuild.outputs.Layer;
+import com.pulumi.dockerbuild.outputs.Lint;
 import com.pulumi.dockerbuild.outputs.Registry;
 import com.pulumi.dockerbuild.outputs.SSH;
+import com.pulumi.dockerbuild.outputs.Ulimit;
 import java.lang.Boolean;
+import java.lang.Integer;
 import java.lang.String;
 import java.util.List;
 import java.util.Map;
@@ -657,6 +668,174 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<Optional<List<String>>> addHosts() {
         return Codegen.optional(this.addHosts);
     }
+    /**
+     * Extra privileges to grant to the build.
+     * 
+     * The builder must also be configured to permit these entitlements,
+     * for example with `--allow-insecure-entitlement`.
+     * 
+     * Equivalent to Docker&#39;s `--allow` flag.
+     * 
+     */
+    @Export(name="allow", refs={List.class,Entitlement.class}, tree="[0,1]")
+    private Output</* @Nullable */ List<Entitlement>> allow;
+
+    /**
+     * @return Extra privileges to grant to the build.
+     * 
+     * The builder must also be configured to permit these entitlements,
+     * for example with `--allow-insecure-entitlement`.
+     * 
+     * Equivalent to Docker&#39;s `--allow` flag.
+     * 
+     */
+    public Output<Optional<List<Entitlement>>> allow() {
+        return Codegen.optional(this.allow);
+    }
+    /**
+     * Glob patterns, like `docker.io/library/*` or
+     * `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+     * are matched against fully-qualified references such as
+     * `docker.io/library/alpine:latest` and their repository names, and `*`
+     * doesn&#39;t match `/`.
+     * 
+     * ARGs are substituted from `buildArgs`. References to other stages and
+     * to `named` contexts are not restricted.
+     * 
+     * Images must also match the provider&#39;s `allowedBaseImages`, if set.
+     * 
+     */
+    @Export(name="allowedBaseImages", refs={List.class,String.class}, tree="[0,1]")
+    private Output</* @Nullable */ List<String>> allowedBaseImages;
+
+    /**
+     * @return Glob patterns, like `docker.io/library/*` or
+     * `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+     * are matched against fully-qualified references such as
+     * `docker.io/library/alpine:latest` and their repository names, and `*`
+     * doesn&#39;t match `/`.
+     * 
+     * ARGs are substituted from `buildArgs`. References to other stages and
+     * to `named` contexts are not restricted.
+     * 
+     * Images must also match the provider&#39;s `allowedBaseImages`, if set.
+     * 
+     */
+    public Output<Optional<List<String>>> allowedBaseImages() {
+        return Codegen.optional(this.allowedBaseImages);
+    }
+    /**
+     * Attach arbitrary key/value annotations to the image&#39;s manifests.
+     * 
+     * Keys may be prefixed with the level to annotate -- `manifest`,
+     * `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+     * qualified with a platform, for example
+     * `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+     * levels can be separated by commas. Defaults to `manifest`.
+     * 
+     * Annotations are applied to every `image`, `registry`, `oci` and
+     * `docker` export. Annotations set directly on an export take
+     * precedence.
+     * 
+     * Equivalent to Docker&#39;s `--annotation` flag.
+     * 
+     */
+    @Export(name="annotations", refs={Map.class,String.class}, tree="[0,1,1]")
+    private Output</* @Nullable */ Map<String,String>> annotations;
+
+    /**
+     * @return Attach arbitrary key/value annotations to the image&#39;s manifests.
+     * 
+     * Keys may be prefixed with the level to annotate -- `manifest`,
+     * `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+     * qualified with a platform, for example
+     * `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+     * levels can be separated by commas. Defaults to `manifest`.
+     * 
+     * Annotations are applied to every `image`, `registry`, `oci` and
+     * `docker` export. Annotations set directly on an export take
+     * precedence.
+     * 
+     * Equivalent to Docker&#39;s `--annotation` flag.
+     * 
+     */
+    public Output<Optional<Map<String,String>>> annotations() {
+        return Codegen.optional(this.annotations);
+    }
+    /**
+     * Attestations to attach to the image, such as an SBOM or SLSA
+     * provenance.
+     * 
+     * Attestations are only exported with `image`, `registry` or `oci`
+     * exports, and require a builder which supports them (the legacy
+     * `docker` driver does not).
+     * 
+     * Equivalent to Docker&#39;s `--attest` flag.
+     * 
+     */
+    @Export(name="attestations", refs={Attestations.class}, tree="[0]")
+    private Output</* @Nullable */ Attestations> attestations;
+
+    /**
+     * @return Attestations to attach to the image, such as an SBOM or SLSA
+     * provenance.
+     * 
+     * Attestations are only exported with `image`, `registry` or `oci`
+     * exports, and require a builder which supports them (the legacy
+     * `docker` driver does not).
+     * 
+     * Equivalent to Docker&#39;s `--attest` flag.
+     * 
+     */
+    public Output<Optional<Attestations>> attestations() {
+        return Codegen.optional(this.attestations);
+    }
+    /**
+     * The digest each external `FROM` image resolved to when the image was
+     * built, keyed by its fully-qualified reference.
+     * 
+     * Only recorded for images with `pull`, which are rebuilt when any of
+     * these digests has moved.
+     * 
+     */
+    @Export(name="baseImageDigests", refs={Map.class,String.class}, tree="[0,1,1]")
+    private Output</* @Nullable */ Map<String,String>> baseImageDigests;
+
+    /**
+     * @return The digest each external `FROM` image resolved to when the image was
+     * built, keyed by its fully-qualified reference.
+     * 
+     * Only recorded for images with `pull`, which are rebuilt when any of
+     * these digests has moved.
+     * 
+     */
+    public Output<Optional<Map<String,String>>> baseImageDigests() {
+        return Codegen.optional(this.baseImageDigests);
+    }
+    /**
+     * Pin every external `FROM` and `COPY --from` image to the digest it
+     * resolved to on the first build.
+     * 
+     * Later builds substitute `docker-image://&lt;ref&gt;{@literal @}&lt;digest&gt;` named
+     * contexts for those images, so they don&#39;t change until the lock&#39;s
+     * `refresh` value does.
+     * 
+     */
+    @Export(name="baseImageLock", refs={BaseImageLock.class}, tree="[0]")
+    private Output</* @Nullable */ BaseImageLock> baseImageLock;
+
+    /**
+     * @return Pin every external `FROM` and `COPY --from` image to the digest it
+     * resolved to on the first build.
+     * 
+     * Later builds substitute `docker-image://&lt;ref&gt;{@literal @}&lt;digest&gt;` named
+     * contexts for those images, so they don&#39;t change until the lock&#39;s
+     * `refresh` value does.
+     * 
+     */
+    public Output<Optional<BaseImageLock>> baseImageLock() {
+        return Codegen.optional(this.baseImageLock);
+    }
     /**
      * `ARG` names and values to set during the build.
      * 
@@ -687,6 +866,44 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<Optional<Map<String,String>>> buildArgs() {
         return Codegen.optional(this.buildArgs);
     }
+    /**
+     * A file to write the build&#39;s full log to, including anything written to
+     * stderr. The log is written whether or not the build succeeds, which is
+     * useful for archiving logs from CI.
+     * 
+     * Defaults to a file in the provider&#39;s `buildLogDir`, if set.
+     * 
+     */
+    @Export(name="buildLogPath", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> buildLogPath;
+
+    /**
+     * @return A file to write the build&#39;s full log to, including anything written to
+     * stderr. The log is written whether or not the build succeeds, which is
+     * useful for archiving logs from CI.
+     * 
+     * Defaults to a file in the provider&#39;s `buildLogDir`, if set.
+     * 
+     */
+    public Output<Optional<String>> buildLogPath() {
+        return Codegen.optional(this.buildLogPath);
+    }
+    /**
+     * Metadata returned by buildkit after the build, useful for tracing an
+     * image back to its buildx history.
+     * 
+     */
+    @Export(name="buildMetadata", refs={BuildMetadata.class}, tree="[0]")
+    private Output</* @Nullable */ BuildMetadata> buildMetadata;
+
+    /**
+     * @return Metadata returned by buildkit after the build, useful for tracing an
+     * image back to its buildx history.
+     * 
+     */
+    public Output<Optional<BuildMetadata>> buildMetadata() {
+        return Codegen.optional(this.buildMetadata);
+    }
     /**
      * Setting this to `false` will always skip image builds during previews,
      * and setting it to `true` will always build images during previews.
@@ -721,6 +938,72 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<Optional<Boolean>> buildOnPreview() {
         return Codegen.optional(this.buildOnPreview);
     }
+    /**
+     * A directory to export the build&#39;s history record to after it succeeds
+     * or fails, as a `.dockerbuild` bundle. The bundle&#39;s path is reported by
+     * `buildRecordPath`.
+     * 
+     * Equivalent to running `docker buildx history export` after the build.
+     * Records of failed builds are not available when `exec` is `true`.
+     * 
+     */
+    @Export(name="buildRecordDir", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> buildRecordDir;
+
+    /**
+     * @return A directory to export the build&#39;s history record to after it succeeds
+     * or fails, as a `.dockerbuild` bundle. The bundle&#39;s path is reported by
+     * `buildRecordPath`.
+     * 
+     * Equivalent to running `docker buildx history export` after the build.
+     * Records of failed builds are not available when `exec` is `true`.
+     * 
+     */
+    public Output<Optional<String>> buildRecordDir() {
+        return Codegen.optional(this.buildRecordDir);
+    }
+    /**
+     * The path of the most recent build&#39;s exported history record, if
+     * `buildRecordDir` is set.
+     * 
+     * The record can be opened with Docker Desktop&#39;s Builds view or imported
+     * with `docker buildx history import`.
+     * 
+     */
+    @Export(name="buildRecordPath", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> buildRecordPath;
+
+    /**
+     * @return The path of the most recent build&#39;s exported history record, if
+     * `buildRecordDir` is set.
+     * 
+     * The record can be opened with Docker Desktop&#39;s Builds view or imported
+     * with `docker buildx history import`.
+     * 
+     */
+    public Output<Optional<String>> buildRecordPath() {
+        return Codegen.optional(this.buildRecordPath);
+    }
+    /**
+     * Statistics about the most recent build, like its duration and how many
+     * steps were cached.
+     * 
+     * Not available when `exec` is `true`.
+     * 
+     */
+    @Export(name="buildStats", refs={BuildStats.class}, tree="[0]")
+    private Output</* @Nullable */ BuildStats> buildStats;
+
+    /**
+     * @return Statistics about the most recent build, like its duration and how many
+     * steps were cached.
+     * 
+     * Not available when `exec` is `true`.
+     * 
+     */
+    public Output<Optional<BuildStats>> buildStats() {
+        return Codegen.optional(this.buildStats);
+    }
     /**
      * Builder configuration.
      * 
@@ -771,6 +1054,66 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<Optional<List<CacheTo>>> cacheTo() {
         return Codegen.optional(this.cacheTo);
     }
+    /**
+     * Set the parent cgroup for `RUN` instructions.
+     * 
+     * Equivalent to Docker&#39;s `--cgroup-parent` flag.
+     * 
+     */
+    @Export(name="cgroupParent", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> cgroupParent;
+
+    /**
+     * @return Set the parent cgroup for `RUN` instructions.
+     * 
+     * Equivalent to Docker&#39;s `--cgroup-parent` flag.
+     * 
+     */
+    public Output<Optional<String>> cgroupParent() {
+        return Codegen.optional(this.cgroupParent);
+    }
+    /**
+     * The total compressed size in bytes of each platform&#39;s pushed layers,
+     * keyed by platform in the same way as `platformDigests`.
+     * 
+     * Empty if the image was not pushed to a registry.
+     * 
+     */
+    @Export(name="compressedSize", refs={Map.class,String.class,Integer.class}, tree="[0,1,2]")
+    private Output</* @Nullable */ Map<String,Integer>> compressedSize;
+
+    /**
+     * @return The total compressed size in bytes of each platform&#39;s pushed layers,
+     * keyed by platform in the same way as `platformDigests`.
+     * 
+     * Empty if the image was not pushed to a registry.
+     * 
+     */
+    public Output<Optional<Map<String,Integer>>> compressedSize() {
+        return Codegen.optional(this.compressedSize);
+    }
+    /**
+     * Runtime configuration recorded in each platform&#39;s pushed image, such
+     * as its entrypoint, environment and exposed ports. Keyed by platform
+     * in the same way as `platformDigests`.
+     * 
+     * Empty if the image was not pushed to a registry.
+     * 
+     */
+    @Export(name="config", refs={Map.class,String.class,ImageConfig.class}, tree="[0,1,2]")
+    private Output</* @Nullable */ Map<String,ImageConfig>> config;
+
+    /**
+     * @return Runtime configuration recorded in each platform&#39;s pushed image, such
+     * as its entrypoint, environment and exposed ports. Keyed by platform
+     * in the same way as `platformDigests`.
+     * 
+     * Empty if the image was not pushed to a registry.
+     * 
+     */
+    public Output<Optional<Map<String,ImageConfig>>> config() {
+        return Codegen.optional(this.config);
+    }
     /**
      * Build context settings. Defaults to the current directory.
      * 
@@ -807,6 +1150,26 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<String> contextHash() {
         return this.contextHash;
     }
+    /**
+     * A compressed record of each file contributing to `contextHash`, used
+     * to explain which files changed when the image needs to be re-built.
+     * 
+     * Empty for contexts with more than 5000 files.
+     * 
+     */
+    @Export(name="contextManifest", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> contextManifest;
+
+    /**
+     * @return A compressed record of each file contributing to `contextHash`, used
+     * to explain which files changed when the image needs to be re-built.
+     * 
+     * Empty for contexts with more than 5000 files.
+     * 
+     */
+    public Output<Optional<String>> contextManifest() {
+        return Codegen.optional(this.contextManifest);
+    }
     /**
      * A SHA256 digest of the image if it was exported to a registry or
      * elsewhere.
@@ -957,6 +1320,24 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<Optional<List<String>>> ignoreSecretsInDiffCalculation() {
         return Codegen.optional(this.ignoreSecretsInDiffCalculation);
     }
+    /**
+     * Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+     * warnings shouldn&#39;t fail the build when `warningsAsErrors` is enabled.
+     * These warnings are still logged.
+     * 
+     */
+    @Export(name="ignoreWarnings", refs={List.class,String.class}, tree="[0,1]")
+    private Output</* @Nullable */ List<String>> ignoreWarnings;
+
+    /**
+     * @return Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+     * warnings shouldn&#39;t fail the build when `warningsAsErrors` is enabled.
+     * These warnings are still logged.
+     * 
+     */
+    public Output<Optional<List<String>>> ignoreWarnings() {
+        return Codegen.optional(this.ignoreWarnings);
+    }
     /**
      * Attach arbitrary key/value metadata to the image.
      * 
@@ -975,6 +1356,50 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<Optional<Map<String,String>>> labels() {
         return Codegen.optional(this.labels);
     }
+    /**
+     * The layers of each platform&#39;s pushed image, keyed by platform in the
+     * same way as `platformDigests`.
+     * 
+     * Empty if the image was not pushed to a registry.
+     * 
+     */
+    @Export(name="layers", refs={Map.class,String.class,List.class,Layer.class}, tree="[0,1,[2,3]]")
+    private Output</* @Nullable */ Map<String,List<Layer>>> layers;
+
+    /**
+     * @return The layers of each platform&#39;s pushed image, keyed by platform in the
+     * same way as `platformDigests`.
+     * 
+     * Empty if the image was not pushed to a registry.
+     * 
+     */
+    public Output<Optional<Map<String,List<Layer>>>> layers() {
+        return Codegen.optional(this.layers);
+    }
+    /**
+     * Run BuildKit&#39;s Dockerfile lint rules, like `StageNameCasing` or
+     * `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+     * building. Violations are reported as warnings unless configured to be
+     * errors.
+     * 
+     * Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+     * 
+     */
+    @Export(name="lint", refs={Lint.class}, tree="[0]")
+    private Output</* @Nullable */ Lint> lint;
+
+    /**
+     * @return Run BuildKit&#39;s Dockerfile lint rules, like `StageNameCasing` or
+     * `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+     * building. Violations are reported as warnings unless configured to be
+     * errors.
+     * 
+     * Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+     * 
+     */
+    public Output<Optional<Lint>> lint() {
+        return Codegen.optional(this.lint);
+    }
     /**
      * When `true` the build will automatically include a `docker` export.
      * 
@@ -997,11 +1422,30 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<Optional<Boolean>> load() {
         return Codegen.optional(this.load);
     }
+    /**
+     * The digests each base image is pinned to by `baseImageLock`, keyed by
+     * its fully-qualified reference.
+     * 
+     */
+    @Export(name="lockedBaseImages", refs={Map.class,String.class}, tree="[0,1,1]")
+    private Output</* @Nullable */ Map<String,String>> lockedBaseImages;
+
+    /**
+     * @return The digests each base image is pinned to by `baseImageLock`, keyed by
+     * its fully-qualified reference.
+     * 
+     */
+    public Output<Optional<Map<String,String>>> lockedBaseImages() {
+        return Codegen.optional(this.lockedBaseImages);
+    }
     /**
      * Set the network mode for `RUN` instructions. Defaults to `default`.
      * 
      * For custom networks, configure your builder with `--driver-opt network=...`.
      * 
+     * The `host` network mode requires the `network.host` entitlement to be
+     * included in `allow`.
+     * 
      * Equivalent to Docker&#39;s `--network` flag.
      * 
      */
@@ -1013,6 +1457,9 @@ public class Image extends com.pulumi.resources.CustomResource {
      * 
      * For custom networks, configure your builder with `--driver-opt network=...`.
      * 
+     * The `host` network mode requires the `network.host` entitlement to be
+     * included in `allow`.
+     * 
      * Equivalent to Docker&#39;s `--network` flag.
      * 
      */
@@ -1037,6 +1484,36 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<Optional<Boolean>> noCache() {
         return Codegen.optional(this.noCache);
     }
+    /**
+     * The digest of each platform-specific manifest pushed to a registry,
+     * keyed by platform (for example `linux/arm64`).
+     * 
+     * This is useful when a downstream consumer requires a single-platform
+     * image. Attestation manifests are not included. Single-platform images
+     * are keyed by the requested platform, or by the platform recorded in
+     * their config if `platforms` wasn&#39;t specified.
+     * 
+     * Empty if the image was not pushed to a registry.
+     * 
+     */
+    @Export(name="platformDigests", refs={Map.class,String.class}, tree="[0,1,1]")
+    private Output</* @Nullable */ Map<String,String>> platformDigests;
+
+    /**
+     * @return The digest of each platform-specific manifest pushed to a registry,
+     * keyed by platform (for example `linux/arm64`).
+     * 
+     * This is useful when a downstream consumer requires a single-platform
+     * image. Attestation manifests are not included. Single-platform images
+     * are keyed by the requested platform, or by the platform recorded in
+     * their config if `platforms` wasn&#39;t specified.
+     * 
+     * Empty if the image was not pushed to a registry.
+     * 
+     */
+    public Output<Optional<Map<String,String>>> platformDigests() {
+        return Codegen.optional(this.platformDigests);
+    }
     /**
      * Set target platform(s) for the build. Defaults to the host&#39;s platform.
      * 
@@ -1055,9 +1532,32 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<Optional<List<Platform>>> platforms() {
         return Codegen.optional(this.platforms);
     }
+    /**
+     * How to report build progress. Defaults to the provider&#39;s `progress`
+     * setting.
+     * 
+     * Equivalent to Docker&#39;s `--progress` flag.
+     * 
+     */
+    @Export(name="progress", refs={ProgressMode.class}, tree="[0]")
+    private Output</* @Nullable */ ProgressMode> progress;
+
+    /**
+     * @return How to report build progress. Defaults to the provider&#39;s `progress`
+     * setting.
+     * 
+     * Equivalent to Docker&#39;s `--progress` flag.
+     * 
+     */
+    public Output<Optional<ProgressMode>> progress() {
+        return Codegen.optional(this.progress);
+    }
     /**
      * Always pull referenced images.
      * 
+     * Images with `pull` are only rebuilt when one of their
+     * `baseImageDigests` has moved, or always if those aren&#39;t known.
+     * 
      * Equivalent to Docker&#39;s `--pull` flag.
      * 
      */
@@ -1067,6 +1567,9 @@ public class Image extends com.pulumi.resources.CustomResource {
     /**
      * @return Always pull referenced images.
      * 
+     * Images with `pull` are only rebuilt when one of their
+     * `baseImageDigests` has moved, or always if those aren&#39;t known.
+     * 
      * Equivalent to Docker&#39;s `--pull` flag.
      * 
      */
@@ -1161,6 +1664,30 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<Optional<List<Registry>>> registries() {
         return Codegen.optional(this.registries);
     }
+    /**
+     * Require every external `FROM` and `COPY --from` image to be pinned
+     * with an `{@literal @}sha256:` digest, after substituting ARGs from `buildArgs`.
+     * Failures suggest the image&#39;s current digest when it can be looked up
+     * with the configured registry credentials.
+     * 
+     * Defaults to the provider&#39;s `requirePinnedBaseImages` setting.
+     * 
+     */
+    @Export(name="requirePinnedBaseImages", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> requirePinnedBaseImages;
+
+    /**
+     * @return Require every external `FROM` and `COPY --from` image to be pinned
+     * with an `{@literal @}sha256:` digest, after substituting ARGs from `buildArgs`.
+     * Failures suggest the image&#39;s current digest when it can be looked up
+     * with the configured registry credentials.
+     * 
+     * Defaults to the provider&#39;s `requirePinnedBaseImages` setting.
+     * 
+     */
+    public Output<Optional<Boolean>> requirePinnedBaseImages() {
+        return Codegen.optional(this.requirePinnedBaseImages);
+    }
     /**
      * A mapping of secret names to their corresponding values.
      * 
@@ -1191,6 +1718,24 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<Optional<Map<String,String>>> secrets() {
         return Codegen.optional(this.secrets);
     }
+    /**
+     * Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+     * 
+     * Equivalent to Docker&#39;s `--shm-size` flag.
+     * 
+     */
+    @Export(name="shmSize", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> shmSize;
+
+    /**
+     * @return Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+     * 
+     * Equivalent to Docker&#39;s `--shm-size` flag.
+     * 
+     */
+    public Output<Optional<String>> shmSize() {
+        return Codegen.optional(this.shmSize);
+    }
     /**
      * SSH agent socket or keys to expose to the build.
      * 
@@ -1255,6 +1800,46 @@ public class Image extends com.pulumi.resources.CustomResource {
     public Output<Optional<String>> target() {
         return Codegen.optional(this.target);
     }
+    /**
+     * Resource limits for `RUN` instructions.
+     * 
+     * Equivalent to Docker&#39;s `--ulimit` flag.
+     * 
+     */
+    @Export(name="ulimits", refs={List.class,Ulimit.class}, tree="[0,1]")
+    private Output</* @Nullable */ List<Ulimit>> ulimits;
+
+    /**
+     * @return Resource limits for `RUN` instructions.
+     * 
+     * Equivalent to Docker&#39;s `--ulimit` flag.
+     * 
+     */
+    public Output<Optional<List<Ulimit>>> ulimits() {
+        return Codegen.optional(this.ulimits);
+    }
+    /**
+     * Fail the build if BuildKit reports any warnings, like Dockerfile lint
+     * rule violations or deprecated syntax. Warnings from rules listed in
+     * `ignoreWarnings` are exempt.
+     * 
+     * Defaults to the provider&#39;s `warningsAsErrors` setting.
+     * 
+     */
+    @Export(name="warningsAsErrors", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> warningsAsErrors;
+
+    /**
+     * @return Fail the build if BuildKit reports any warnings, like Dockerfile lint
+     * rule violations or deprecated syntax. Warnings from rules listed in
+     * `ignoreWarnings` are exempt.
+     * 
+     * Defaults to the provider&#39;s `warningsAsErrors` setting.
+     * 
+     */
+    public Output<Optional<Boolean>> warningsAsErrors() {
+        return Codegen.optional(this.warningsAsErrors);
+    }
 
     /**
      *
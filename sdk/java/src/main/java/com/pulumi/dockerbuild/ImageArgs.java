@@ -6,16 +6,22 @@ package com.pulumi.dockerbuild;
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Import;
 import com.pulumi.core.internal.Codegen;
+import com.pulumi.dockerbuild.enums.Entitlement;
 import com.pulumi.dockerbuild.enums.NetworkMode;
 import com.pulumi.dockerbuild.enums.Platform;
+import com.pulumi.dockerbuild.enums.ProgressMode;
+import com.pulumi.dockerbuild.inputs.AttestationsArgs;
+import com.pulumi.dockerbuild.inputs.BaseImageLockArgs;
 import com.pulumi.dockerbuild.inputs.BuildContextArgs;
 import com.pulumi.dockerbuild.inputs.BuilderConfigArgs;
 import com.pulumi.dockerbuild.inputs.CacheFromArgs;
 import com.pulumi.dockerbuild.inputs.CacheToArgs;
 import com.pulumi.dockerbuild.inputs.DockerfileArgs;
 import com.pulumi.dockerbuild.inputs.ExportArgs;
+import com.pulumi.dockerbuild.inputs.LintArgs;
 import com.pulumi.dockerbuild.inputs.RegistryArgs;
 import com.pulumi.dockerbuild.inputs.SSHArgs;
+import com.pulumi.dockerbuild.inputs.UlimitArgs;
 import com.pulumi.exceptions.MissingRequiredPropertyException;
 import java.lang.Boolean;
 import java.lang.String;
@@ -49,6 +55,157 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.addHosts);
     }
 
+    /**
+     * Extra privileges to grant to the build.
+     * 
+     * The builder must also be configured to permit these entitlements,
+     * for example with `--allow-insecure-entitlement`.
+     * 
+     * Equivalent to Docker&#39;s `--allow` flag.
+     * 
+     */
+    @Import(name="allow")
+    private @Nullable Output<List<Entitlement>> allow;
+
+    /**
+     * @return Extra privileges to grant to the build.
+     * 
+     * The builder must also be configured to permit these entitlements,
+     * for example with `--allow-insecure-entitlement`.
+     * 
+     * Equivalent to Docker&#39;s `--allow` flag.
+     * 
+     */
+    public Optional<Output<List<Entitlement>>> allow() {
+        return Optional.ofNullable(this.allow);
+    }
+
+    /**
+     * Glob patterns, like `docker.io/library/*` or
+     * `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+     * are matched against fully-qualified references such as
+     * `docker.io/library/alpine:latest` and their repository names, and `*`
+     * doesn&#39;t match `/`.
+     * 
+     * ARGs are substituted from `buildArgs`. References to other stages and
+     * to `named` contexts are not restricted.
+     * 
+     * Images must also match the provider&#39;s `allowedBaseImages`, if set.
+     * 
+     */
+    @Import(name="allowedBaseImages")
+    private @Nullable Output<List<String>> allowedBaseImages;
+
+    /**
+     * @return Glob patterns, like `docker.io/library/*` or
+     * `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+     * are matched against fully-qualified references such as
+     * `docker.io/library/alpine:latest` and their repository names, and `*`
+     * doesn&#39;t match `/`.
+     * 
+     * ARGs are substituted from `buildArgs`. References to other stages and
+     * to `named` contexts are not restricted.
+     * 
+     * Images must also match the provider&#39;s `allowedBaseImages`, if set.
+     * 
+     */
+    public Optional<Output<List<String>>> allowedBaseImages() {
+        return Optional.ofNullable(this.allowedBaseImages);
+    }
+
+    /**
+     * Attach arbitrary key/value annotations to the image&#39;s manifests.
+     * 
+     * Keys may be prefixed with the level to annotate -- `manifest`,
+     * `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+     * qualified with a platform, for example
+     * `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+     * levels can be separated by commas. Defaults to `manifest`.
+     * 
+     * Annotations are applied to every `image`, `registry`, `oci` and
+     * `docker` export. Annotations set directly on an export take
+     * precedence.
+     * 
+     * Equivalent to Docker&#39;s `--annotation` flag.
+     * 
+     */
+    @Import(name="annotations")
+    private @Nullable Output<Map<String,String>> annotations;
+
+    /**
+     * @return Attach arbitrary key/value annotations to the image&#39;s manifests.
+     * 
+     * Keys may be prefixed with the level to annotate -- `manifest`,
+     * `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+     * qualified with a platform, for example
+     * `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+     * levels can be separated by commas. Defaults to `manifest`.
+     * 
+     * Annotations are applied to every `image`, `registry`, `oci` and
+     * `docker` export. Annotations set directly on an export take
+     * precedence.
+     * 
+     * Equivalent to Docker&#39;s `--annotation` flag.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> annotations() {
+        return Optional.ofNullable(this.annotations);
+    }
+
+    /**
+     * Attestations to attach to the image, such as an SBOM or SLSA
+     * provenance.
+     * 
+     * Attestations are only exported with `image`, `registry` or `oci`
+     * exports, and require a builder which supports them (the legacy
+     * `docker` driver does not).
+     * 
+     * Equivalent to Docker&#39;s `--attest` flag.
+     * 
+     */
+    @Import(name="attestations")
+    private @Nullable Output<AttestationsArgs> attestations;
+
+    /**
+     * @return Attestations to attach to the image, such as an SBOM or SLSA
+     * provenance.
+     * 
+     * Attestations are only exported with `image`, `registry` or `oci`
+     * exports, and require a builder which supports them (the legacy
+     * `docker` driver does not).
+     * 
+     * Equivalent to Docker&#39;s `--attest` flag.
+     * 
+     */
+    public Optional<Output<AttestationsArgs>> attestations() {
+        return Optional.ofNullable(this.attestations);
+    }
+
+    /**
+     * Pin every external `FROM` and `COPY --from` image to the digest it
+     * resolved to on the first build.
+     * 
+     * Later builds substitute `docker-image://&lt;ref&gt;{@literal @}&lt;digest&gt;` named
+     * contexts for those images, so they don&#39;t change until the lock&#39;s
+     * `refresh` value does.
+     * 
+     */
+    @Import(name="baseImageLock")
+    private @Nullable Output<BaseImageLockArgs> baseImageLock;
+
+    /**
+     * @return Pin every external `FROM` and `COPY --from` image to the digest it
+     * resolved to on the first build.
+     * 
+     * Later builds substitute `docker-image://&lt;ref&gt;{@literal @}&lt;digest&gt;` named
+     * contexts for those images, so they don&#39;t change until the lock&#39;s
+     * `refresh` value does.
+     * 
+     */
+    public Optional<Output<BaseImageLockArgs>> baseImageLock() {
+        return Optional.ofNullable(this.baseImageLock);
+    }
+
     /**
      * `ARG` names and values to set during the build.
      * 
@@ -80,6 +237,29 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.buildArgs);
     }
 
+    /**
+     * A file to write the build&#39;s full log to, including anything written to
+     * stderr. The log is written whether or not the build succeeds, which is
+     * useful for archiving logs from CI.
+     * 
+     * Defaults to a file in the provider&#39;s `buildLogDir`, if set.
+     * 
+     */
+    @Import(name="buildLogPath")
+    private @Nullable Output<String> buildLogPath;
+
+    /**
+     * @return A file to write the build&#39;s full log to, including anything written to
+     * stderr. The log is written whether or not the build succeeds, which is
+     * useful for archiving logs from CI.
+     * 
+     * Defaults to a file in the provider&#39;s `buildLogDir`, if set.
+     * 
+     */
+    public Optional<Output<String>> buildLogPath() {
+        return Optional.ofNullable(this.buildLogPath);
+    }
+
     /**
      * Setting this to `false` will always skip image builds during previews,
      * and setting it to `true` will always build images during previews.
@@ -115,6 +295,31 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.buildOnPreview);
     }
 
+    /**
+     * A directory to export the build&#39;s history record to after it succeeds
+     * or fails, as a `.dockerbuild` bundle. The bundle&#39;s path is reported by
+     * `buildRecordPath`.
+     * 
+     * Equivalent to running `docker buildx history export` after the build.
+     * Records of failed builds are not available when `exec` is `true`.
+     * 
+     */
+    @Import(name="buildRecordDir")
+    private @Nullable Output<String> buildRecordDir;
+
+    /**
+     * @return A directory to export the build&#39;s history record to after it succeeds
+     * or fails, as a `.dockerbuild` bundle. The bundle&#39;s path is reported by
+     * `buildRecordPath`.
+     * 
+     * Equivalent to running `docker buildx history export` after the build.
+     * Records of failed builds are not available when `exec` is `true`.
+     * 
+     */
+    public Optional<Output<String>> buildRecordDir() {
+        return Optional.ofNullable(this.buildRecordDir);
+    }
+
     /**
      * Builder configuration.
      * 
@@ -168,6 +373,25 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.cacheTo);
     }
 
+    /**
+     * Set the parent cgroup for `RUN` instructions.
+     * 
+     * Equivalent to Docker&#39;s `--cgroup-parent` flag.
+     * 
+     */
+    @Import(name="cgroupParent")
+    private @Nullable Output<String> cgroupParent;
+
+    /**
+     * @return Set the parent cgroup for `RUN` instructions.
+     * 
+     * Equivalent to Docker&#39;s `--cgroup-parent` flag.
+     * 
+     */
+    public Optional<Output<String>> cgroupParent() {
+        return Optional.ofNullable(this.cgroupParent);
+    }
+
     /**
      * Build context settings. Defaults to the current directory.
      * 
@@ -315,6 +539,25 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.ignoreSecretsInDiffCalculation);
     }
 
+    /**
+     * Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+     * warnings shouldn&#39;t fail the build when `warningsAsErrors` is enabled.
+     * These warnings are still logged.
+     * 
+     */
+    @Import(name="ignoreWarnings")
+    private @Nullable Output<List<String>> ignoreWarnings;
+
+    /**
+     * @return Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+     * warnings shouldn&#39;t fail the build when `warningsAsErrors` is enabled.
+     * These warnings are still logged.
+     * 
+     */
+    public Optional<Output<List<String>>> ignoreWarnings() {
+        return Optional.ofNullable(this.ignoreWarnings);
+    }
+
     /**
      * Attach arbitrary key/value metadata to the image.
      * 
@@ -334,6 +577,31 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.labels);
     }
 
+    /**
+     * Run BuildKit&#39;s Dockerfile lint rules, like `StageNameCasing` or
+     * `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+     * building. Violations are reported as warnings unless configured to be
+     * errors.
+     * 
+     * Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+     * 
+     */
+    @Import(name="lint")
+    private @Nullable Output<LintArgs> lint;
+
+    /**
+     * @return Run BuildKit&#39;s Dockerfile lint rules, like `StageNameCasing` or
+     * `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+     * building. Violations are reported as warnings unless configured to be
+     * errors.
+     * 
+     * Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+     * 
+     */
+    public Optional<Output<LintArgs>> lint() {
+        return Optional.ofNullable(this.lint);
+    }
+
     /**
      * When `true` the build will automatically include a `docker` export.
      * 
@@ -362,6 +630,9 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
      * 
      * For custom networks, configure your builder with `--driver-opt network=...`.
      * 
+     * The `host` network mode requires the `network.host` entitlement to be
+     * included in `allow`.
+     * 
      * Equivalent to Docker&#39;s `--network` flag.
      * 
      */
@@ -373,6 +644,9 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
      * 
      * For custom networks, configure your builder with `--driver-opt network=...`.
      * 
+     * The `host` network mode requires the `network.host` entitlement to be
+     * included in `allow`.
+     * 
      * Equivalent to Docker&#39;s `--network` flag.
      * 
      */
@@ -418,9 +692,33 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.platforms);
     }
 
+    /**
+     * How to report build progress. Defaults to the provider&#39;s `progress`
+     * setting.
+     * 
+     * Equivalent to Docker&#39;s `--progress` flag.
+     * 
+     */
+    @Import(name="progress")
+    private @Nullable Output<ProgressMode> progress;
+
+    /**
+     * @return How to report build progress. Defaults to the provider&#39;s `progress`
+     * setting.
+     * 
+     * Equivalent to Docker&#39;s `--progress` flag.
+     * 
+     */
+    public Optional<Output<ProgressMode>> progress() {
+        return Optional.ofNullable(this.progress);
+    }
+
     /**
      * Always pull referenced images.
      * 
+     * Images with `pull` are only rebuilt when one of their
+     * `baseImageDigests` has moved, or always if those aren&#39;t known.
+     * 
      * Equivalent to Docker&#39;s `--pull` flag.
      * 
      */
@@ -430,6 +728,9 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
     /**
      * @return Always pull referenced images.
      * 
+     * Images with `pull` are only rebuilt when one of their
+     * `baseImageDigests` has moved, or always if those aren&#39;t known.
+     * 
      * Equivalent to Docker&#39;s `--pull` flag.
      * 
      */
@@ -487,6 +788,31 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.registries);
     }
 
+    /**
+     * Require every external `FROM` and `COPY --from` image to be pinned
+     * with an `{@literal @}sha256:` digest, after substituting ARGs from `buildArgs`.
+     * Failures suggest the image&#39;s current digest when it can be looked up
+     * with the configured registry credentials.
+     * 
+     * Defaults to the provider&#39;s `requirePinnedBaseImages` setting.
+     * 
+     */
+    @Import(name="requirePinnedBaseImages")
+    private @Nullable Output<Boolean> requirePinnedBaseImages;
+
+    /**
+     * @return Require every external `FROM` and `COPY --from` image to be pinned
+     * with an `{@literal @}sha256:` digest, after substituting ARGs from `buildArgs`.
+     * Failures suggest the image&#39;s current digest when it can be looked up
+     * with the configured registry credentials.
+     * 
+     * Defaults to the provider&#39;s `requirePinnedBaseImages` setting.
+     * 
+     */
+    public Optional<Output<Boolean>> requirePinnedBaseImages() {
+        return Optional.ofNullable(this.requirePinnedBaseImages);
+    }
+
     /**
      * A mapping of secret names to their corresponding values.
      * 
@@ -518,6 +844,25 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.secrets);
     }
 
+    /**
+     * Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+     * 
+     * Equivalent to Docker&#39;s `--shm-size` flag.
+     * 
+     */
+    @Import(name="shmSize")
+    private @Nullable Output<String> shmSize;
+
+    /**
+     * @return Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+     * 
+     * Equivalent to Docker&#39;s `--shm-size` flag.
+     * 
+     */
+    public Optional<Output<String>> shmSize() {
+        return Optional.ofNullable(this.shmSize);
+    }
+
     /**
      * SSH agent socket or keys to expose to the build.
      * 
@@ -585,32 +930,89 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.target);
     }
 
+    /**
+     * Resource limits for `RUN` instructions.
+     * 
+     * Equivalent to Docker&#39;s `--ulimit` flag.
+     * 
+     */
+    @Import(name="ulimits")
+    private @Nullable Output<List<UlimitArgs>> ulimits;
+
+    /**
+     * @return Resource limits for `RUN` instructions.
+     * 
+     * Equivalent to Docker&#39;s `--ulimit` flag.
+     * 
+     */
+    public Optional<Output<List<UlimitArgs>>> ulimits() {
+        return Optional.ofNullable(this.ulimits);
+    }
+
+    /**
+     * Fail the build if BuildKit reports any warnings, like Dockerfile lint
+     * rule violations or deprecated syntax. Warnings from rules listed in
+     * `ignoreWarnings` are exempt.
+     * 
+     * Defaults to the provider&#39;s `warningsAsErrors` setting.
+     * 
+     */
+    @Import(name="warningsAsErrors")
+    private @Nullable Output<Boolean> warningsAsErrors;
+
+    /**
+     * @return Fail the build if BuildKit reports any warnings, like Dockerfile lint
+     * rule violations or deprecated syntax. Warnings from rules listed in
+     * `ignoreWarnings` are exempt.
+     * 
+     * Defaults to the provider&#39;s `warningsAsErrors` setting.
+     * 
+     */
+    public Optional<Output<Boolean>> warningsAsErrors() {
+        return Optional.ofNullable(this.warningsAsErrors);
+    }
+
     private ImageArgs() {}
 
     private ImageArgs(ImageArgs $) {
         this.addHosts = $.addHosts;
+        this.allow = $.allow;
+        this.allowedBaseImages = $.allowedBaseImages;
+        this.annotations = $.annotations;
+        this.attestations = $.attestations;
+        this.baseImageLock = $.baseImageLock;
         this.buildArgs = $.buildArgs;
+        this.buildLogPath = $.buildLogPath;
         this.buildOnPreview = $.buildOnPreview;
+        this.buildRecordDir = $.buildRecordDir;
         this.builder = $.builder;
         this.cacheFrom = $.cacheFrom;
         this.cacheTo = $.cacheTo;
+        this.cgroupParent = $.cgroupParent;
         this.context = $.context;
         this.dockerfile = $.dockerfile;
         this.exec = $.exec;
         this.exports = $.exports;
         this.ignoreSecretsInDiffCalculation = $.ignoreSecretsInDiffCalculation;
+        this.ignoreWarnings = $.ignoreWarnings;
         this.labels = $.labels;
+        this.lint = $.lint;
         this.load = $.load;
         this.network = $.network;
         this.noCache = $.noCache;
         this.platforms = $.platforms;
+        this.progress = $.progress;
         this.pull = $.pull;
         this.push = $.push;
         this.registries = $.registries;
+        this.requirePinnedBaseImages = $.requirePinnedBaseImages;
         this.secrets = $.secrets;
+        this.shmSize = $.shmSize;
         this.ssh = $.ssh;
         this.tags = $.tags;
         this.target = $.target;
+        this.ulimits = $.ulimits;
+        this.warningsAsErrors = $.warningsAsErrors;
     }
 
     public static Builder builder() {
@@ -668,6 +1070,221 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
             return addHosts(List.of(addHosts));
         }
 
+        /**
+         * @param allow Extra privileges to grant to the build.
+         * 
+         * The builder must also be configured to permit these entitlements,
+         * for example with `--allow-insecure-entitlement`.
+         * 
+         * Equivalent to Docker&#39;s `--allow` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder allow(@Nullable Output<List<Entitlement>> allow) {
+            $.allow = allow;
+            return this;
+        }
+
+        /**
+         * @param allow Extra privileges to grant to the build.
+         * 
+         * The builder must also be configured to permit these entitlements,
+         * for example with `--allow-insecure-entitlement`.
+         * 
+         * Equivalent to Docker&#39;s `--allow` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder allow(List<Entitlement> allow) {
+            return allow(Output.of(allow));
+        }
+
+        /**
+         * @param allow Extra privileges to grant to the build.
+         * 
+         * The builder must also be configured to permit these entitlements,
+         * for example with `--allow-insecure-entitlement`.
+         * 
+         * Equivalent to Docker&#39;s `--allow` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder allow(Entitlement... allow) {
+            return allow(List.of(allow));
+        }
+
+        /**
+         * @param allowedBaseImages Glob patterns, like `docker.io/library/*` or
+         * `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+         * are matched against fully-qualified references such as
+         * `docker.io/library/alpine:latest` and their repository names, and `*`
+         * doesn&#39;t match `/`.
+         * 
+         * ARGs are substituted from `buildArgs`. References to other stages and
+         * to `named` contexts are not restricted.
+         * 
+         * Images must also match the provider&#39;s `allowedBaseImages`, if set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder allowedBaseImages(@Nullable Output<List<String>> allowedBaseImages) {
+            $.allowedBaseImages = allowedBaseImages;
+            return this;
+        }
+
+        /**
+         * @param allowedBaseImages Glob patterns, like `docker.io/library/*` or
+         * `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+         * are matched against fully-qualified references such as
+         * `docker.io/library/alpine:latest` and their repository names, and `*`
+         * doesn&#39;t match `/`.
+         * 
+         * ARGs are substituted from `buildArgs`. References to other stages and
+         * to `named` contexts are not restricted.
+         * 
+         * Images must also match the provider&#39;s `allowedBaseImages`, if set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder allowedBaseImages(List<String> allowedBaseImages) {
+            return allowedBaseImages(Output.of(allowedBaseImages));
+        }
+
+        /**
+         * @param allowedBaseImages Glob patterns, like `docker.io/library/*` or
+         * `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+         * are matched against fully-qualified references such as
+         * `docker.io/library/alpine:latest` and their repository names, and `*`
+         * doesn&#39;t match `/`.
+         * 
+         * ARGs are substituted from `buildArgs`. References to other stages and
+         * to `named` contexts are not restricted.
+         * 
+         * Images must also match the provider&#39;s `allowedBaseImages`, if set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder allowedBaseImages(String... allowedBaseImages) {
+            return allowedBaseImages(List.of(allowedBaseImages));
+        }
+
+        /**
+         * @param annotations Attach arbitrary key/value annotations to the image&#39;s manifests.
+         * 
+         * Keys may be prefixed with the level to annotate -- `manifest`,
+         * `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+         * qualified with a platform, for example
+         * `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+         * levels can be separated by commas. Defaults to `manifest`.
+         * 
+         * Annotations are applied to every `image`, `registry`, `oci` and
+         * `docker` export. Annotations set directly on an export take
+         * precedence.
+         * 
+         * Equivalent to Docker&#39;s `--annotation` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder annotations(@Nullable Output<Map<String,String>> annotations) {
+            $.annotations = annotations;
+            return this;
+        }
+
+        /**
+         * @param annotations Attach arbitrary key/value annotations to the image&#39;s manifests.
+         * 
+         * Keys may be prefixed with the level to annotate -- `manifest`,
+         * `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+         * qualified with a platform, for example
+         * `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+         * levels can be separated by commas. Defaults to `manifest`.
+         * 
+         * Annotations are applied to every `image`, `registry`, `oci` and
+         * `docker` export. Annotations set directly on an export take
+         * precedence.
+         * 
+         * Equivalent to Docker&#39;s `--annotation` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder annotations(Map<String,String> annotations) {
+            return annotations(Output.of(annotations));
+        }
+
+        /**
+         * @param attestations Attestations to attach to the image, such as an SBOM or SLSA
+         * provenance.
+         * 
+         * Attestations are only exported with `image`, `registry` or `oci`
+         * exports, and require a builder which supports them (the legacy
+         * `docker` driver does not).
+         * 
+         * Equivalent to Docker&#39;s `--attest` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder attestations(@Nullable Output<AttestationsArgs> attestations) {
+            $.attestations = attestations;
+            return this;
+        }
+
+        /**
+         * @param attestations Attestations to attach to the image, such as an SBOM or SLSA
+         * provenance.
+         * 
+         * Attestations are only exported with `image`, `registry` or `oci`
+         * exports, and require a builder which supports them (the legacy
+         * `docker` driver does not).
+         * 
+         * Equivalent to Docker&#39;s `--attest` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder attestations(AttestationsArgs attestations) {
+            return attestations(Output.of(attestations));
+        }
+
+        /**
+         * @param baseImageLock Pin every external `FROM` and `COPY --from` image to the digest it
+         * resolved to on the first build.
+         * 
+         * Later builds substitute `docker-image://&lt;ref&gt;{@literal @}&lt;digest&gt;` named
+         * contexts for those images, so they don&#39;t change until the lock&#39;s
+         * `refresh` value does.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder baseImageLock(@Nullable Output<BaseImageLockArgs> baseImageLock) {
+            $.baseImageLock = baseImageLock;
+            return this;
+        }
+
+        /**
+         * @param baseImageLock Pin every external `FROM` and `COPY --from` image to the digest it
+         * resolved to on the first build.
+         * 
+         * Later builds substitute `docker-image://&lt;ref&gt;{@literal @}&lt;digest&gt;` named
+         * contexts for those images, so they don&#39;t change until the lock&#39;s
+         * `refresh` value does.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder baseImageLock(BaseImageLockArgs baseImageLock) {
+            return baseImageLock(Output.of(baseImageLock));
+        }
+
         /**
          * @param buildArgs `ARG` names and values to set during the build.
          * 
@@ -705,6 +1322,35 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
             return buildArgs(Output.of(buildArgs));
         }
 
+        /**
+         * @param buildLogPath A file to write the build&#39;s full log to, including anything written to
+         * stderr. The log is written whether or not the build succeeds, which is
+         * useful for archiving logs from CI.
+         * 
+         * Defaults to a file in the provider&#39;s `buildLogDir`, if set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder buildLogPath(@Nullable Output<String> buildLogPath) {
+            $.buildLogPath = buildLogPath;
+            return this;
+        }
+
+        /**
+         * @param buildLogPath A file to write the build&#39;s full log to, including anything written to
+         * stderr. The log is written whether or not the build succeeds, which is
+         * useful for archiving logs from CI.
+         * 
+         * Defaults to a file in the provider&#39;s `buildLogDir`, if set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder buildLogPath(String buildLogPath) {
+            return buildLogPath(Output.of(buildLogPath));
+        }
+
         /**
          * @param buildOnPreview Setting this to `false` will always skip image builds during previews,
          * and setting it to `true` will always build images during previews.
@@ -746,6 +1392,37 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
             return buildOnPreview(Output.of(buildOnPreview));
         }
 
+        /**
+         * @param buildRecordDir A directory to export the build&#39;s history record to after it succeeds
+         * or fails, as a `.dockerbuild` bundle. The bundle&#39;s path is reported by
+         * `buildRecordPath`.
+         * 
+         * Equivalent to running `docker buildx history export` after the build.
+         * Records of failed builds are not available when `exec` is `true`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder buildRecordDir(@Nullable Output<String> buildRecordDir) {
+            $.buildRecordDir = buildRecordDir;
+            return this;
+        }
+
+        /**
+         * @param buildRecordDir A directory to export the build&#39;s history record to after it succeeds
+         * or fails, as a `.dockerbuild` bundle. The bundle&#39;s path is reported by
+         * `buildRecordPath`.
+         * 
+         * Equivalent to running `docker buildx history export` after the build.
+         * Records of failed builds are not available when `exec` is `true`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder buildRecordDir(String buildRecordDir) {
+            return buildRecordDir(Output.of(buildRecordDir));
+        }
+
         /**
          * @param builder Builder configuration.
          * 
@@ -841,6 +1518,31 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
             return cacheTo(List.of(cacheTo));
         }
 
+        /**
+         * @param cgroupParent Set the parent cgroup for `RUN` instructions.
+         * 
+         * Equivalent to Docker&#39;s `--cgroup-parent` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cgroupParent(@Nullable Output<String> cgroupParent) {
+            $.cgroupParent = cgroupParent;
+            return this;
+        }
+
+        /**
+         * @param cgroupParent Set the parent cgroup for `RUN` instructions.
+         * 
+         * Equivalent to Docker&#39;s `--cgroup-parent` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cgroupParent(String cgroupParent) {
+            return cgroupParent(Output.of(cgroupParent));
+        }
+
         /**
          * @param context Build context settings. Defaults to the current directory.
          * 
@@ -1051,6 +1753,43 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
             return ignoreSecretsInDiffCalculation(List.of(ignoreSecretsInDiffCalculation));
         }
 
+        /**
+         * @param ignoreWarnings Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+         * warnings shouldn&#39;t fail the build when `warningsAsErrors` is enabled.
+         * These warnings are still logged.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder ignoreWarnings(@Nullable Output<List<String>> ignoreWarnings) {
+            $.ignoreWarnings = ignoreWarnings;
+            return this;
+        }
+
+        /**
+         * @param ignoreWarnings Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+         * warnings shouldn&#39;t fail the build when `warningsAsErrors` is enabled.
+         * These warnings are still logged.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder ignoreWarnings(List<String> ignoreWarnings) {
+            return ignoreWarnings(Output.of(ignoreWarnings));
+        }
+
+        /**
+         * @param ignoreWarnings Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+         * warnings shouldn&#39;t fail the build when `warningsAsErrors` is enabled.
+         * These warnings are still logged.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder ignoreWarnings(String... ignoreWarnings) {
+            return ignoreWarnings(List.of(ignoreWarnings));
+        }
+
         /**
          * @param labels Attach arbitrary key/value metadata to the image.
          * 
@@ -1076,6 +1815,37 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
             return labels(Output.of(labels));
         }
 
+        /**
+         * @param lint Run BuildKit&#39;s Dockerfile lint rules, like `StageNameCasing` or
+         * `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+         * building. Violations are reported as warnings unless configured to be
+         * errors.
+         * 
+         * Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder lint(@Nullable Output<LintArgs> lint) {
+            $.lint = lint;
+            return this;
+        }
+
+        /**
+         * @param lint Run BuildKit&#39;s Dockerfile lint rules, like `StageNameCasing` or
+         * `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+         * building. Violations are reported as warnings unless configured to be
+         * errors.
+         * 
+         * Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder lint(LintArgs lint) {
+            return lint(Output.of(lint));
+        }
+
         /**
          * @param load When `true` the build will automatically include a `docker` export.
          * 
@@ -1110,6 +1880,9 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
          * 
          * For custom networks, configure your builder with `--driver-opt network=...`.
          * 
+         * The `host` network mode requires the `network.host` entitlement to be
+         * included in `allow`.
+         * 
          * Equivalent to Docker&#39;s `--network` flag.
          * 
          * @return builder
@@ -1125,6 +1898,9 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
          * 
          * For custom networks, configure your builder with `--driver-opt network=...`.
          * 
+         * The `host` network mode requires the `network.host` entitlement to be
+         * included in `allow`.
+         * 
          * Equivalent to Docker&#39;s `--network` flag.
          * 
          * @return builder
@@ -1196,9 +1972,39 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
             return platforms(List.of(platforms));
         }
 
+        /**
+         * @param progress How to report build progress. Defaults to the provider&#39;s `progress`
+         * setting.
+         * 
+         * Equivalent to Docker&#39;s `--progress` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder progress(@Nullable Output<ProgressMode> progress) {
+            $.progress = progress;
+            return this;
+        }
+
+        /**
+         * @param progress How to report build progress. Defaults to the provider&#39;s `progress`
+         * setting.
+         * 
+         * Equivalent to Docker&#39;s `--progress` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder progress(ProgressMode progress) {
+            return progress(Output.of(progress));
+        }
+
         /**
          * @param pull Always pull referenced images.
          * 
+         * Images with `pull` are only rebuilt when one of their
+         * `baseImageDigests` has moved, or always if those aren&#39;t known.
+         * 
          * Equivalent to Docker&#39;s `--pull` flag.
          * 
          * @return builder
@@ -1212,6 +2018,9 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
         /**
          * @param pull Always pull referenced images.
          * 
+         * Images with `pull` are only rebuilt when one of their
+         * `baseImageDigests` has moved, or always if those aren&#39;t known.
+         * 
          * Equivalent to Docker&#39;s `--pull` flag.
          * 
          * @return builder
@@ -1299,6 +2108,37 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
             return registries(List.of(registries));
         }
 
+        /**
+         * @param requirePinnedBaseImages Require every external `FROM` and `COPY --from` image to be pinned
+         * with an `{@literal @}sha256:` digest, after substituting ARGs from `buildArgs`.
+         * Failures suggest the image&#39;s current digest when it can be looked up
+         * with the configured registry credentials.
+         * 
+         * Defaults to the provider&#39;s `requirePinnedBaseImages` setting.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder requirePinnedBaseImages(@Nullable Output<Boolean> requirePinnedBaseImages) {
+            $.requirePinnedBaseImages = requirePinnedBaseImages;
+            return this;
+        }
+
+        /**
+         * @param requirePinnedBaseImages Require every external `FROM` and `COPY --from` image to be pinned
+         * with an `{@literal @}sha256:` digest, after substituting ARGs from `buildArgs`.
+         * Failures suggest the image&#39;s current digest when it can be looked up
+         * with the configured registry credentials.
+         * 
+         * Defaults to the provider&#39;s `requirePinnedBaseImages` setting.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder requirePinnedBaseImages(Boolean requirePinnedBaseImages) {
+            return requirePinnedBaseImages(Output.of(requirePinnedBaseImages));
+        }
+
         /**
          * @param secrets A mapping of secret names to their corresponding values.
          * 
@@ -1336,6 +2176,31 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
             return secrets(Output.of(secrets));
         }
 
+        /**
+         * @param shmSize Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+         * 
+         * Equivalent to Docker&#39;s `--shm-size` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder shmSize(@Nullable Output<String> shmSize) {
+            $.shmSize = shmSize;
+            return this;
+        }
+
+        /**
+         * @param shmSize Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+         * 
+         * Equivalent to Docker&#39;s `--shm-size` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder shmSize(String shmSize) {
+            return shmSize(Output.of(shmSize));
+        }
+
         /**
          * @param ssh SSH agent socket or keys to expose to the build.
          * 
@@ -1448,6 +2313,72 @@ public final class ImageArgs extends com.pulumi.resources.ResourceArgs {
             return target(Output.of(target));
         }
 
+        /**
+         * @param ulimits Resource limits for `RUN` instructions.
+         * 
+         * Equivalent to Docker&#39;s `--ulimit` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder ulimits(@Nullable Output<List<UlimitArgs>> ulimits) {
+            $.ulimits = ulimits;
+            return this;
+        }
+
+        /**
+         * @param ulimits Resource limits for `RUN` instructions.
+         * 
+         * Equivalent to Docker&#39;s `--ulimit` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder ulimits(List<UlimitArgs> ulimits) {
+            return ulimits(Output.of(ulimits));
+        }
+
+        /**
+         * @param ulimits Resource limits for `RUN` instructions.
+         * 
+         * Equivalent to Docker&#39;s `--ulimit` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder ulimits(UlimitArgs... ulimits) {
+            return ulimits(List.of(ulimits));
+        }
+
+        /**
+         * @param warningsAsErrors Fail the build if BuildKit reports any warnings, like Dockerfile lint
+         * rule violations or deprecated syntax. Warnings from rules listed in
+         * `ignoreWarnings` are exempt.
+         * 
+         * Defaults to the provider&#39;s `warningsAsErrors` setting.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder warningsAsErrors(@Nullable Output<Boolean> warningsAsErrors) {
+            $.warningsAsErrors = warningsAsErrors;
+            return this;
+        }
+
+        /**
+         * @param warningsAsErrors Fail the build if BuildKit reports any warnings, like Dockerfile lint
+         * rule violations or deprecated syntax. Warnings from rules listed in
+         * `ignoreWarnings` are exempt.
+         * 
+         * Defaults to the provider&#39;s `warningsAsErrors` setting.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder warningsAsErrors(Boolean warningsAsErrors) {
+            return warningsAsErrors(Output.of(warningsAsErrors));
+        }
+
         public ImageArgs build() {
             $.buildOnPreview = Codegen.booleanProp("buildOnPreview").output().arg($.buildOnPreview).def(true).getNullable();
             $.network = Codegen.objectProp("network", NetworkMode.class).output().arg($.network).def(NetworkMode.Default_).getNullable();
@@ -9,12 +9,57 @@ import com.pulumi.core.annotations.ResourceType;
 import com.pulumi.core.internal.Codegen;
 import com.pulumi.dockerbuild.ProviderArgs;
 import com.pulumi.dockerbuild.Utilities;
+import com.pulumi.dockerbuild.enums.ProgressMode;
 import java.lang.String;
 import java.util.Optional;
 import javax.annotation.Nullable;
 
 @ResourceType(type="pulumi:providers:docker-build")
 public class Provider extends com.pulumi.resources.ProviderResource {
+    /**
+     * A directory to write the full log of each image build to, for images
+     * which don&#39;t specify their own `buildLogPath`.
+     * 
+     * Logs are named after the image&#39;s first tag, or the resource&#39;s name if
+     * it has no tags.
+     * 
+     */
+    @Export(name="buildLogDir", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> buildLogDir;
+
+    /**
+     * @return A directory to write the full log of each image build to, for images
+     * which don&#39;t specify their own `buildLogPath`.
+     * 
+     * Logs are named after the image&#39;s first tag, or the resource&#39;s name if
+     * it has no tags.
+     * 
+     */
+    public Output<Optional<String>> buildLogDir() {
+        return Codegen.optional(this.buildLogDir);
+    }
+    /**
+     * A directory to cache build context hashes in, so files which haven&#39;t
+     * changed aren&#39;t read again on later runs.
+     * 
+     * Files are considered unchanged if their size, modification time and
+     * inode are the same. The resulting `contextHash` is unaffected.
+     * 
+     */
+    @Export(name="contextHashCacheDir", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> contextHashCacheDir;
+
+    /**
+     * @return A directory to cache build context hashes in, so files which haven&#39;t
+     * changed aren&#39;t read again on later runs.
+     * 
+     * Files are considered unchanged if their size, modification time and
+     * inode are the same. The resulting `contextHash` is unaffected.
+     * 
+     */
+    public Output<Optional<String>> contextHashCacheDir() {
+        return Codegen.optional(this.contextHashCacheDir);
+    }
     /**
      * The build daemon&#39;s address.
      * 
@@ -29,6 +74,44 @@ public class Provider extends com.pulumi.resources.ProviderResource {
     public Output<Optional<String>> host() {
         return Codegen.optional(this.host);
     }
+    /**
+     * An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+     * of resource operations and builds to. gRPC endpoints aren&#39;t supported.
+     * 
+     * Spans join the trace in the `TRACEPARENT` environment variable, if set,
+     * and trace context is propagated to the build daemon.
+     * 
+     */
+    @Export(name="otlpEndpoint", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> otlpEndpoint;
+
+    /**
+     * @return An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+     * of resource operations and builds to. gRPC endpoints aren&#39;t supported.
+     * 
+     * Spans join the trace in the `TRACEPARENT` environment variable, if set,
+     * and trace context is propagated to the build daemon.
+     * 
+     */
+    public Output<Optional<String>> otlpEndpoint() {
+        return Codegen.optional(this.otlpEndpoint);
+    }
+    /**
+     * How to report build progress for images which don&#39;t specify their own
+     * `progress`. Defaults to `plain`.
+     * 
+     */
+    @Export(name="progress", refs={ProgressMode.class}, tree="[0]")
+    private Output</* @Nullable */ ProgressMode> progress;
+
+    /**
+     * @return How to report build progress for images which don&#39;t specify their own
+     * `progress`. Defaults to `plain`.
+     * 
+     */
+    public Output<Optional<ProgressMode>> progress() {
+        return Codegen.optional(this.progress);
+    }
 
     /**
      *
@@ -6,7 +6,9 @@ package com.pulumi.dockerbuild;
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Import;
 import com.pulumi.core.internal.Codegen;
+import com.pulumi.dockerbuild.enums.ProgressMode;
 import com.pulumi.dockerbuild.inputs.RegistryArgs;
+import java.lang.Boolean;
 import java.lang.String;
 import java.util.List;
 import java.util.Objects;
@@ -18,6 +20,71 @@ public final class ProviderArgs extends com.pulumi.resources.ResourceArgs {
 
     public static final ProviderArgs Empty = new ProviderArgs();
 
+    /**
+     * Glob patterns, like `docker.io/library/*`, that every image&#39;s `FROM`
+     * instructions must match. This applies in addition to each image&#39;s own
+     * `allowedBaseImages`.
+     * 
+     */
+    @Import(name="allowedBaseImages", json=true)
+    private @Nullable Output<List<String>> allowedBaseImages;
+
+    /**
+     * @return Glob patterns, like `docker.io/library/*`, that every image&#39;s `FROM`
+     * instructions must match. This applies in addition to each image&#39;s own
+     * `allowedBaseImages`.
+     * 
+     */
+    public Optional<Output<List<String>>> allowedBaseImages() {
+        return Optional.ofNullable(this.allowedBaseImages);
+    }
+
+    /**
+     * A directory to write the full log of each image build to, for images
+     * which don&#39;t specify their own `buildLogPath`.
+     * 
+     * Logs are named after the image&#39;s first tag, or the resource&#39;s name if
+     * it has no tags.
+     * 
+     */
+    @Import(name="buildLogDir")
+    private @Nullable Output<String> buildLogDir;
+
+    /**
+     * @return A directory to write the full log of each image build to, for images
+     * which don&#39;t specify their own `buildLogPath`.
+     * 
+     * Logs are named after the image&#39;s first tag, or the resource&#39;s name if
+     * it has no tags.
+     * 
+     */
+    public Optional<Output<String>> buildLogDir() {
+        return Optional.ofNullable(this.buildLogDir);
+    }
+
+    /**
+     * A directory to cache build context hashes in, so files which haven&#39;t
+     * changed aren&#39;t read again on later runs.
+     * 
+     * Files are considered unchanged if their size, modification time and
+     * inode are the same. The resulting `contextHash` is unaffected.
+     * 
+     */
+    @Import(name="contextHashCacheDir")
+    private @Nullable Output<String> contextHashCacheDir;
+
+    /**
+     * @return A directory to cache build context hashes in, so files which haven&#39;t
+     * changed aren&#39;t read again on later runs.
+     * 
+     * Files are considered unchanged if their size, modification time and
+     * inode are the same. The resulting `contextHash` is unaffected.
+     * 
+     */
+    public Optional<Output<String>> contextHashCacheDir() {
+        return Optional.ofNullable(this.contextHashCacheDir);
+    }
+
     /**
      * The build daemon&#39;s address.
      * 
@@ -33,6 +100,46 @@ public final class ProviderArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.host);
     }
 
+    /**
+     * An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+     * of resource operations and builds to. gRPC endpoints aren&#39;t supported.
+     * 
+     * Spans join the trace in the `TRACEPARENT` environment variable, if set,
+     * and trace context is propagated to the build daemon.
+     * 
+     */
+    @Import(name="otlpEndpoint")
+    private @Nullable Output<String> otlpEndpoint;
+
+    /**
+     * @return An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+     * of resource operations and builds to. gRPC endpoints aren&#39;t supported.
+     * 
+     * Spans join the trace in the `TRACEPARENT` environment variable, if set,
+     * and trace context is propagated to the build daemon.
+     * 
+     */
+    public Optional<Output<String>> otlpEndpoint() {
+        return Optional.ofNullable(this.otlpEndpoint);
+    }
+
+    /**
+     * How to report build progress for images which don&#39;t specify their own
+     * `progress`. Defaults to `plain`.
+     * 
+     */
+    @Import(name="progress", json=true)
+    private @Nullable Output<ProgressMode> progress;
+
+    /**
+     * @return How to report build progress for images which don&#39;t specify their own
+     * `progress`. Defaults to `plain`.
+     * 
+     */
+    public Optional<Output<ProgressMode>> progress() {
+        return Optional.ofNullable(this.progress);
+    }
+
     @Import(name="registries", json=true)
     private @Nullable Output<List<RegistryArgs>> registries;
 
@@ -40,11 +147,54 @@ public final class ProviderArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.registries);
     }
 
+    /**
+     * Require external `FROM` and `COPY --from` images to be pinned with an
+     * `{@literal @}sha256:` digest, for images which don&#39;t specify their own
+     * `requirePinnedBaseImages`.
+     * 
+     */
+    @Import(name="requirePinnedBaseImages", json=true)
+    private @Nullable Output<Boolean> requirePinnedBaseImages;
+
+    /**
+     * @return Require external `FROM` and `COPY --from` images to be pinned with an
+     * `{@literal @}sha256:` digest, for images which don&#39;t specify their own
+     * `requirePinnedBaseImages`.
+     * 
+     */
+    public Optional<Output<Boolean>> requirePinnedBaseImages() {
+        return Optional.ofNullable(this.requirePinnedBaseImages);
+    }
+
+    /**
+     * Fail image builds if BuildKit reports any warnings, for images which
+     * don&#39;t specify their own `warningsAsErrors`.
+     * 
+     */
+    @Import(name="warningsAsErrors", json=true)
+    private @Nullable Output<Boolean> warningsAsErrors;
+
+    /**
+     * @return Fail image builds if BuildKit reports any warnings, for images which
+     * don&#39;t specify their own `warningsAsErrors`.
+     * 
+     */
+    public Optional<Output<Boolean>> warningsAsErrors() {
+        return Optional.ofNullable(this.warningsAsErrors);
+    }
+
     private ProviderArgs() {}
 
     private ProviderArgs(ProviderArgs $) {
+        this.allowedBaseImages = $.allowedBaseImages;
+        this.buildLogDir = $.buildLogDir;
+        this.contextHashCacheDir = $.contextHashCacheDir;
         this.host = $.host;
+        this.otlpEndpoint = $.otlpEndpoint;
+        this.progress = $.progress;
         this.registries = $.registries;
+        this.requirePinnedBaseImages = $.requirePinnedBaseImages;
+        this.warningsAsErrors = $.warningsAsErrors;
     }
 
     public static Builder builder() {
@@ -65,6 +215,101 @@ public final class ProviderArgs extends com.pulumi.resources.ResourceArgs {
             $ = new ProviderArgs(Objects.requireNonNull(defaults));
         }
 
+        /**
+         * @param allowedBaseImages Glob patterns, like `docker.io/library/*`, that every image&#39;s `FROM`
+         * instructions must match. This applies in addition to each image&#39;s own
+         * `allowedBaseImages`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder allowedBaseImages(@Nullable Output<List<String>> allowedBaseImages) {
+            $.allowedBaseImages = allowedBaseImages;
+            return this;
+        }
+
+        /**
+         * @param allowedBaseImages Glob patterns, like `docker.io/library/*`, that every image&#39;s `FROM`
+         * instructions must match. This applies in addition to each image&#39;s own
+         * `allowedBaseImages`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder allowedBaseImages(List<String> allowedBaseImages) {
+            return allowedBaseImages(Output.of(allowedBaseImages));
+        }
+
+        /**
+         * @param allowedBaseImages Glob patterns, like `docker.io/library/*`, that every image&#39;s `FROM`
+         * instructions must match. This applies in addition to each image&#39;s own
+         * `allowedBaseImages`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder allowedBaseImages(String... allowedBaseImages) {
+            return allowedBaseImages(List.of(allowedBaseImages));
+        }
+
+        /**
+         * @param buildLogDir A directory to write the full log of each image build to, for images
+         * which don&#39;t specify their own `buildLogPath`.
+         * 
+         * Logs are named after the image&#39;s first tag, or the resource&#39;s name if
+         * it has no tags.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder buildLogDir(@Nullable Output<String> buildLogDir) {
+            $.buildLogDir = buildLogDir;
+            return this;
+        }
+
+        /**
+         * @param buildLogDir A directory to write the full log of each image build to, for images
+         * which don&#39;t specify their own `buildLogPath`.
+         * 
+         * Logs are named after the image&#39;s first tag, or the resource&#39;s name if
+         * it has no tags.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder buildLogDir(String buildLogDir) {
+            return buildLogDir(Output.of(buildLogDir));
+        }
+
+        /**
+         * @param contextHashCacheDir A directory to cache build context hashes in, so files which haven&#39;t
+         * changed aren&#39;t read again on later runs.
+         * 
+         * Files are considered unchanged if their size, modification time and
+         * inode are the same. The resulting `contextHash` is unaffected.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder contextHashCacheDir(@Nullable Output<String> contextHashCacheDir) {
+            $.contextHashCacheDir = contextHashCacheDir;
+            return this;
+        }
+
+        /**
+         * @param contextHashCacheDir A directory to cache build context hashes in, so files which haven&#39;t
+         * changed aren&#39;t read again on later runs.
+         * 
+         * Files are considered unchanged if their size, modification time and
+         * inode are the same. The resulting `contextHash` is unaffected.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder contextHashCacheDir(String contextHashCacheDir) {
+            return contextHashCacheDir(Output.of(contextHashCacheDir));
+        }
+
         /**
          * @param host The build daemon&#39;s address.
          * 
@@ -86,6 +331,58 @@ public final class ProviderArgs extends com.pulumi.resources.ResourceArgs {
             return host(Output.of(host));
         }
 
+        /**
+         * @param otlpEndpoint An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+         * of resource operations and builds to. gRPC endpoints aren&#39;t supported.
+         * 
+         * Spans join the trace in the `TRACEPARENT` environment variable, if set,
+         * and trace context is propagated to the build daemon.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder otlpEndpoint(@Nullable Output<String> otlpEndpoint) {
+            $.otlpEndpoint = otlpEndpoint;
+            return this;
+        }
+
+        /**
+         * @param otlpEndpoint An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+         * of resource operations and builds to. gRPC endpoints aren&#39;t supported.
+         * 
+         * Spans join the trace in the `TRACEPARENT` environment variable, if set,
+         * and trace context is propagated to the build daemon.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder otlpEndpoint(String otlpEndpoint) {
+            return otlpEndpoint(Output.of(otlpEndpoint));
+        }
+
+        /**
+         * @param progress How to report build progress for images which don&#39;t specify their own
+         * `progress`. Defaults to `plain`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder progress(@Nullable Output<ProgressMode> progress) {
+            $.progress = progress;
+            return this;
+        }
+
+        /**
+         * @param progress How to report build progress for images which don&#39;t specify their own
+         * `progress`. Defaults to `plain`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder progress(ProgressMode progress) {
+            return progress(Output.of(progress));
+        }
+
         public Builder registries(@Nullable Output<List<RegistryArgs>> registries) {
             $.registries = registries;
             return this;
@@ -99,8 +396,57 @@ public final class ProviderArgs extends com.pulumi.resources.ResourceArgs {
             return registries(List.of(registries));
         }
 
+        /**
+         * @param requirePinnedBaseImages Require external `FROM` and `COPY --from` images to be pinned with an
+         * `{@literal @}sha256:` digest, for images which don&#39;t specify their own
+         * `requirePinnedBaseImages`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder requirePinnedBaseImages(@Nullable Output<Boolean> requirePinnedBaseImages) {
+            $.requirePinnedBaseImages = requirePinnedBaseImages;
+            return this;
+        }
+
+        /**
+         * @param requirePinnedBaseImages Require external `FROM` and `COPY --from` images to be pinned with an
+         * `{@literal @}sha256:` digest, for images which don&#39;t specify their own
+         * `requirePinnedBaseImages`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder requirePinnedBaseImages(Boolean requirePinnedBaseImages) {
+            return requirePinnedBaseImages(Output.of(requirePinnedBaseImages));
+        }
+
+        /**
+         * @param warningsAsErrors Fail image builds if BuildKit reports any warnings, for images which
+         * don&#39;t specify their own `warningsAsErrors`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder warningsAsErrors(@Nullable Output<Boolean> warningsAsErrors) {
+            $.warningsAsErrors = warningsAsErrors;
+            return this;
+        }
+
+        /**
+         * @param warningsAsErrors Fail image builds if BuildKit reports any warnings, for images which
+         * don&#39;t specify their own `warningsAsErrors`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder warningsAsErrors(Boolean warningsAsErrors) {
+            return warningsAsErrors(Output.of(warningsAsErrors));
+        }
+
         public ProviderArgs build() {
             $.host = Codegen.stringProp("host").output().arg($.host).env("DOCKER_HOST").def("").getNullable();
+            $.otlpEndpoint = Codegen.stringProp("otlpEndpoint").output().arg($.otlpEndpoint).env("PULUMI_DOCKER_BUILD_OTLP_ENDPOINT").def("").getNullable();
             return $;
         }
     }
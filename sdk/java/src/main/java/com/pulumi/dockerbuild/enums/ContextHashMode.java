@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    @EnumType
+    public enum ContextHashMode {
+        /**
+         * Hash every file in the context which isn&#39;t excluded by &#34;.dockerignore&#34;.
+         * 
+         */
+        All("all"),
+        /**
+         * Hash only the files reachable from &#34;COPY&#34; and &#34;ADD&#34; sources, falling back to every file when they can&#39;t be determined statically.
+         * 
+         */
+        Referenced("referenced");
+
+        private final String value;
+
+        ContextHashMode(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public java.lang.String toString() {
+            return new StringJoiner(", ", "ContextHashMode[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    @EnumType
+    public enum Entitlement {
+        /**
+         * Allow &#34;RUN --network=host&#34; and the &#34;host&#34; network mode.
+         * 
+         */
+        Network_host("network.host"),
+        /**
+         * Allow &#34;RUN --security=insecure&#34; to run privileged containers.
+         * 
+         */
+        Security_insecure("security.insecure");
+
+        private final String value;
+
+        Entitlement(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public java.lang.String toString() {
+            return new StringJoiner(", ", "Entitlement[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
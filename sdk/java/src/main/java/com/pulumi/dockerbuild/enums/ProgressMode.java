@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    @EnumType
+    public enum ProgressMode {
+        /**
+         * Stream plain-text progress as the build runs.
+         * 
+         */
+        Plain("plain"),
+        /**
+         * Log each build status update as a line of JSON.
+         * 
+         */
+        Rawjson("rawjson"),
+        /**
+         * Only report progress if the build fails.
+         * 
+         */
+        Quiet("quiet");
+
+        private final String value;
+
+        ProgressMode(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public java.lang.String toString() {
+            return new StringJoiner(", ", "ProgressMode[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
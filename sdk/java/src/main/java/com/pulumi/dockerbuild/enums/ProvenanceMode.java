@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    @EnumType
+    public enum ProvenanceMode {
+        /**
+         * Only include a minimal description of the build.
+         * 
+         */
+        Min("min"),
+        /**
+         * Include detailed information about the build, including build arguments and source mappings.
+         * 
+         */
+        Max("max");
+
+        private final String value;
+
+        ProvenanceMode(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public java.lang.String toString() {
+            return new StringJoiner(", ", "ProvenanceMode[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
@@ -0,0 +1,145 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.dockerbuild.inputs.ProvenanceAttestationArgs;
+import com.pulumi.dockerbuild.inputs.SBOMAttestationArgs;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class AttestationsArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final AttestationsArgs Empty = new AttestationsArgs();
+
+    /**
+     * Generate SLSA provenance for the build.
+     * 
+     * Provenance is disabled unless configured here.
+     * 
+     * Equivalent to Docker&#39;s `--attest type=provenance` flag.
+     * 
+     */
+    @Import(name="provenance")
+    private @Nullable Output<ProvenanceAttestationArgs> provenance;
+
+    /**
+     * @return Generate SLSA provenance for the build.
+     * 
+     * Provenance is disabled unless configured here.
+     * 
+     * Equivalent to Docker&#39;s `--attest type=provenance` flag.
+     * 
+     */
+    public Optional<Output<ProvenanceAttestationArgs>> provenance() {
+        return Optional.ofNullable(this.provenance);
+    }
+
+    /**
+     * Generate a Software Bill of Materials (SBOM) for the image.
+     * 
+     * Equivalent to Docker&#39;s `--attest type=sbom` flag.
+     * 
+     */
+    @Import(name="sbom")
+    private @Nullable Output<SBOMAttestationArgs> sbom;
+
+    /**
+     * @return Generate a Software Bill of Materials (SBOM) for the image.
+     * 
+     * Equivalent to Docker&#39;s `--attest type=sbom` flag.
+     * 
+     */
+    public Optional<Output<SBOMAttestationArgs>> sbom() {
+        return Optional.ofNullable(this.sbom);
+    }
+
+    private AttestationsArgs() {}
+
+    private AttestationsArgs(AttestationsArgs $) {
+        this.provenance = $.provenance;
+        this.sbom = $.sbom;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(AttestationsArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private AttestationsArgs $;
+
+        public Builder() {
+            $ = new AttestationsArgs();
+        }
+
+        public Builder(AttestationsArgs defaults) {
+            $ = new AttestationsArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param provenance Generate SLSA provenance for the build.
+         * 
+         * Provenance is disabled unless configured here.
+         * 
+         * Equivalent to Docker&#39;s `--attest type=provenance` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder provenance(@Nullable Output<ProvenanceAttestationArgs> provenance) {
+            $.provenance = provenance;
+            return this;
+        }
+
+        /**
+         * @param provenance Generate SLSA provenance for the build.
+         * 
+         * Provenance is disabled unless configured here.
+         * 
+         * Equivalent to Docker&#39;s `--attest type=provenance` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder provenance(ProvenanceAttestationArgs provenance) {
+            return provenance(Output.of(provenance));
+        }
+
+        /**
+         * @param sbom Generate a Software Bill of Materials (SBOM) for the image.
+         * 
+         * Equivalent to Docker&#39;s `--attest type=sbom` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder sbom(@Nullable Output<SBOMAttestationArgs> sbom) {
+            $.sbom = sbom;
+            return this;
+        }
+
+        /**
+         * @param sbom Generate a Software Bill of Materials (SBOM) for the image.
+         * 
+         * Equivalent to Docker&#39;s `--attest type=sbom` flag.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder sbom(SBOMAttestationArgs sbom) {
+            return sbom(Output.of(sbom));
+        }
+
+        public AttestationsArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,140 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class BaseImageLockArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final BaseImageLockArgs Empty = new BaseImageLockArgs();
+
+    /**
+     * A lockfile to record digests in, like `Dockerfile.lock`. Relative
+     * paths are resolved from the Dockerfile&#39;s directory, or the context&#39;s
+     * for inline Dockerfiles.
+     * 
+     * Digests are recorded in the resource&#39;s state if this isn&#39;t set.
+     * 
+     */
+    @Import(name="path")
+    private @Nullable Output<String> path;
+
+    /**
+     * @return A lockfile to record digests in, like `Dockerfile.lock`. Relative
+     * paths are resolved from the Dockerfile&#39;s directory, or the context&#39;s
+     * for inline Dockerfiles.
+     * 
+     * Digests are recorded in the resource&#39;s state if this isn&#39;t set.
+     * 
+     */
+    public Optional<Output<String>> path() {
+        return Optional.ofNullable(this.path);
+    }
+
+    /**
+     * An arbitrary value, like a date, which re-resolves every base image
+     * when it changes.
+     * 
+     */
+    @Import(name="refresh")
+    private @Nullable Output<String> refresh;
+
+    /**
+     * @return An arbitrary value, like a date, which re-resolves every base image
+     * when it changes.
+     * 
+     */
+    public Optional<Output<String>> refresh() {
+        return Optional.ofNullable(this.refresh);
+    }
+
+    private BaseImageLockArgs() {}
+
+    private BaseImageLockArgs(BaseImageLockArgs $) {
+        this.path = $.path;
+        this.refresh = $.refresh;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(BaseImageLockArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private BaseImageLockArgs $;
+
+        public Builder() {
+            $ = new BaseImageLockArgs();
+        }
+
+        public Builder(BaseImageLockArgs defaults) {
+            $ = new BaseImageLockArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param path A lockfile to record digests in, like `Dockerfile.lock`. Relative
+         * paths are resolved from the Dockerfile&#39;s directory, or the context&#39;s
+         * for inline Dockerfiles.
+         * 
+         * Digests are recorded in the resource&#39;s state if this isn&#39;t set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder path(@Nullable Output<String> path) {
+            $.path = path;
+            return this;
+        }
+
+        /**
+         * @param path A lockfile to record digests in, like `Dockerfile.lock`. Relative
+         * paths are resolved from the Dockerfile&#39;s directory, or the context&#39;s
+         * for inline Dockerfiles.
+         * 
+         * Digests are recorded in the resource&#39;s state if this isn&#39;t set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder path(String path) {
+            return path(Output.of(path));
+        }
+
+        /**
+         * @param refresh An arbitrary value, like a date, which re-resolves every base image
+         * when it changes.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder refresh(@Nullable Output<String> refresh) {
+            $.refresh = refresh;
+            return this;
+        }
+
+        /**
+         * @param refresh An arbitrary value, like a date, which re-resolves every base image
+         * when it changes.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder refresh(String refresh) {
+            return refresh(Output.of(refresh));
+        }
+
+        public BaseImageLockArgs build() {
+            return $;
+        }
+    }
+
+}
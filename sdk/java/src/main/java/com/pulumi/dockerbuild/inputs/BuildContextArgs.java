@@ -5,6 +5,7 @@ package com.pulumi.dockerbuild.inputs;
 
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Import;
+import com.pulumi.dockerbuild.enums.ContextHashMode;
 import com.pulumi.dockerbuild.inputs.ContextArgs;
 import com.pulumi.exceptions.MissingRequiredPropertyException;
 import java.lang.String;
@@ -18,6 +19,39 @@ public final class BuildContextArgs extends com.pulumi.resources.ResourceArgs {
 
     public static final BuildContextArgs Empty = new BuildContextArgs();
 
+    /**
+     * Which files in local contexts contribute to the `contextHash` used to
+     * decide whether the image needs to be rebuilt.
+     * 
+     * With `referenced`, only the paths copied by `COPY` and `ADD`
+     * instructions are hashed, so changes to other files don&#39;t trigger a
+     * rebuild. Every file is hashed if those paths can&#39;t be determined
+     * statically -- for example when the Dockerfile is remote or a `RUN`
+     * instruction bind-mounts a context.
+     * 
+     * Defaults to `all`.
+     * 
+     */
+    @Import(name="hashMode")
+    private @Nullable Output<ContextHashMode> hashMode;
+
+    /**
+     * @return Which files in local contexts contribute to the `contextHash` used to
+     * decide whether the image needs to be rebuilt.
+     * 
+     * With `referenced`, only the paths copied by `COPY` and `ADD`
+     * instructions are hashed, so changes to other files don&#39;t trigger a
+     * rebuild. Every file is hashed if those paths can&#39;t be determined
+     * statically -- for example when the Dockerfile is remote or a `RUN`
+     * instruction bind-mounts a context.
+     * 
+     * Defaults to `all`.
+     * 
+     */
+    public Optional<Output<ContextHashMode>> hashMode() {
+        return Optional.ofNullable(this.hashMode);
+    }
+
     /**
      * Resources to use for build context.
      * 
@@ -75,6 +109,7 @@ public final class BuildContextArgs extends com.pulumi.resources.ResourceArgs {
     private BuildContextArgs() {}
 
     private BuildContextArgs(BuildContextArgs $) {
+        this.hashMode = $.hashMode;
         this.location = $.location;
         this.named = $.named;
     }
@@ -97,6 +132,45 @@ public final class BuildContextArgs extends com.pulumi.resources.ResourceArgs {
             $ = new BuildContextArgs(Objects.requireNonNull(defaults));
         }
 
+        /**
+         * @param hashMode Which files in local contexts contribute to the `contextHash` used to
+         * decide whether the image needs to be rebuilt.
+         * 
+         * With `referenced`, only the paths copied by `COPY` and `ADD`
+         * instructions are hashed, so changes to other files don&#39;t trigger a
+         * rebuild. Every file is hashed if those paths can&#39;t be determined
+         * statically -- for example when the Dockerfile is remote or a `RUN`
+         * instruction bind-mounts a context.
+         * 
+         * Defaults to `all`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder hashMode(@Nullable Output<ContextHashMode> hashMode) {
+            $.hashMode = hashMode;
+            return this;
+        }
+
+        /**
+         * @param hashMode Which files in local contexts contribute to the `contextHash` used to
+         * decide whether the image needs to be rebuilt.
+         * 
+         * With `referenced`, only the paths copied by `COPY` and `ADD`
+         * instructions are hashed, so changes to other files don&#39;t trigger a
+         * rebuild. Every file is hashed if those paths can&#39;t be determined
+         * statically -- for example when the Dockerfile is remote or a `RUN`
+         * instruction bind-mounts a context.
+         * 
+         * Defaults to `all`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder hashMode(ContextHashMode hashMode) {
+            return hashMode(Output.of(hashMode));
+        }
+
         /**
          * @param location Resources to use for build context.
          * 
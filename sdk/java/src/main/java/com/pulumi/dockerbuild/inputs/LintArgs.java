@@ -0,0 +1,150 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Boolean;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class LintArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final LintArgs Empty = new LintArgs();
+
+    /**
+     * Report lint violations as errors instead of warnings.
+     * 
+     * Defaults to the Dockerfile&#39;s `# check=error=...` directive.
+     * 
+     */
+    @Import(name="error")
+    private @Nullable Output<Boolean> error;
+
+    /**
+     * @return Report lint violations as errors instead of warnings.
+     * 
+     * Defaults to the Dockerfile&#39;s `# check=error=...` directive.
+     * 
+     */
+    public Optional<Output<Boolean>> error() {
+        return Optional.ofNullable(this.error);
+    }
+
+    /**
+     * Names of lint rules to skip, like `StageNameCasing`, or `all` to
+     * disable linting. Rules skipped by the Dockerfile&#39;s `# check=skip=...`
+     * directive are also skipped.
+     * 
+     */
+    @Import(name="skip")
+    private @Nullable Output<List<String>> skip;
+
+    /**
+     * @return Names of lint rules to skip, like `StageNameCasing`, or `all` to
+     * disable linting. Rules skipped by the Dockerfile&#39;s `# check=skip=...`
+     * directive are also skipped.
+     * 
+     */
+    public Optional<Output<List<String>>> skip() {
+        return Optional.ofNullable(this.skip);
+    }
+
+    private LintArgs() {}
+
+    private LintArgs(LintArgs $) {
+        this.error = $.error;
+        this.skip = $.skip;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(LintArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private LintArgs $;
+
+        public Builder() {
+            $ = new LintArgs();
+        }
+
+        public Builder(LintArgs defaults) {
+            $ = new LintArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param error Report lint violations as errors instead of warnings.
+         * 
+         * Defaults to the Dockerfile&#39;s `# check=error=...` directive.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder error(@Nullable Output<Boolean> error) {
+            $.error = error;
+            return this;
+        }
+
+        /**
+         * @param error Report lint violations as errors instead of warnings.
+         * 
+         * Defaults to the Dockerfile&#39;s `# check=error=...` directive.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder error(Boolean error) {
+            return error(Output.of(error));
+        }
+
+        /**
+         * @param skip Names of lint rules to skip, like `StageNameCasing`, or `all` to
+         * disable linting. Rules skipped by the Dockerfile&#39;s `# check=skip=...`
+         * directive are also skipped.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder skip(@Nullable Output<List<String>> skip) {
+            $.skip = skip;
+            return this;
+        }
+
+        /**
+         * @param skip Names of lint rules to skip, like `StageNameCasing`, or `all` to
+         * disable linting. Rules skipped by the Dockerfile&#39;s `# check=skip=...`
+         * directive are also skipped.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder skip(List<String> skip) {
+            return skip(Output.of(skip));
+        }
+
+        /**
+         * @param skip Names of lint rules to skip, like `StageNameCasing`, or `all` to
+         * disable linting. Rules skipped by the Dockerfile&#39;s `# check=skip=...`
+         * directive are also skipped.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder skip(String... skip) {
+            return skip(List.of(skip));
+        }
+
+        public LintArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,206 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.core.internal.Codegen;
+import com.pulumi.dockerbuild.enums.ProvenanceMode;
+import java.lang.Boolean;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class ProvenanceAttestationArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final ProvenanceAttestationArgs Empty = new ProvenanceAttestationArgs();
+
+    /**
+     * An explicit builder ID to record in the provenance, for example the
+     * URL of the CI job performing the build.
+     * 
+     */
+    @Import(name="builderId")
+    private @Nullable Output<String> builderId;
+
+    /**
+     * @return An explicit builder ID to record in the provenance, for example the
+     * URL of the CI job performing the build.
+     * 
+     */
+    public Optional<Output<String>> builderId() {
+        return Optional.ofNullable(this.builderId);
+    }
+
+    /**
+     * When `true` no provenance will be generated. Defaults to `false`.
+     * 
+     */
+    @Import(name="disabled")
+    private @Nullable Output<Boolean> disabled;
+
+    /**
+     * @return When `true` no provenance will be generated. Defaults to `false`.
+     * 
+     */
+    public Optional<Output<Boolean>> disabled() {
+        return Optional.ofNullable(this.disabled);
+    }
+
+    /**
+     * Only attach provenance to exporters which embed it in the image
+     * (e.g. `image` and `registry`). Defaults to `false`.
+     * 
+     */
+    @Import(name="inlineOnly")
+    private @Nullable Output<Boolean> inlineOnly;
+
+    /**
+     * @return Only attach provenance to exporters which embed it in the image
+     * (e.g. `image` and `registry`). Defaults to `false`.
+     * 
+     */
+    public Optional<Output<Boolean>> inlineOnly() {
+        return Optional.ofNullable(this.inlineOnly);
+    }
+
+    /**
+     * The level of detail to include in the provenance. Defaults to `min`.
+     * 
+     */
+    @Import(name="mode")
+    private @Nullable Output<ProvenanceMode> mode;
+
+    /**
+     * @return The level of detail to include in the provenance. Defaults to `min`.
+     * 
+     */
+    public Optional<Output<ProvenanceMode>> mode() {
+        return Optional.ofNullable(this.mode);
+    }
+
+    private ProvenanceAttestationArgs() {}
+
+    private ProvenanceAttestationArgs(ProvenanceAttestationArgs $) {
+        this.builderId = $.builderId;
+        this.disabled = $.disabled;
+        this.inlineOnly = $.inlineOnly;
+        this.mode = $.mode;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(ProvenanceAttestationArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private ProvenanceAttestationArgs $;
+
+        public Builder() {
+            $ = new ProvenanceAttestationArgs();
+        }
+
+        public Builder(ProvenanceAttestationArgs defaults) {
+            $ = new ProvenanceAttestationArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param builderId An explicit builder ID to record in the provenance, for example the
+         * URL of the CI job performing the build.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder builderId(@Nullable Output<String> builderId) {
+            $.builderId = builderId;
+            return this;
+        }
+
+        /**
+         * @param builderId An explicit builder ID to record in the provenance, for example the
+         * URL of the CI job performing the build.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder builderId(String builderId) {
+            return builderId(Output.of(builderId));
+        }
+
+        /**
+         * @param disabled When `true` no provenance will be generated. Defaults to `false`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder disabled(@Nullable Output<Boolean> disabled) {
+            $.disabled = disabled;
+            return this;
+        }
+
+        /**
+         * @param disabled When `true` no provenance will be generated. Defaults to `false`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder disabled(Boolean disabled) {
+            return disabled(Output.of(disabled));
+        }
+
+        /**
+         * @param inlineOnly Only attach provenance to exporters which embed it in the image
+         * (e.g. `image` and `registry`). Defaults to `false`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder inlineOnly(@Nullable Output<Boolean> inlineOnly) {
+            $.inlineOnly = inlineOnly;
+            return this;
+        }
+
+        /**
+         * @param inlineOnly Only attach provenance to exporters which embed it in the image
+         * (e.g. `image` and `registry`). Defaults to `false`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder inlineOnly(Boolean inlineOnly) {
+            return inlineOnly(Output.of(inlineOnly));
+        }
+
+        /**
+         * @param mode The level of detail to include in the provenance. Defaults to `min`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder mode(@Nullable Output<ProvenanceMode> mode) {
+            $.mode = mode;
+            return this;
+        }
+
+        /**
+         * @param mode The level of detail to include in the provenance. Defaults to `min`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder mode(ProvenanceMode mode) {
+            return mode(Output.of(mode));
+        }
+
+        public ProvenanceAttestationArgs build() {
+            $.mode = Codegen.objectProp("mode", ProvenanceMode.class).output().arg($.mode).def(ProvenanceMode.Min).getNullable();
+            return $;
+        }
+    }
+
+}
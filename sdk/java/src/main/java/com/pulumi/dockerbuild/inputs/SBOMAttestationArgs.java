@@ -0,0 +1,219 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Boolean;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class SBOMAttestationArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final SBOMAttestationArgs Empty = new SBOMAttestationArgs();
+
+    /**
+     * When `true` no SBOM will be generated. Defaults to `false`.
+     * 
+     */
+    @Import(name="disabled")
+    private @Nullable Output<Boolean> disabled;
+
+    /**
+     * @return When `true` no SBOM will be generated. Defaults to `false`.
+     * 
+     */
+    public Optional<Output<Boolean>> disabled() {
+        return Optional.ofNullable(this.disabled);
+    }
+
+    /**
+     * The scanner image used to generate the SBOM.
+     * 
+     * Defaults to BuildKit&#39;s `docker/buildkit-syft-scanner`.
+     * 
+     */
+    @Import(name="generator")
+    private @Nullable Output<String> generator;
+
+    /**
+     * @return The scanner image used to generate the SBOM.
+     * 
+     * Defaults to BuildKit&#39;s `docker/buildkit-syft-scanner`.
+     * 
+     */
+    public Optional<Output<String>> generator() {
+        return Optional.ofNullable(this.generator);
+    }
+
+    /**
+     * Also scan the build context, in addition to the final image.
+     * 
+     * Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+     * 
+     */
+    @Import(name="scanContext")
+    private @Nullable Output<Boolean> scanContext;
+
+    /**
+     * @return Also scan the build context, in addition to the final image.
+     * 
+     * Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+     * 
+     */
+    public Optional<Output<Boolean>> scanContext() {
+        return Optional.ofNullable(this.scanContext);
+    }
+
+    /**
+     * Also scan intermediate build stages, in addition to the final image.
+     * 
+     * Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+     * 
+     */
+    @Import(name="scanStage")
+    private @Nullable Output<Boolean> scanStage;
+
+    /**
+     * @return Also scan intermediate build stages, in addition to the final image.
+     * 
+     * Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+     * 
+     */
+    public Optional<Output<Boolean>> scanStage() {
+        return Optional.ofNullable(this.scanStage);
+    }
+
+    private SBOMAttestationArgs() {}
+
+    private SBOMAttestationArgs(SBOMAttestationArgs $) {
+        this.disabled = $.disabled;
+        this.generator = $.generator;
+        this.scanContext = $.scanContext;
+        this.scanStage = $.scanStage;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(SBOMAttestationArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private SBOMAttestationArgs $;
+
+        public Builder() {
+            $ = new SBOMAttestationArgs();
+        }
+
+        public Builder(SBOMAttestationArgs defaults) {
+            $ = new SBOMAttestationArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param disabled When `true` no SBOM will be generated. Defaults to `false`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder disabled(@Nullable Output<Boolean> disabled) {
+            $.disabled = disabled;
+            return this;
+        }
+
+        /**
+         * @param disabled When `true` no SBOM will be generated. Defaults to `false`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder disabled(Boolean disabled) {
+            return disabled(Output.of(disabled));
+        }
+
+        /**
+         * @param generator The scanner image used to generate the SBOM.
+         * 
+         * Defaults to BuildKit&#39;s `docker/buildkit-syft-scanner`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder generator(@Nullable Output<String> generator) {
+            $.generator = generator;
+            return this;
+        }
+
+        /**
+         * @param generator The scanner image used to generate the SBOM.
+         * 
+         * Defaults to BuildKit&#39;s `docker/buildkit-syft-scanner`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder generator(String generator) {
+            return generator(Output.of(generator));
+        }
+
+        /**
+         * @param scanContext Also scan the build context, in addition to the final image.
+         * 
+         * Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder scanContext(@Nullable Output<Boolean> scanContext) {
+            $.scanContext = scanContext;
+            return this;
+        }
+
+        /**
+         * @param scanContext Also scan the build context, in addition to the final image.
+         * 
+         * Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder scanContext(Boolean scanContext) {
+            return scanContext(Output.of(scanContext));
+        }
+
+        /**
+         * @param scanStage Also scan intermediate build stages, in addition to the final image.
+         * 
+         * Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder scanStage(@Nullable Output<Boolean> scanStage) {
+            $.scanStage = scanStage;
+            return this;
+        }
+
+        /**
+         * @param scanStage Also scan intermediate build stages, in addition to the final image.
+         * 
+         * Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder scanStage(Boolean scanStage) {
+            return scanStage(Output.of(scanStage));
+        }
+
+        public SBOMAttestationArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,165 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class UlimitArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final UlimitArgs Empty = new UlimitArgs();
+
+    /**
+     * The hard limit. Defaults to the soft limit.
+     * 
+     */
+    @Import(name="hard")
+    private @Nullable Output<Integer> hard;
+
+    /**
+     * @return The hard limit. Defaults to the soft limit.
+     * 
+     */
+    public Optional<Output<Integer>> hard() {
+        return Optional.ofNullable(this.hard);
+    }
+
+    /**
+     * The name of the limit, for example `nofile` or `nproc`.
+     * 
+     */
+    @Import(name="name", required=true)
+    private Output<String> name;
+
+    /**
+     * @return The name of the limit, for example `nofile` or `nproc`.
+     * 
+     */
+    public Output<String> name() {
+        return this.name;
+    }
+
+    /**
+     * The soft limit. Use `-1` for unlimited.
+     * 
+     */
+    @Import(name="soft", required=true)
+    private Output<Integer> soft;
+
+    /**
+     * @return The soft limit. Use `-1` for unlimited.
+     * 
+     */
+    public Output<Integer> soft() {
+        return this.soft;
+    }
+
+    private UlimitArgs() {}
+
+    private UlimitArgs(UlimitArgs $) {
+        this.hard = $.hard;
+        this.name = $.name;
+        this.soft = $.soft;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(UlimitArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private UlimitArgs $;
+
+        public Builder() {
+            $ = new UlimitArgs();
+        }
+
+        public Builder(UlimitArgs defaults) {
+            $ = new UlimitArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param hard The hard limit. Defaults to the soft limit.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder hard(@Nullable Output<Integer> hard) {
+            $.hard = hard;
+            return this;
+        }
+
+        /**
+         * @param hard The hard limit. Defaults to the soft limit.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder hard(Integer hard) {
+            return hard(Output.of(hard));
+        }
+
+        /**
+         * @param name The name of the limit, for example `nofile` or `nproc`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder name(Output<String> name) {
+            $.name = name;
+            return this;
+        }
+
+        /**
+         * @param name The name of the limit, for example `nofile` or `nproc`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder name(String name) {
+            return name(Output.of(name));
+        }
+
+        /**
+         * @param soft The soft limit. Use `-1` for unlimited.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder soft(Output<Integer> soft) {
+            $.soft = soft;
+            return this;
+        }
+
+        /**
+         * @param soft The soft limit. Use `-1` for unlimited.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder soft(Integer soft) {
+            return soft(Output.of(soft));
+        }
+
+        public UlimitArgs build() {
+            if ($.name == null) {
+                throw new MissingRequiredPropertyException("UlimitArgs", "name");
+            }
+            if ($.soft == null) {
+                throw new MissingRequiredPropertyException("UlimitArgs", "soft");
+            }
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,91 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.dockerbuild.outputs.ProvenanceAttestation;
+import com.pulumi.dockerbuild.outputs.SBOMAttestation;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class Attestations {
+    /**
+     * @return Generate SLSA provenance for the build.
+     * 
+     * Provenance is disabled unless configured here.
+     * 
+     * Equivalent to Docker&#39;s `--attest type=provenance` flag.
+     * 
+     */
+    private @Nullable ProvenanceAttestation provenance;
+    /**
+     * @return Generate a Software Bill of Materials (SBOM) for the image.
+     * 
+     * Equivalent to Docker&#39;s `--attest type=sbom` flag.
+     * 
+     */
+    private @Nullable SBOMAttestation sbom;
+
+    private Attestations() {}
+    /**
+     * @return Generate SLSA provenance for the build.
+     * 
+     * Provenance is disabled unless configured here.
+     * 
+     * Equivalent to Docker&#39;s `--attest type=provenance` flag.
+     * 
+     */
+    public Optional<ProvenanceAttestation> provenance() {
+        return Optional.ofNullable(this.provenance);
+    }
+    /**
+     * @return Generate a Software Bill of Materials (SBOM) for the image.
+     * 
+     * Equivalent to Docker&#39;s `--attest type=sbom` flag.
+     * 
+     */
+    public Optional<SBOMAttestation> sbom() {
+        return Optional.ofNullable(this.sbom);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(Attestations defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable ProvenanceAttestation provenance;
+        private @Nullable SBOMAttestation sbom;
+        public Builder() {}
+        public Builder(Attestations defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.provenance = defaults.provenance;
+    	      this.sbom = defaults.sbom;
+        }
+
+        @CustomType.Setter
+        public Builder provenance(@Nullable ProvenanceAttestation provenance) {
+
+            this.provenance = provenance;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder sbom(@Nullable SBOMAttestation sbom) {
+
+            this.sbom = sbom;
+            return this;
+        }
+        public Attestations build() {
+            final var _resultValue = new Attestations();
+            _resultValue.provenance = provenance;
+            _resultValue.sbom = sbom;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,88 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class BaseImageLock {
+    /**
+     * @return A lockfile to record digests in, like `Dockerfile.lock`. Relative
+     * paths are resolved from the Dockerfile&#39;s directory, or the context&#39;s
+     * for inline Dockerfiles.
+     * 
+     * Digests are recorded in the resource&#39;s state if this isn&#39;t set.
+     * 
+     */
+    private @Nullable String path;
+    /**
+     * @return An arbitrary value, like a date, which re-resolves every base image
+     * when it changes.
+     * 
+     */
+    private @Nullable String refresh;
+
+    private BaseImageLock() {}
+    /**
+     * @return A lockfile to record digests in, like `Dockerfile.lock`. Relative
+     * paths are resolved from the Dockerfile&#39;s directory, or the context&#39;s
+     * for inline Dockerfiles.
+     * 
+     * Digests are recorded in the resource&#39;s state if this isn&#39;t set.
+     * 
+     */
+    public Optional<String> path() {
+        return Optional.ofNullable(this.path);
+    }
+    /**
+     * @return An arbitrary value, like a date, which re-resolves every base image
+     * when it changes.
+     * 
+     */
+    public Optional<String> refresh() {
+        return Optional.ofNullable(this.refresh);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(BaseImageLock defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable String path;
+        private @Nullable String refresh;
+        public Builder() {}
+        public Builder(BaseImageLock defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.path = defaults.path;
+    	      this.refresh = defaults.refresh;
+        }
+
+        @CustomType.Setter
+        public Builder path(@Nullable String path) {
+
+            this.path = path;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder refresh(@Nullable String refresh) {
+
+            this.refresh = refresh;
+            return this;
+        }
+        public BaseImageLock build() {
+            final var _resultValue = new BaseImageLock();
+            _resultValue.path = path;
+            _resultValue.refresh = refresh;
+            return _resultValue;
+        }
+    }
+}
@@ -4,15 +4,31 @@
 package com.pulumi.dockerbuild.outputs;
 
 import com.pulumi.core.annotations.CustomType;
+import com.pulumi.dockerbuild.enums.ContextHashMode;
 import com.pulumi.dockerbuild.outputs.Context;
 import com.pulumi.exceptions.MissingRequiredPropertyException;
 import java.lang.String;
 import java.util.Map;
 import java.util.Objects;
+import java.util.Optional;
 import javax.annotation.Nullable;
 
 @CustomType
 public final class BuildContext {
+    /**
+     * @return Which files in local contexts contribute to the `contextHash` used to
+     * decide whether the image needs to be rebuilt.
+     * 
+     * With `referenced`, only the paths copied by `COPY` and `ADD`
+     * instructions are hashed, so changes to other files don&#39;t trigger a
+     * rebuild. Every file is hashed if those paths can&#39;t be determined
+     * statically -- for example when the Dockerfile is remote or a `RUN`
+     * instruction bind-mounts a context.
+     * 
+     * Defaults to `all`.
+     * 
+     */
+    private @Nullable ContextHashMode hashMode;
     /**
      * @return Resources to use for build context.
      * 
@@ -37,6 +53,22 @@ public final class BuildContext {
     private @Nullable Map<String,Context> named;
 
     private BuildContext() {}
+    /**
+     * @return Which files in local contexts contribute to the `contextHash` used to
+     * decide whether the image needs to be rebuilt.
+     * 
+     * With `referenced`, only the paths copied by `COPY` and `ADD`
+     * instructions are hashed, so changes to other files don&#39;t trigger a
+     * rebuild. Every file is hashed if those paths can&#39;t be determined
+     * statically -- for example when the Dockerfile is remote or a `RUN`
+     * instruction bind-mounts a context.
+     * 
+     * Defaults to `all`.
+     * 
+     */
+    public Optional<ContextHashMode> hashMode() {
+        return Optional.ofNullable(this.hashMode);
+    }
     /**
      * @return Resources to use for build context.
      * 
@@ -73,15 +105,23 @@ public final class BuildContext {
     }
     @CustomType.Builder
     public static final class Builder {
+        private @Nullable ContextHashMode hashMode;
         private String location;
         private @Nullable Map<String,Context> named;
         public Builder() {}
         public Builder(BuildContext defaults) {
     	      Objects.requireNonNull(defaults);
+    	      this.hashMode = defaults.hashMode;
     	      this.location = defaults.location;
     	      this.named = defaults.named;
         }
 
+        @CustomType.Setter
+        public Builder hashMode(@Nullable ContextHashMode hashMode) {
+
+            this.hashMode = hashMode;
+            return this;
+        }
         @CustomType.Setter
         public Builder location(String location) {
             if (location == null) {
@@ -98,6 +138,7 @@ public final class BuildContext {
         }
         public BuildContext build() {
             final var _resultValue = new BuildContext();
+            _resultValue.hashMode = hashMode;
             _resultValue.location = location;
             _resultValue.named = named;
             return _resultValue;
@@ -0,0 +1,150 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class BuildMetadata {
+    /**
+     * @return The buildx history reference for the build, formatted as
+     * `&lt;builder&gt;/&lt;node&gt;/&lt;ref&gt;`.
+     * 
+     * This can be passed to `docker buildx history inspect` to debug the
+     * build.
+     * 
+     */
+    private @Nullable String buildRef;
+    /**
+     * @return The digest of the image&#39;s config blob.
+     * 
+     */
+    private @Nullable String configDigest;
+    /**
+     * @return The media type of the exported image&#39;s top-level manifest or index.
+     * 
+     */
+    private @Nullable String descriptorMediaType;
+    /**
+     * @return The size in bytes of the exported image&#39;s top-level manifest or index.
+     * 
+     */
+    private @Nullable Integer descriptorSize;
+    /**
+     * @return The comma-separated names the image was exported with.
+     * 
+     */
+    private @Nullable String imageName;
+
+    private BuildMetadata() {}
+    /**
+     * @return The buildx history reference for the build, formatted as
+     * `&lt;builder&gt;/&lt;node&gt;/&lt;ref&gt;`.
+     * 
+     * This can be passed to `docker buildx history inspect` to debug the
+     * build.
+     * 
+     */
+    public Optional<String> buildRef() {
+        return Optional.ofNullable(this.buildRef);
+    }
+    /**
+     * @return The digest of the image&#39;s config blob.
+     * 
+     */
+    public Optional<String> configDigest() {
+        return Optional.ofNullable(this.configDigest);
+    }
+    /**
+     * @return The media type of the exported image&#39;s top-level manifest or index.
+     * 
+     */
+    public Optional<String> descriptorMediaType() {
+        return Optional.ofNullable(this.descriptorMediaType);
+    }
+    /**
+     * @return The size in bytes of the exported image&#39;s top-level manifest or index.
+     * 
+     */
+    public Optional<Integer> descriptorSize() {
+        return Optional.ofNullable(this.descriptorSize);
+    }
+    /**
+     * @return The comma-separated names the image was exported with.
+     * 
+     */
+    public Optional<String> imageName() {
+        return Optional.ofNullable(this.imageName);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(BuildMetadata defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable String buildRef;
+        private @Nullable String configDigest;
+        private @Nullable String descriptorMediaType;
+        private @Nullable Integer descriptorSize;
+        private @Nullable String imageName;
+        public Builder() {}
+        public Builder(BuildMetadata defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.buildRef = defaults.buildRef;
+    	      this.configDigest = defaults.configDigest;
+    	      this.descriptorMediaType = defaults.descriptorMediaType;
+    	      this.descriptorSize = defaults.descriptorSize;
+    	      this.imageName = defaults.imageName;
+        }
+
+        @CustomType.Setter
+        public Builder buildRef(@Nullable String buildRef) {
+
+            this.buildRef = buildRef;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder configDigest(@Nullable String configDigest) {
+
+            this.configDigest = configDigest;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder descriptorMediaType(@Nullable String descriptorMediaType) {
+
+            this.descriptorMediaType = descriptorMediaType;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder descriptorSize(@Nullable Integer descriptorSize) {
+
+            this.descriptorSize = descriptorSize;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder imageName(@Nullable String imageName) {
+
+            this.imageName = imageName;
+            return this;
+        }
+        public BuildMetadata build() {
+            final var _resultValue = new BuildMetadata();
+            _resultValue.buildRef = buildRef;
+            _resultValue.configDigest = configDigest;
+            _resultValue.descriptorMediaType = descriptorMediaType;
+            _resultValue.descriptorSize = descriptorSize;
+            _resultValue.imageName = imageName;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,155 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.dockerbuild.outputs.BuildStep;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Double;
+import java.lang.Integer;
+import java.util.List;
+import java.util.Objects;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class BuildStats {
+    /**
+     * @return The fraction of steps which were cached, between 0 and 1.
+     * 
+     */
+    private Double cacheHitRatio;
+    /**
+     * @return The number of steps which were cached.
+     * 
+     */
+    private Integer cachedSteps;
+    /**
+     * @return Wall-clock duration of the build in seconds.
+     * 
+     */
+    private Double duration;
+    /**
+     * @return The slowest uncached steps, in descending order of duration.
+     * 
+     */
+    private @Nullable List<BuildStep> slowestSteps;
+    /**
+     * @return The number of steps (vertices) solved by the build.
+     * 
+     */
+    private Integer steps;
+
+    private BuildStats() {}
+    /**
+     * @return The fraction of steps which were cached, between 0 and 1.
+     * 
+     */
+    public Double cacheHitRatio() {
+        return this.cacheHitRatio;
+    }
+    /**
+     * @return The number of steps which were cached.
+     * 
+     */
+    public Integer cachedSteps() {
+        return this.cachedSteps;
+    }
+    /**
+     * @return Wall-clock duration of the build in seconds.
+     * 
+     */
+    public Double duration() {
+        return this.duration;
+    }
+    /**
+     * @return The slowest uncached steps, in descending order of duration.
+     * 
+     */
+    public List<BuildStep> slowestSteps() {
+        return this.slowestSteps == null ? List.of() : this.slowestSteps;
+    }
+    /**
+     * @return The number of steps (vertices) solved by the build.
+     * 
+     */
+    public Integer steps() {
+        return this.steps;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(BuildStats defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private Double cacheHitRatio;
+        private Integer cachedSteps;
+        private Double duration;
+        private @Nullable List<BuildStep> slowestSteps;
+        private Integer steps;
+        public Builder() {}
+        public Builder(BuildStats defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.cacheHitRatio = defaults.cacheHitRatio;
+    	      this.cachedSteps = defaults.cachedSteps;
+    	      this.duration = defaults.duration;
+    	      this.slowestSteps = defaults.slowestSteps;
+    	      this.steps = defaults.steps;
+        }
+
+        @CustomType.Setter
+        public Builder cacheHitRatio(Double cacheHitRatio) {
+            if (cacheHitRatio == null) {
+              throw new MissingRequiredPropertyException("BuildStats", "cacheHitRatio");
+            }
+            this.cacheHitRatio = cacheHitRatio;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder cachedSteps(Integer cachedSteps) {
+            if (cachedSteps == null) {
+              throw new MissingRequiredPropertyException("BuildStats", "cachedSteps");
+            }
+            this.cachedSteps = cachedSteps;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder duration(Double duration) {
+            if (duration == null) {
+              throw new MissingRequiredPropertyException("BuildStats", "duration");
+            }
+            this.duration = duration;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder slowestSteps(@Nullable List<BuildStep> slowestSteps) {
+
+            this.slowestSteps = slowestSteps;
+            return this;
+        }
+        public Builder slowestSteps(BuildStep... slowestSteps) {
+            return slowestSteps(List.of(slowestSteps));
+        }
+        @CustomType.Setter
+        public Builder steps(Integer steps) {
+            if (steps == null) {
+              throw new MissingRequiredPropertyException("BuildStats", "steps");
+            }
+            this.steps = steps;
+            return this;
+        }
+        public BuildStats build() {
+            final var _resultValue = new BuildStats();
+            _resultValue.cacheHitRatio = cacheHitRatio;
+            _resultValue.cachedSteps = cachedSteps;
+            _resultValue.duration = duration;
+            _resultValue.slowestSteps = slowestSteps;
+            _resultValue.steps = steps;
+            return _resultValue;
+        }
+    }
+}
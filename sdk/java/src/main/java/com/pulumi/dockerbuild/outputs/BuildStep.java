@@ -0,0 +1,82 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Double;
+import java.lang.String;
+import java.util.Objects;
+
+@CustomType
+public final class BuildStep {
+    /**
+     * @return The step&#39;s duration in seconds.
+     * 
+     */
+    private Double duration;
+    /**
+     * @return The step&#39;s name, for example &#34;[2/3] RUN make&#34;.
+     * 
+     */
+    private String name;
+
+    private BuildStep() {}
+    /**
+     * @return The step&#39;s duration in seconds.
+     * 
+     */
+    public Double duration() {
+        return this.duration;
+    }
+    /**
+     * @return The step&#39;s name, for example &#34;[2/3] RUN make&#34;.
+     * 
+     */
+    public String name() {
+        return this.name;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(BuildStep defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private Double duration;
+        private String name;
+        public Builder() {}
+        public Builder(BuildStep defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.duration = defaults.duration;
+    	      this.name = defaults.name;
+        }
+
+        @CustomType.Setter
+        public Builder duration(Double duration) {
+            if (duration == null) {
+              throw new MissingRequiredPropertyException("BuildStep", "duration");
+            }
+            this.duration = duration;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder name(String name) {
+            if (name == null) {
+              throw new MissingRequiredPropertyException("BuildStep", "name");
+            }
+            this.name = name;
+            return this;
+        }
+        public BuildStep build() {
+            final var _resultValue = new BuildStep();
+            _resultValue.duration = duration;
+            _resultValue.name = name;
+            return _resultValue;
+        }
+    }
+}
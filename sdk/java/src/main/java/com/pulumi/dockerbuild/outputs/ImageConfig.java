@@ -0,0 +1,197 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.String;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class ImageConfig {
+    /**
+     * @return The image&#39;s &#34;CMD&#34;.
+     * 
+     */
+    private @Nullable List<String> cmd;
+    /**
+     * @return The image&#39;s &#34;ENTRYPOINT&#34;.
+     * 
+     */
+    private @Nullable List<String> entrypoint;
+    /**
+     * @return Environment variables set by &#34;ENV&#34;, as &#34;KEY=value&#34; pairs.
+     * 
+     */
+    private @Nullable List<String> env;
+    /**
+     * @return Ports declared by `EXPOSE`, for example `8080/tcp`.
+     * 
+     */
+    private @Nullable List<String> exposedPorts;
+    /**
+     * @return The image&#39;s final labels, including any inherited from base images.
+     * 
+     */
+    private @Nullable Map<String,String> labels;
+    /**
+     * @return The image&#39;s &#34;USER&#34;.
+     * 
+     */
+    private @Nullable String user;
+    /**
+     * @return The image&#39;s &#34;WORKDIR&#34;.
+     * 
+     */
+    private @Nullable String workingDir;
+
+    private ImageConfig() {}
+    /**
+     * @return The image&#39;s &#34;CMD&#34;.
+     * 
+     */
+    public List<String> cmd() {
+        return this.cmd == null ? List.of() : this.cmd;
+    }
+    /**
+     * @return The image&#39;s &#34;ENTRYPOINT&#34;.
+     * 
+     */
+    public List<String> entrypoint() {
+        return this.entrypoint == null ? List.of() : this.entrypoint;
+    }
+    /**
+     * @return Environment variables set by &#34;ENV&#34;, as &#34;KEY=value&#34; pairs.
+     * 
+     */
+    public List<String> env() {
+        return this.env == null ? List.of() : this.env;
+    }
+    /**
+     * @return Ports declared by `EXPOSE`, for example `8080/tcp`.
+     * 
+     */
+    public List<String> exposedPorts() {
+        return this.exposedPorts == null ? List.of() : this.exposedPorts;
+    }
+    /**
+     * @return The image&#39;s final labels, including any inherited from base images.
+     * 
+     */
+    public Map<String,String> labels() {
+        return this.labels == null ? Map.of() : this.labels;
+    }
+    /**
+     * @return The image&#39;s &#34;USER&#34;.
+     * 
+     */
+    public Optional<String> user() {
+        return Optional.ofNullable(this.user);
+    }
+    /**
+     * @return The image&#39;s &#34;WORKDIR&#34;.
+     * 
+     */
+    public Optional<String> workingDir() {
+        return Optional.ofNullable(this.workingDir);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(ImageConfig defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable List<String> cmd;
+        private @Nullable List<String> entrypoint;
+        private @Nullable List<String> env;
+        private @Nullable List<String> exposedPorts;
+        private @Nullable Map<String,String> labels;
+        private @Nullable String user;
+        private @Nullable String workingDir;
+        public Builder() {}
+        public Builder(ImageConfig defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.cmd = defaults.cmd;
+    	      this.entrypoint = defaults.entrypoint;
+    	      this.env = defaults.env;
+    	      this.exposedPorts = defaults.exposedPorts;
+    	      this.labels = defaults.labels;
+    	      this.user = defaults.user;
+    	      this.workingDir = defaults.workingDir;
+        }
+
+        @CustomType.Setter
+        public Builder cmd(@Nullable List<String> cmd) {
+
+            this.cmd = cmd;
+            return this;
+        }
+        public Builder cmd(String... cmd) {
+            return cmd(List.of(cmd));
+        }
+        @CustomType.Setter
+        public Builder entrypoint(@Nullable List<String> entrypoint) {
+
+            this.entrypoint = entrypoint;
+            return this;
+        }
+        public Builder entrypoint(String... entrypoint) {
+            return entrypoint(List.of(entrypoint));
+        }
+        @CustomType.Setter
+        public Builder env(@Nullable List<String> env) {
+
+            this.env = env;
+            return this;
+        }
+        public Builder env(String... env) {
+            return env(List.of(env));
+        }
+        @CustomType.Setter
+        public Builder exposedPorts(@Nullable List<String> exposedPorts) {
+
+            this.exposedPorts = exposedPorts;
+            return this;
+        }
+        public Builder exposedPorts(String... exposedPorts) {
+            return exposedPorts(List.of(exposedPorts));
+        }
+        @CustomType.Setter
+        public Builder labels(@Nullable Map<String,String> labels) {
+
+            this.labels = labels;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder user(@Nullable String user) {
+
+            this.user = user;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder workingDir(@Nullable String workingDir) {
+
+            this.workingDir = workingDir;
+            return this;
+        }
+        public ImageConfig build() {
+            final var _resultValue = new ImageConfig();
+            _resultValue.cmd = cmd;
+            _resultValue.entrypoint = entrypoint;
+            _resultValue.env = env;
+            _resultValue.exposedPorts = exposedPorts;
+            _resultValue.labels = labels;
+            _resultValue.user = user;
+            _resultValue.workingDir = workingDir;
+            return _resultValue;
+        }
+    }
+}
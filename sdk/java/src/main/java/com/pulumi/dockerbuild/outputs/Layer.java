@@ -0,0 +1,105 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+
+@CustomType
+public final class Layer {
+    /**
+     * @return The layer&#39;s content digest.
+     * 
+     */
+    private String digest;
+    /**
+     * @return The layer&#39;s media type, for example &#34;application/vnd.oci.image.layer.v1.tar+gzip&#34;.
+     * 
+     */
+    private String mediaType;
+    /**
+     * @return The layer&#39;s compressed size in bytes.
+     * 
+     */
+    private Integer size;
+
+    private Layer() {}
+    /**
+     * @return The layer&#39;s content digest.
+     * 
+     */
+    public String digest() {
+        return this.digest;
+    }
+    /**
+     * @return The layer&#39;s media type, for example &#34;application/vnd.oci.image.layer.v1.tar+gzip&#34;.
+     * 
+     */
+    public String mediaType() {
+        return this.mediaType;
+    }
+    /**
+     * @return The layer&#39;s compressed size in bytes.
+     * 
+     */
+    public Integer size() {
+        return this.size;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(Layer defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private String digest;
+        private String mediaType;
+        private Integer size;
+        public Builder() {}
+        public Builder(Layer defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.digest = defaults.digest;
+    	      this.mediaType = defaults.mediaType;
+    	      this.size = defaults.size;
+        }
+
+        @CustomType.Setter
+        public Builder digest(String digest) {
+            if (digest == null) {
+              throw new MissingRequiredPropertyException("Layer", "digest");
+            }
+            this.digest = digest;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder mediaType(String mediaType) {
+            if (mediaType == null) {
+              throw new MissingRequiredPropertyException("Layer", "mediaType");
+            }
+            this.mediaType = mediaType;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder size(Integer size) {
+            if (size == null) {
+              throw new MissingRequiredPropertyException("Layer", "size");
+            }
+            this.size = size;
+            return this;
+        }
+        public Layer build() {
+            final var _resultValue = new Layer();
+            _resultValue.digest = digest;
+            _resultValue.mediaType = mediaType;
+            _resultValue.size = size;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,91 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.Boolean;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class Lint {
+    /**
+     * @return Report lint violations as errors instead of warnings.
+     * 
+     * Defaults to the Dockerfile&#39;s `# check=error=...` directive.
+     * 
+     */
+    private @Nullable Boolean error;
+    /**
+     * @return Names of lint rules to skip, like `StageNameCasing`, or `all` to
+     * disable linting. Rules skipped by the Dockerfile&#39;s `# check=skip=...`
+     * directive are also skipped.
+     * 
+     */
+    private @Nullable List<String> skip;
+
+    private Lint() {}
+    /**
+     * @return Report lint violations as errors instead of warnings.
+     * 
+     * Defaults to the Dockerfile&#39;s `# check=error=...` directive.
+     * 
+     */
+    public Optional<Boolean> error() {
+        return Optional.ofNullable(this.error);
+    }
+    /**
+     * @return Names of lint rules to skip, like `StageNameCasing`, or `all` to
+     * disable linting. Rules skipped by the Dockerfile&#39;s `# check=skip=...`
+     * directive are also skipped.
+     * 
+     */
+    public List<String> skip() {
+        return this.skip == null ? List.of() : this.skip;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(Lint defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable Boolean error;
+        private @Nullable List<String> skip;
+        public Builder() {}
+        public Builder(Lint defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.error = defaults.error;
+    	      this.skip = defaults.skip;
+        }
+
+        @CustomType.Setter
+        public Builder error(@Nullable Boolean error) {
+
+            this.error = error;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder skip(@Nullable List<String> skip) {
+
+            this.skip = skip;
+            return this;
+        }
+        public Builder skip(String... skip) {
+            return skip(List.of(skip));
+        }
+        public Lint build() {
+            final var _resultValue = new Lint();
+            _resultValue.error = error;
+            _resultValue.skip = skip;
+            return _resultValue;
+        }
+    }
+}
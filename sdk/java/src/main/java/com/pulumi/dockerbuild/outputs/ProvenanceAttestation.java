@@ -0,0 +1,126 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.dockerbuild.enums.ProvenanceMode;
+import java.lang.Boolean;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class ProvenanceAttestation {
+    /**
+     * @return An explicit builder ID to record in the provenance, for example the
+     * URL of the CI job performing the build.
+     * 
+     */
+    private @Nullable String builderId;
+    /**
+     * @return When `true` no provenance will be generated. Defaults to `false`.
+     * 
+     */
+    private @Nullable Boolean disabled;
+    /**
+     * @return Only attach provenance to exporters which embed it in the image
+     * (e.g. `image` and `registry`). Defaults to `false`.
+     * 
+     */
+    private @Nullable Boolean inlineOnly;
+    /**
+     * @return The level of detail to include in the provenance. Defaults to `min`.
+     * 
+     */
+    private @Nullable ProvenanceMode mode;
+
+    private ProvenanceAttestation() {}
+    /**
+     * @return An explicit builder ID to record in the provenance, for example the
+     * URL of the CI job performing the build.
+     * 
+     */
+    public Optional<String> builderId() {
+        return Optional.ofNullable(this.builderId);
+    }
+    /**
+     * @return When `true` no provenance will be generated. Defaults to `false`.
+     * 
+     */
+    public Optional<Boolean> disabled() {
+        return Optional.ofNullable(this.disabled);
+    }
+    /**
+     * @return Only attach provenance to exporters which embed it in the image
+     * (e.g. `image` and `registry`). Defaults to `false`.
+     * 
+     */
+    public Optional<Boolean> inlineOnly() {
+        return Optional.ofNullable(this.inlineOnly);
+    }
+    /**
+     * @return The level of detail to include in the provenance. Defaults to `min`.
+     * 
+     */
+    public Optional<ProvenanceMode> mode() {
+        return Optional.ofNullable(this.mode);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(ProvenanceAttestation defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable String builderId;
+        private @Nullable Boolean disabled;
+        private @Nullable Boolean inlineOnly;
+        private @Nullable ProvenanceMode mode;
+        public Builder() {}
+        public Builder(ProvenanceAttestation defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.builderId = defaults.builderId;
+    	      this.disabled = defaults.disabled;
+    	      this.inlineOnly = defaults.inlineOnly;
+    	      this.mode = defaults.mode;
+        }
+
+        @CustomType.Setter
+        public Builder builderId(@Nullable String builderId) {
+
+            this.builderId = builderId;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder disabled(@Nullable Boolean disabled) {
+
+            this.disabled = disabled;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder inlineOnly(@Nullable Boolean inlineOnly) {
+
+            this.inlineOnly = inlineOnly;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder mode(@Nullable ProvenanceMode mode) {
+
+            this.mode = mode;
+            return this;
+        }
+        public ProvenanceAttestation build() {
+            final var _resultValue = new ProvenanceAttestation();
+            _resultValue.builderId = builderId;
+            _resultValue.disabled = disabled;
+            _resultValue.inlineOnly = inlineOnly;
+            _resultValue.mode = mode;
+            return _resultValue;
+        }
+    }
+}
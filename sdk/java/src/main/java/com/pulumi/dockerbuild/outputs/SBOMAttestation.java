@@ -0,0 +1,133 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.Boolean;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class SBOMAttestation {
+    /**
+     * @return When `true` no SBOM will be generated. Defaults to `false`.
+     * 
+     */
+    private @Nullable Boolean disabled;
+    /**
+     * @return The scanner image used to generate the SBOM.
+     * 
+     * Defaults to BuildKit&#39;s `docker/buildkit-syft-scanner`.
+     * 
+     */
+    private @Nullable String generator;
+    /**
+     * @return Also scan the build context, in addition to the final image.
+     * 
+     * Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+     * 
+     */
+    private @Nullable Boolean scanContext;
+    /**
+     * @return Also scan intermediate build stages, in addition to the final image.
+     * 
+     * Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+     * 
+     */
+    private @Nullable Boolean scanStage;
+
+    private SBOMAttestation() {}
+    /**
+     * @return When `true` no SBOM will be generated. Defaults to `false`.
+     * 
+     */
+    public Optional<Boolean> disabled() {
+        return Optional.ofNullable(this.disabled);
+    }
+    /**
+     * @return The scanner image used to generate the SBOM.
+     * 
+     * Defaults to BuildKit&#39;s `docker/buildkit-syft-scanner`.
+     * 
+     */
+    public Optional<String> generator() {
+        return Optional.ofNullable(this.generator);
+    }
+    /**
+     * @return Also scan the build context, in addition to the final image.
+     * 
+     * Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+     * 
+     */
+    public Optional<Boolean> scanContext() {
+        return Optional.ofNullable(this.scanContext);
+    }
+    /**
+     * @return Also scan intermediate build stages, in addition to the final image.
+     * 
+     * Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+     * 
+     */
+    public Optional<Boolean> scanStage() {
+        return Optional.ofNullable(this.scanStage);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(SBOMAttestation defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable Boolean disabled;
+        private @Nullable String generator;
+        private @Nullable Boolean scanContext;
+        private @Nullable Boolean scanStage;
+        public Builder() {}
+        public Builder(SBOMAttestation defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.disabled = defaults.disabled;
+    	      this.generator = defaults.generator;
+    	      this.scanContext = defaults.scanContext;
+    	      this.scanStage = defaults.scanStage;
+        }
+
+        @CustomType.Setter
+        public Builder disabled(@Nullable Boolean disabled) {
+
+            this.disabled = disabled;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder generator(@Nullable String generator) {
+
+            this.generator = generator;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder scanContext(@Nullable Boolean scanContext) {
+
+            this.scanContext = scanContext;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder scanStage(@Nullable Boolean scanStage) {
+
+            this.scanStage = scanStage;
+            return this;
+        }
+        public SBOMAttestation build() {
+            final var _resultValue = new SBOMAttestation();
+            _resultValue.disabled = disabled;
+            _resultValue.generator = generator;
+            _resultValue.scanContext = scanContext;
+            _resultValue.scanStage = scanStage;
+            return _resultValue;
+        }
+    }
+}
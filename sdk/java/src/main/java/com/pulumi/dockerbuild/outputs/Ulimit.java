@@ -0,0 +1,105 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.dockerbuild.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class Ulimit {
+    /**
+     * @return The hard limit. Defaults to the soft limit.
+     * 
+     */
+    private @Nullable Integer hard;
+    /**
+     * @return The name of the limit, for example `nofile` or `nproc`.
+     * 
+     */
+    private String name;
+    /**
+     * @return The soft limit. Use `-1` for unlimited.
+     * 
+     */
+    private Integer soft;
+
+    private Ulimit() {}
+    /**
+     * @return The hard limit. Defaults to the soft limit.
+     * 
+     */
+    public Optional<Integer> hard() {
+        return Optional.ofNullable(this.hard);
+    }
+    /**
+     * @return The name of the limit, for example `nofile` or `nproc`.
+     * 
+     */
+    public String name() {
+        return this.name;
+    }
+    /**
+     * @return The soft limit. Use `-1` for unlimited.
+     * 
+     */
+    public Integer soft() {
+        return this.soft;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(Ulimit defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable Integer hard;
+        private String name;
+        private Integer soft;
+        public Builder() {}
+        public Builder(Ulimit defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.hard = defaults.hard;
+    	      this.name = defaults.name;
+    	      this.soft = defaults.soft;
+        }
+
+        @CustomType.Setter
+        public Builder hard(@Nullable Integer hard) {
+
+            this.hard = hard;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder name(String name) {
+            if (name == null) {
+              throw new MissingRequiredPropertyException("Ulimit", "name");
+            }
+            this.name = name;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder soft(Integer soft) {
+            if (soft == null) {
+              throw new MissingRequiredPropertyException("Ulimit", "soft");
+            }
+            this.soft = soft;
+            return this;
+        }
+        public Ulimit build() {
+            final var _resultValue = new Ulimit();
+            _resultValue.hard = hard;
+            _resultValue.name = name;
+            _resultValue.soft = soft;
+            return _resultValue;
+        }
+    }
+}
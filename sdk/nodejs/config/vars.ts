@@ -10,6 +10,49 @@ import * as utilities from "../utilities";
 declare var exports: any;
 const __config = new pulumi.Config("docker-build");
 
+/**
+ * Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+ * instructions must match. This applies in addition to each image's own
+ * `allowedBaseImages`.
+ */
+export declare const allowedBaseImages: string[] | undefined;
+Object.defineProperty(exports, "allowedBaseImages", {
+    get() {
+        return __config.getObject<string[]>("allowedBaseImages");
+    },
+    enumerable: true,
+});
+
+/**
+ * A directory to write the full log of each image build to, for images
+ * which don't specify their own `buildLogPath`.
+ *
+ * Logs are named after the image's first tag, or the resource's name if
+ * it has no tags.
+ */
+export declare const buildLogDir: string | undefined;
+Object.defineProperty(exports, "buildLogDir", {
+    get() {
+        return __config.get("buildLogDir");
+    },
+    enumerable: true,
+});
+
+/**
+ * A directory to cache build context hashes in, so files which haven't
+ * changed aren't read again on later runs.
+ *
+ * Files are considered unchanged if their size, modification time and
+ * inode are the same. The resulting `contextHash` is unaffected.
+ */
+export declare const contextHashCacheDir: string | undefined;
+Object.defineProperty(exports, "contextHashCacheDir", {
+    get() {
+        return __config.get("contextHashCacheDir");
+    },
+    enumerable: true,
+});
+
 /**
  * The build daemon's address.
  */
@@ -21,6 +64,33 @@ Object.defineProperty(exports, "host", {
     enumerable: true,
 });
 
+/**
+ * An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+ * of resource operations and builds to. gRPC endpoints aren't supported.
+ *
+ * Spans join the trace in the `TRACEPARENT` environment variable, if set,
+ * and trace context is propagated to the build daemon.
+ */
+export declare const otlpEndpoint: string;
+Object.defineProperty(exports, "otlpEndpoint", {
+    get() {
+        return __config.get("otlpEndpoint") ?? (utilities.getEnv("PULUMI_DOCKER_BUILD_OTLP_ENDPOINT") || "");
+    },
+    enumerable: true,
+});
+
+/**
+ * How to report build progress for images which don't specify their own
+ * `progress`. Defaults to `plain`.
+ */
+export declare const progress: enums.ProgressMode | undefined;
+Object.defineProperty(exports, "progress", {
+    get() {
+        return __config.getObject<enums.ProgressMode>("progress");
+    },
+    enumerable: true,
+});
+
 export declare const registries: outputs.Registry[] | undefined;
 Object.defineProperty(exports, "registries", {
     get() {
@@ -29,3 +99,28 @@ Object.defineProperty(exports, "registries", {
     enumerable: true,
 });
 
+/**
+ * Require external `FROM` and `COPY --from` images to be pinned with an
+ * `@sha256:` digest, for images which don't specify their own
+ * `requirePinnedBaseImages`.
+ */
+export declare const requirePinnedBaseImages: boolean | undefined;
+Object.defineProperty(exports, "requirePinnedBaseImages", {
+    get() {
+        return __config.getObject<boolean>("requirePinnedBaseImages");
+    },
+    enumerable: true,
+});
+
+/**
+ * Fail image builds if BuildKit reports any warnings, for images which
+ * don't specify their own `warningsAsErrors`.
+ */
+export declare const warningsAsErrors: boolean | undefined;
+Object.defineProperty(exports, "warningsAsErrors", {
+    get() {
+        return __config.getObject<boolean>("warningsAsErrors");
+    },
+    enumerable: true,
+});
+
@@ -502,6 +502,72 @@ export class Image extends pulumi.CustomResource {
      * Equivalent to Docker's `--add-host` flag.
      */
     declare public readonly addHosts: pulumi.Output<string[] | undefined>;
+    /**
+     * Extra privileges to grant to the build.
+     *
+     * The builder must also be configured to permit these entitlements,
+     * for example with `--allow-insecure-entitlement`.
+     *
+     * Equivalent to Docker's `--allow` flag.
+     */
+    declare public readonly allow: pulumi.Output<enums.Entitlement[] | undefined>;
+    /**
+     * Glob patterns, like `docker.io/library/*` or
+     * `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+     * are matched against fully-qualified references such as
+     * `docker.io/library/alpine:latest` and their repository names, and `*`
+     * doesn't match `/`.
+     *
+     * ARGs are substituted from `buildArgs`. References to other stages and
+     * to `named` contexts are not restricted.
+     *
+     * Images must also match the provider's `allowedBaseImages`, if set.
+     */
+    declare public readonly allowedBaseImages: pulumi.Output<string[] | undefined>;
+    /**
+     * Attach arbitrary key/value annotations to the image's manifests.
+     *
+     * Keys may be prefixed with the level to annotate -- `manifest`,
+     * `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+     * qualified with a platform, for example
+     * `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+     * levels can be separated by commas. Defaults to `manifest`.
+     *
+     * Annotations are applied to every `image`, `registry`, `oci` and
+     * `docker` export. Annotations set directly on an export take
+     * precedence.
+     *
+     * Equivalent to Docker's `--annotation` flag.
+     */
+    declare public readonly annotations: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * Attestations to attach to the image, such as an SBOM or SLSA
+     * provenance.
+     *
+     * Attestations are only exported with `image`, `registry` or `oci`
+     * exports, and require a builder which supports them (the legacy
+     * `docker` driver does not).
+     *
+     * Equivalent to Docker's `--attest` flag.
+     */
+    declare public readonly attestations: pulumi.Output<outputs.Attestations | undefined>;
+    /**
+     * The digest each external `FROM` image resolved to when the image was
+     * built, keyed by its fully-qualified reference.
+     *
+     * Only recorded for images with `pull`, which are rebuilt when any of
+     * these digests has moved.
+     */
+    declare public /*out*/ readonly baseImageDigests: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * Pin every external `FROM` and `COPY --from` image to the digest it
+     * resolved to on the first build.
+     *
+     * Later builds substitute `docker-image://<ref>@<digest>` named
+     * contexts for those images, so they don't change until the lock's
+     * `refresh` value does.
+     */
+    declare public readonly baseImageLock: pulumi.Output<outputs.BaseImageLock | undefined>;
     /**
      * `ARG` names and values to set during the build.
      *
@@ -514,6 +580,19 @@ export class Image extends pulumi.CustomResource {
      * Equivalent to Docker's `--build-arg` flag.
      */
     declare public readonly buildArgs: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * A file to write the build's full log to, including anything written to
+     * stderr. The log is written whether or not the build succeeds, which is
+     * useful for archiving logs from CI.
+     *
+     * Defaults to a file in the provider's `buildLogDir`, if set.
+     */
+    declare public readonly buildLogPath: pulumi.Output<string | undefined>;
+    /**
+     * Metadata returned by buildkit after the build, useful for tracing an
+     * image back to its buildx history.
+     */
+    declare public /*out*/ readonly buildMetadata: pulumi.Output<outputs.BuildMetadata | undefined>;
     /**
      * Setting this to `false` will always skip image builds during previews,
      * and setting it to `true` will always build images during previews.
@@ -528,6 +607,30 @@ export class Image extends pulumi.CustomResource {
      * of CI pipelines.
      */
     declare public readonly buildOnPreview: pulumi.Output<boolean | undefined>;
+    /**
+     * A directory to export the build's history record to after it succeeds
+     * or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+     * `buildRecordPath`.
+     *
+     * Equivalent to running `docker buildx history export` after the build.
+     * Records of failed builds are not available when `exec` is `true`.
+     */
+    declare public readonly buildRecordDir: pulumi.Output<string | undefined>;
+    /**
+     * The path of the most recent build's exported history record, if
+     * `buildRecordDir` is set.
+     *
+     * The record can be opened with Docker Desktop's Builds view or imported
+     * with `docker buildx history import`.
+     */
+    declare public /*out*/ readonly buildRecordPath: pulumi.Output<string | undefined>;
+    /**
+     * Statistics about the most recent build, like its duration and how many
+     * steps were cached.
+     *
+     * Not available when `exec` is `true`.
+     */
+    declare public /*out*/ readonly buildStats: pulumi.Output<outputs.BuildStats | undefined>;
     /**
      * Builder configuration.
      */
@@ -544,6 +647,27 @@ export class Image extends pulumi.CustomResource {
      * Equivalent to Docker's `--cache-to` flag.
      */
     declare public readonly cacheTo: pulumi.Output<outputs.CacheTo[] | undefined>;
+    /**
+     * Set the parent cgroup for `RUN` instructions.
+     *
+     * Equivalent to Docker's `--cgroup-parent` flag.
+     */
+    declare public readonly cgroupParent: pulumi.Output<string | undefined>;
+    /**
+     * The total compressed size in bytes of each platform's pushed layers,
+     * keyed by platform in the same way as `platformDigests`.
+     *
+     * Empty if the image was not pushed to a registry.
+     */
+    declare public /*out*/ readonly compressedSize: pulumi.Output<{[key: string]: number} | undefined>;
+    /**
+     * Runtime configuration recorded in each platform's pushed image, such
+     * as its entrypoint, environment and exposed ports. Keyed by platform
+     * in the same way as `platformDigests`.
+     *
+     * Empty if the image was not pushed to a registry.
+     */
+    declare public /*out*/ readonly config: pulumi.Output<{[key: string]: outputs.ImageConfig} | undefined>;
     /**
      * Build context settings. Defaults to the current directory.
      *
@@ -556,6 +680,13 @@ export class Image extends pulumi.CustomResource {
      * Pulumi uses this to determine if an image _may_ need to be re-built.
      */
     declare public /*out*/ readonly contextHash: pulumi.Output<string>;
+    /**
+     * A compressed record of each file contributing to `contextHash`, used
+     * to explain which files changed when the image needs to be re-built.
+     *
+     * Empty for contexts with more than 5000 files.
+     */
+    declare public /*out*/ readonly contextManifest: pulumi.Output<string | undefined>;
     /**
      * A SHA256 digest of the image if it was exported to a registry or
      * elsewhere.
@@ -616,12 +747,34 @@ export class Image extends pulumi.CustomResource {
      * This is useful when you want to avoid unnecessary rebuilds caused by short-lived secrets that change on every run.
      */
     declare public readonly ignoreSecretsInDiffCalculation: pulumi.Output<string[] | undefined>;
+    /**
+     * Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+     * warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+     * These warnings are still logged.
+     */
+    declare public readonly ignoreWarnings: pulumi.Output<string[] | undefined>;
     /**
      * Attach arbitrary key/value metadata to the image.
      *
      * Equivalent to Docker's `--label` flag.
      */
     declare public readonly labels: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * The layers of each platform's pushed image, keyed by platform in the
+     * same way as `platformDigests`.
+     *
+     * Empty if the image was not pushed to a registry.
+     */
+    declare public /*out*/ readonly layers: pulumi.Output<{[key: string]: outputs.Layer[]} | undefined>;
+    /**
+     * Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+     * `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+     * building. Violations are reported as warnings unless configured to be
+     * errors.
+     *
+     * Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+     */
+    declare public readonly lint: pulumi.Output<outputs.Lint | undefined>;
     /**
      * When `true` the build will automatically include a `docker` export.
      *
@@ -630,11 +783,19 @@ export class Image extends pulumi.CustomResource {
      * Equivalent to Docker's `--load` flag.
      */
     declare public readonly load: pulumi.Output<boolean | undefined>;
+    /**
+     * The digests each base image is pinned to by `baseImageLock`, keyed by
+     * its fully-qualified reference.
+     */
+    declare public /*out*/ readonly lockedBaseImages: pulumi.Output<{[key: string]: string} | undefined>;
     /**
      * Set the network mode for `RUN` instructions. Defaults to `default`.
      *
      * For custom networks, configure your builder with `--driver-opt network=...`.
      *
+     * The `host` network mode requires the `network.host` entitlement to be
+     * included in `allow`.
+     *
      * Equivalent to Docker's `--network` flag.
      */
     declare public readonly network: pulumi.Output<enums.NetworkMode | undefined>;
@@ -644,15 +805,37 @@ export class Image extends pulumi.CustomResource {
      * Equivalent to Docker's `--no-cache` flag.
      */
     declare public readonly noCache: pulumi.Output<boolean | undefined>;
+    /**
+     * The digest of each platform-specific manifest pushed to a registry,
+     * keyed by platform (for example `linux/arm64`).
+     *
+     * This is useful when a downstream consumer requires a single-platform
+     * image. Attestation manifests are not included. Single-platform images
+     * are keyed by the requested platform, or by the platform recorded in
+     * their config if `platforms` wasn't specified.
+     *
+     * Empty if the image was not pushed to a registry.
+     */
+    declare public /*out*/ readonly platformDigests: pulumi.Output<{[key: string]: string} | undefined>;
     /**
      * Set target platform(s) for the build. Defaults to the host's platform.
      *
      * Equivalent to Docker's `--platform` flag.
      */
     declare public readonly platforms: pulumi.Output<enums.Platform[] | undefined>;
+    /**
+     * How to report build progress. Defaults to the provider's `progress`
+     * setting.
+     *
+     * Equivalent to Docker's `--progress` flag.
+     */
+    declare public readonly progress: pulumi.Output<enums.ProgressMode | undefined>;
     /**
      * Always pull referenced images.
      *
+     * Images with `pull` are only rebuilt when one of their
+     * `baseImageDigests` has moved, or always if those aren't known.
+     *
      * Equivalent to Docker's `--pull` flag.
      */
     declare public readonly pull: pulumi.Output<boolean | undefined>;
@@ -691,6 +874,15 @@ export class Image extends pulumi.CustomResource {
      * Similar to `docker login`.
      */
     declare public readonly registries: pulumi.Output<outputs.Registry[] | undefined>;
+    /**
+     * Require every external `FROM` and `COPY --from` image to be pinned
+     * with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+     * Failures suggest the image's current digest when it can be looked up
+     * with the configured registry credentials.
+     *
+     * Defaults to the provider's `requirePinnedBaseImages` setting.
+     */
+    declare public readonly requirePinnedBaseImages: pulumi.Output<boolean | undefined>;
     /**
      * A mapping of secret names to their corresponding values.
      *
@@ -703,6 +895,12 @@ export class Image extends pulumi.CustomResource {
      * Similar to Docker's `--secret` flag.
      */
     declare public readonly secrets: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+     *
+     * Equivalent to Docker's `--shm-size` flag.
+     */
+    declare public readonly shmSize: pulumi.Output<string | undefined>;
     /**
      * SSH agent socket or keys to expose to the build.
      *
@@ -726,6 +924,20 @@ export class Image extends pulumi.CustomResource {
      * Equivalent to Docker's `--target` flag.
      */
     declare public readonly target: pulumi.Output<string | undefined>;
+    /**
+     * Resource limits for `RUN` instructions.
+     *
+     * Equivalent to Docker's `--ulimit` flag.
+     */
+    declare public readonly ulimits: pulumi.Output<outputs.Ulimit[] | undefined>;
+    /**
+     * Fail the build if BuildKit reports any warnings, like Dockerfile lint
+     * rule violations or deprecated syntax. Warnings from rules listed in
+     * `ignoreWarnings` are exempt.
+     *
+     * Defaults to the provider's `warningsAsErrors` setting.
+     */
+    declare public readonly warningsAsErrors: pulumi.Output<boolean | undefined>;
 
     /**
      * Create a Image resource with the given unique name, arguments, and options.
@@ -742,58 +954,108 @@ export class Image extends pulumi.CustomResource {
                 throw new Error("Missing required property 'push'");
             }
             resourceInputs["addHosts"] = args?.addHosts;
+            resourceInputs["allow"] = args?.allow;
+            resourceInputs["allowedBaseImages"] = args?.allowedBaseImages;
+            resourceInputs["annotations"] = args?.annotations;
+            resourceInputs["attestations"] = args ? pulumi.output(args.attestations).apply(v => v === undefined ? undefined : inputs.attestationsArgsProvideDefaults(v)) : undefined;
+            resourceInputs["baseImageLock"] = args?.baseImageLock;
             resourceInputs["buildArgs"] = args?.buildArgs;
+            resourceInputs["buildLogPath"] = args?.buildLogPath;
             resourceInputs["buildOnPreview"] = (args?.buildOnPreview) ?? true;
+            resourceInputs["buildRecordDir"] = args?.buildRecordDir;
             resourceInputs["builder"] = args?.builder;
             resourceInputs["cacheFrom"] = args?.cacheFrom;
             resourceInputs["cacheTo"] = args?.cacheTo;
+            resourceInputs["cgroupParent"] = args?.cgroupParent;
             resourceInputs["context"] = args?.context;
             resourceInputs["dockerfile"] = args?.dockerfile;
             resourceInputs["exec"] = args?.exec;
             resourceInputs["exports"] = args?.exports;
             resourceInputs["ignoreSecretsInDiffCalculation"] = args?.ignoreSecretsInDiffCalculation;
+            resourceInputs["ignoreWarnings"] = args?.ignoreWarnings;
             resourceInputs["labels"] = args?.labels;
+            resourceInputs["lint"] = args?.lint;
             resourceInputs["load"] = args?.load;
             resourceInputs["network"] = (args?.network) ?? "default";
             resourceInputs["noCache"] = args?.noCache;
             resourceInputs["platforms"] = args?.platforms;
+            resourceInputs["progress"] = args?.progress;
             resourceInputs["pull"] = args?.pull;
             resourceInputs["push"] = args?.push;
             resourceInputs["registries"] = args?.registries;
+            resourceInputs["requirePinnedBaseImages"] = args?.requirePinnedBaseImages;
             resourceInputs["secrets"] = args?.secrets ? pulumi.secret(args.secrets) : undefined;
+            resourceInputs["shmSize"] = args?.shmSize;
             resourceInputs["ssh"] = args?.ssh;
             resourceInputs["tags"] = args?.tags;
             resourceInputs["target"] = args?.target;
+            resourceInputs["ulimits"] = args?.ulimits;
+            resourceInputs["warningsAsErrors"] = args?.warningsAsErrors;
+            resourceInputs["baseImageDigests"] = undefined /*out*/;
+            resourceInputs["buildMetadata"] = undefined /*out*/;
+            resourceInputs["buildRecordPath"] = undefined /*out*/;
+            resourceInputs["buildStats"] = undefined /*out*/;
+            resourceInputs["compressedSize"] = undefined /*out*/;
+            resourceInputs["config"] = undefined /*out*/;
             resourceInputs["contextHash"] = undefined /*out*/;
+            resourceInputs["contextManifest"] = undefined /*out*/;
             resourceInputs["digest"] = undefined /*out*/;
+            resourceInputs["layers"] = undefined /*out*/;
+            resourceInputs["lockedBaseImages"] = undefined /*out*/;
+            resourceInputs["platformDigests"] = undefined /*out*/;
             resourceInputs["ref"] = undefined /*out*/;
         } else {
             resourceInputs["addHosts"] = undefined /*out*/;
+            resourceInputs["allow"] = undefined /*out*/;
+            resourceInputs["allowedBaseImages"] = undefined /*out*/;
+            resourceInputs["annotations"] = undefined /*out*/;
+            resourceInputs["attestations"] = undefined /*out*/;
+            resourceInputs["baseImageDigests"] = undefined /*out*/;
+            resourceInputs["baseImageLock"] = undefined /*out*/;
             resourceInputs["buildArgs"] = undefined /*out*/;
+            resourceInputs["buildLogPath"] = undefined /*out*/;
+            resourceInputs["buildMetadata"] = undefined /*out*/;
             resourceInputs["buildOnPreview"] = undefined /*out*/;
+            resourceInputs["buildRecordDir"] = undefined /*out*/;
+            resourceInputs["buildRecordPath"] = undefined /*out*/;
+            resourceInputs["buildStats"] = undefined /*out*/;
             resourceInputs["builder"] = undefined /*out*/;
             resourceInputs["cacheFrom"] = undefined /*out*/;
             resourceInputs["cacheTo"] = undefined /*out*/;
+            resourceInputs["cgroupParent"] = undefined /*out*/;
+            resourceInputs["compressedSize"] = undefined /*out*/;
+            resourceInputs["config"] = undefined /*out*/;
             resourceInputs["context"] = undefined /*out*/;
             resourceInputs["contextHash"] = undefined /*out*/;
+            resourceInputs["contextManifest"] = undefined /*out*/;
             resourceInputs["digest"] = undefined /*out*/;
             resourceInputs["dockerfile"] = undefined /*out*/;
             resourceInputs["exec"] = undefined /*out*/;
             resourceInputs["exports"] = undefined /*out*/;
             resourceInputs["ignoreSecretsInDiffCalculation"] = undefined /*out*/;
+            resourceInputs["ignoreWarnings"] = undefined /*out*/;
             resourceInputs["labels"] = undefined /*out*/;
+            resourceInputs["layers"] = undefined /*out*/;
+            resourceInputs["lint"] = undefined /*out*/;
             resourceInputs["load"] = undefined /*out*/;
+            resourceInputs["lockedBaseImages"] = undefined /*out*/;
             resourceInputs["network"] = undefined /*out*/;
             resourceInputs["noCache"] = undefined /*out*/;
+            resourceInputs["platformDigests"] = undefined /*out*/;
             resourceInputs["platforms"] = undefined /*out*/;
+            resourceInputs["progress"] = undefined /*out*/;
             resourceInputs["pull"] = undefined /*out*/;
             resourceInputs["push"] = undefined /*out*/;
             resourceInputs["ref"] = undefined /*out*/;
             resourceInputs["registries"] = undefined /*out*/;
+            resourceInputs["requirePinnedBaseImages"] = undefined /*out*/;
             resourceInputs["secrets"] = undefined /*out*/;
+            resourceInputs["shmSize"] = undefined /*out*/;
             resourceInputs["ssh"] = undefined /*out*/;
             resourceInputs["tags"] = undefined /*out*/;
             resourceInputs["target"] = undefined /*out*/;
+            resourceInputs["ulimits"] = undefined /*out*/;
+            resourceInputs["warningsAsErrors"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         const secretOpts = { additionalSecretOutputs: ["secrets"] };
@@ -812,6 +1074,64 @@ export interface ImageArgs {
      * Equivalent to Docker's `--add-host` flag.
      */
     addHosts?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * Extra privileges to grant to the build.
+     *
+     * The builder must also be configured to permit these entitlements,
+     * for example with `--allow-insecure-entitlement`.
+     *
+     * Equivalent to Docker's `--allow` flag.
+     */
+    allow?: pulumi.Input<pulumi.Input<enums.Entitlement>[] | undefined>;
+    /**
+     * Glob patterns, like `docker.io/library/*` or
+     * `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+     * are matched against fully-qualified references such as
+     * `docker.io/library/alpine:latest` and their repository names, and `*`
+     * doesn't match `/`.
+     *
+     * ARGs are substituted from `buildArgs`. References to other stages and
+     * to `named` contexts are not restricted.
+     *
+     * Images must also match the provider's `allowedBaseImages`, if set.
+     */
+    allowedBaseImages?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * Attach arbitrary key/value annotations to the image's manifests.
+     *
+     * Keys may be prefixed with the level to annotate -- `manifest`,
+     * `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+     * qualified with a platform, for example
+     * `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+     * levels can be separated by commas. Defaults to `manifest`.
+     *
+     * Annotations are applied to every `image`, `registry`, `oci` and
+     * `docker` export. Annotations set directly on an export take
+     * precedence.
+     *
+     * Equivalent to Docker's `--annotation` flag.
+     */
+    annotations?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * Attestations to attach to the image, such as an SBOM or SLSA
+     * provenance.
+     *
+     * Attestations are only exported with `image`, `registry` or `oci`
+     * exports, and require a builder which supports them (the legacy
+     * `docker` driver does not).
+     *
+     * Equivalent to Docker's `--attest` flag.
+     */
+    attestations?: pulumi.Input<inputs.AttestationsArgs | undefined>;
+    /**
+     * Pin every external `FROM` and `COPY --from` image to the digest it
+     * resolved to on the first build.
+     *
+     * Later builds substitute `docker-image://<ref>@<digest>` named
+     * contexts for those images, so they don't change until the lock's
+     * `refresh` value does.
+     */
+    baseImageLock?: pulumi.Input<inputs.BaseImageLockArgs | undefined>;
     /**
      * `ARG` names and values to set during the build.
      *
@@ -824,6 +1144,14 @@ export interface ImageArgs {
      * Equivalent to Docker's `--build-arg` flag.
      */
     buildArgs?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * A file to write the build's full log to, including anything written to
+     * stderr. The log is written whether or not the build succeeds, which is
+     * useful for archiving logs from CI.
+     *
+     * Defaults to a file in the provider's `buildLogDir`, if set.
+     */
+    buildLogPath?: pulumi.Input<string | undefined>;
     /**
      * Setting this to `false` will always skip image builds during previews,
      * and setting it to `true` will always build images during previews.
@@ -838,6 +1166,15 @@ export interface ImageArgs {
      * of CI pipelines.
      */
     buildOnPreview?: pulumi.Input<boolean | undefined>;
+    /**
+     * A directory to export the build's history record to after it succeeds
+     * or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+     * `buildRecordPath`.
+     *
+     * Equivalent to running `docker buildx history export` after the build.
+     * Records of failed builds are not available when `exec` is `true`.
+     */
+    buildRecordDir?: pulumi.Input<string | undefined>;
     /**
      * Builder configuration.
      */
@@ -854,6 +1191,12 @@ export interface ImageArgs {
      * Equivalent to Docker's `--cache-to` flag.
      */
     cacheTo?: pulumi.Input<pulumi.Input<inputs.CacheToArgs>[] | undefined>;
+    /**
+     * Set the parent cgroup for `RUN` instructions.
+     *
+     * Equivalent to Docker's `--cgroup-parent` flag.
+     */
+    cgroupParent?: pulumi.Input<string | undefined>;
     /**
      * Build context settings. Defaults to the current directory.
      *
@@ -910,12 +1253,27 @@ export interface ImageArgs {
      * This is useful when you want to avoid unnecessary rebuilds caused by short-lived secrets that change on every run.
      */
     ignoreSecretsInDiffCalculation?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+     * warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+     * These warnings are still logged.
+     */
+    ignoreWarnings?: pulumi.Input<pulumi.Input<string>[] | undefined>;
     /**
      * Attach arbitrary key/value metadata to the image.
      *
      * Equivalent to Docker's `--label` flag.
      */
     labels?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+     * `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+     * building. Violations are reported as warnings unless configured to be
+     * errors.
+     *
+     * Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+     */
+    lint?: pulumi.Input<inputs.LintArgs | undefined>;
     /**
      * When `true` the build will automatically include a `docker` export.
      *
@@ -929,6 +1287,9 @@ export interface ImageArgs {
      *
      * For custom networks, configure your builder with `--driver-opt network=...`.
      *
+     * The `host` network mode requires the `network.host` entitlement to be
+     * included in `allow`.
+     *
      * Equivalent to Docker's `--network` flag.
      */
     network?: pulumi.Input<enums.NetworkMode | undefined>;
@@ -944,9 +1305,19 @@ export interface ImageArgs {
      * Equivalent to Docker's `--platform` flag.
      */
     platforms?: pulumi.Input<pulumi.Input<enums.Platform>[] | undefined>;
+    /**
+     * How to report build progress. Defaults to the provider's `progress`
+     * setting.
+     *
+     * Equivalent to Docker's `--progress` flag.
+     */
+    progress?: pulumi.Input<enums.ProgressMode | undefined>;
     /**
      * Always pull referenced images.
      *
+     * Images with `pull` are only rebuilt when one of their
+     * `baseImageDigests` has moved, or always if those aren't known.
+     *
      * Equivalent to Docker's `--pull` flag.
      */
     pull?: pulumi.Input<boolean | undefined>;
@@ -968,6 +1339,15 @@ export interface ImageArgs {
      * Similar to `docker login`.
      */
     registries?: pulumi.Input<pulumi.Input<inputs.RegistryArgs>[] | undefined>;
+    /**
+     * Require every external `FROM` and `COPY --from` image to be pinned
+     * with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+     * Failures suggest the image's current digest when it can be looked up
+     * with the configured registry credentials.
+     *
+     * Defaults to the provider's `requirePinnedBaseImages` setting.
+     */
+    requirePinnedBaseImages?: pulumi.Input<boolean | undefined>;
     /**
      * A mapping of secret names to their corresponding values.
      *
@@ -980,6 +1360,12 @@ export interface ImageArgs {
      * Similar to Docker's `--secret` flag.
      */
     secrets?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+     *
+     * Equivalent to Docker's `--shm-size` flag.
+     */
+    shmSize?: pulumi.Input<string | undefined>;
     /**
      * SSH agent socket or keys to expose to the build.
      *
@@ -1003,4 +1389,18 @@ export interface ImageArgs {
      * Equivalent to Docker's `--target` flag.
      */
     target?: pulumi.Input<string | undefined>;
+    /**
+     * Resource limits for `RUN` instructions.
+     *
+     * Equivalent to Docker's `--ulimit` flag.
+     */
+    ulimits?: pulumi.Input<pulumi.Input<inputs.UlimitArgs>[] | undefined>;
+    /**
+     * Fail the build if BuildKit reports any warnings, like Dockerfile lint
+     * rule violations or deprecated syntax. Warnings from rules listed in
+     * `ignoreWarnings` are exempt.
+     *
+     * Defaults to the provider's `warningsAsErrors` setting.
+     */
+    warningsAsErrors?: pulumi.Input<boolean | undefined>;
 }
@@ -22,10 +22,39 @@ export class Provider extends pulumi.ProviderResource {
         return obj['__pulumiType'] === "pulumi:providers:" + Provider.__pulumiType;
     }
 
+    /**
+     * A directory to write the full log of each image build to, for images
+     * which don't specify their own `buildLogPath`.
+     *
+     * Logs are named after the image's first tag, or the resource's name if
+     * it has no tags.
+     */
+    declare public readonly buildLogDir: pulumi.Output<string | undefined>;
+    /**
+     * A directory to cache build context hashes in, so files which haven't
+     * changed aren't read again on later runs.
+     *
+     * Files are considered unchanged if their size, modification time and
+     * inode are the same. The resulting `contextHash` is unaffected.
+     */
+    declare public readonly contextHashCacheDir: pulumi.Output<string | undefined>;
     /**
      * The build daemon's address.
      */
     declare public readonly host: pulumi.Output<string | undefined>;
+    /**
+     * An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+     * of resource operations and builds to. gRPC endpoints aren't supported.
+     *
+     * Spans join the trace in the `TRACEPARENT` environment variable, if set,
+     * and trace context is propagated to the build daemon.
+     */
+    declare public readonly otlpEndpoint: pulumi.Output<string | undefined>;
+    /**
+     * How to report build progress for images which don't specify their own
+     * `progress`. Defaults to `plain`.
+     */
+    declare public readonly progress: pulumi.Output<enums.ProgressMode | undefined>;
 
     /**
      * Create a Provider resource with the given unique name, arguments, and options.
@@ -38,8 +67,15 @@ export class Provider extends pulumi.ProviderResource {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         {
+            resourceInputs["allowedBaseImages"] = pulumi.output(args?.allowedBaseImages).apply(JSON.stringify);
+            resourceInputs["buildLogDir"] = args?.buildLogDir;
+            resourceInputs["contextHashCacheDir"] = args?.contextHashCacheDir;
             resourceInputs["host"] = (args?.host) ?? (utilities.getEnv("DOCKER_HOST") || "");
+            resourceInputs["otlpEndpoint"] = (args?.otlpEndpoint) ?? (utilities.getEnv("PULUMI_DOCKER_BUILD_OTLP_ENDPOINT") || "");
+            resourceInputs["progress"] = args?.progress;
             resourceInputs["registries"] = pulumi.output(args?.registries).apply(JSON.stringify);
+            resourceInputs["requirePinnedBaseImages"] = pulumi.output(args?.requirePinnedBaseImages).apply(JSON.stringify);
+            resourceInputs["warningsAsErrors"] = pulumi.output(args?.warningsAsErrors).apply(JSON.stringify);
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         super(Provider.__pulumiType, name, resourceInputs, opts);
@@ -50,9 +86,55 @@ export class Provider extends pulumi.ProviderResource {
  * The set of arguments for constructing a Provider resource.
  */
 export interface ProviderArgs {
+    /**
+     * Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+     * instructions must match. This applies in addition to each image's own
+     * `allowedBaseImages`.
+     */
+    allowedBaseImages?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * A directory to write the full log of each image build to, for images
+     * which don't specify their own `buildLogPath`.
+     *
+     * Logs are named after the image's first tag, or the resource's name if
+     * it has no tags.
+     */
+    buildLogDir?: pulumi.Input<string | undefined>;
+    /**
+     * A directory to cache build context hashes in, so files which haven't
+     * changed aren't read again on later runs.
+     *
+     * Files are considered unchanged if their size, modification time and
+     * inode are the same. The resulting `contextHash` is unaffected.
+     */
+    contextHashCacheDir?: pulumi.Input<string | undefined>;
     /**
      * The build daemon's address.
      */
     host?: pulumi.Input<string | undefined>;
+    /**
+     * An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+     * of resource operations and builds to. gRPC endpoints aren't supported.
+     *
+     * Spans join the trace in the `TRACEPARENT` environment variable, if set,
+     * and trace context is propagated to the build daemon.
+     */
+    otlpEndpoint?: pulumi.Input<string | undefined>;
+    /**
+     * How to report build progress for images which don't specify their own
+     * `progress`. Defaults to `plain`.
+     */
+    progress?: pulumi.Input<enums.ProgressMode | undefined>;
     registries?: pulumi.Input<pulumi.Input<inputs.RegistryArgs>[] | undefined>;
+    /**
+     * Require external `FROM` and `COPY --from` images to be pinned with an
+     * `@sha256:` digest, for images which don't specify their own
+     * `requirePinnedBaseImages`.
+     */
+    requirePinnedBaseImages?: pulumi.Input<boolean | undefined>;
+    /**
+     * Fail image builds if BuildKit reports any warnings, for images which
+     * don't specify their own `warningsAsErrors`.
+     */
+    warningsAsErrors?: pulumi.Input<boolean | undefined>;
 }
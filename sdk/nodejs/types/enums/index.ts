@@ -32,6 +32,32 @@ export const CompressionType = {
 
 export type CompressionType = (typeof CompressionType)[keyof typeof CompressionType];
 
+export const ContextHashMode = {
+    /**
+     * Hash every file in the context which isn't excluded by ".dockerignore".
+     */
+    All: "all",
+    /**
+     * Hash only the files reachable from "COPY" and "ADD" sources, falling back to every file when they can't be determined statically.
+     */
+    Referenced: "referenced",
+} as const;
+
+export type ContextHashMode = (typeof ContextHashMode)[keyof typeof ContextHashMode];
+
+export const Entitlement = {
+    /**
+     * Allow "RUN --network=host" and the "host" network mode.
+     */
+    Network_host: "network.host",
+    /**
+     * Allow "RUN --security=insecure" to run privileged containers.
+     */
+    Security_insecure: "security.insecure",
+} as const;
+
+export type Entitlement = (typeof Entitlement)[keyof typeof Entitlement];
+
 export const NetworkMode = {
     /**
      * The default sandbox network mode.
@@ -81,3 +107,33 @@ export const Platform = {
 } as const;
 
 export type Platform = (typeof Platform)[keyof typeof Platform];
+
+export const ProgressMode = {
+    /**
+     * Stream plain-text progress as the build runs.
+     */
+    Plain: "plain",
+    /**
+     * Log each build status update as a line of JSON.
+     */
+    Rawjson: "rawjson",
+    /**
+     * Only report progress if the build fails.
+     */
+    Quiet: "quiet",
+} as const;
+
+export type ProgressMode = (typeof ProgressMode)[keyof typeof ProgressMode];
+
+export const ProvenanceMode = {
+    /**
+     * Only include a minimal description of the build.
+     */
+    Min: "min",
+    /**
+     * Include detailed information about the build, including build arguments and source mappings.
+     */
+    Max: "max",
+} as const;
+
+export type ProvenanceMode = (typeof ProvenanceMode)[keyof typeof ProvenanceMode];
@@ -8,7 +8,62 @@ import * as enums from "../types/enums";
 
 import * as utilities from "../utilities";
 
+export interface AttestationsArgs {
+    /**
+     * Generate SLSA provenance for the build.
+     *
+     * Provenance is disabled unless configured here.
+     *
+     * Equivalent to Docker's `--attest type=provenance` flag.
+     */
+    provenance?: pulumi.Input<inputs.ProvenanceAttestationArgs | undefined>;
+    /**
+     * Generate a Software Bill of Materials (SBOM) for the image.
+     *
+     * Equivalent to Docker's `--attest type=sbom` flag.
+     */
+    sbom?: pulumi.Input<inputs.SBOMAttestationArgs | undefined>;
+}
+/**
+ * attestationsArgsProvideDefaults sets the appropriate defaults for AttestationsArgs
+ */
+export function attestationsArgsProvideDefaults(val: AttestationsArgs): AttestationsArgs {
+    return {
+        ...val,
+        provenance: pulumi.output(val.provenance).apply(v => v === undefined ? undefined : inputs.provenanceAttestationArgsProvideDefaults(v)),
+    };
+}
+
+export interface BaseImageLockArgs {
+    /**
+     * A lockfile to record digests in, like `Dockerfile.lock`. Relative
+     * paths are resolved from the Dockerfile's directory, or the context's
+     * for inline Dockerfiles.
+     *
+     * Digests are recorded in the resource's state if this isn't set.
+     */
+    path?: pulumi.Input<string | undefined>;
+    /**
+     * An arbitrary value, like a date, which re-resolves every base image
+     * when it changes.
+     */
+    refresh?: pulumi.Input<string | undefined>;
+}
+
 export interface BuildContextArgs {
+    /**
+     * Which files in local contexts contribute to the `contextHash` used to
+     * decide whether the image needs to be rebuilt.
+     *
+     * With `referenced`, only the paths copied by `COPY` and `ADD`
+     * instructions are hashed, so changes to other files don't trigger a
+     * rebuild. Every file is hashed if those paths can't be determined
+     * statically -- for example when the Dockerfile is remote or a `RUN`
+     * instruction bind-mounts a context.
+     *
+     * Defaults to `all`.
+     */
+    hashMode?: pulumi.Input<enums.ContextHashMode | undefined>;
     /**
      * Resources to use for build context.
      *
@@ -845,6 +900,51 @@ export interface ExportTarArgs {
     dest: pulumi.Input<string>;
 }
 
+export interface LintArgs {
+    /**
+     * Report lint violations as errors instead of warnings.
+     *
+     * Defaults to the Dockerfile's `# check=error=...` directive.
+     */
+    error?: pulumi.Input<boolean | undefined>;
+    /**
+     * Names of lint rules to skip, like `StageNameCasing`, or `all` to
+     * disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+     * directive are also skipped.
+     */
+    skip?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+}
+
+export interface ProvenanceAttestationArgs {
+    /**
+     * An explicit builder ID to record in the provenance, for example the
+     * URL of the CI job performing the build.
+     */
+    builderId?: pulumi.Input<string | undefined>;
+    /**
+     * When `true` no provenance will be generated. Defaults to `false`.
+     */
+    disabled?: pulumi.Input<boolean | undefined>;
+    /**
+     * Only attach provenance to exporters which embed it in the image
+     * (e.g. `image` and `registry`). Defaults to `false`.
+     */
+    inlineOnly?: pulumi.Input<boolean | undefined>;
+    /**
+     * The level of detail to include in the provenance. Defaults to `min`.
+     */
+    mode?: pulumi.Input<enums.ProvenanceMode | undefined>;
+}
+/**
+ * provenanceAttestationArgsProvideDefaults sets the appropriate defaults for ProvenanceAttestationArgs
+ */
+export function provenanceAttestationArgsProvideDefaults(val: ProvenanceAttestationArgs): ProvenanceAttestationArgs {
+    return {
+        ...val,
+        mode: (val.mode) ?? "min",
+    };
+}
+
 export interface RegistryArgs {
     /**
      * The registry's address (e.g. "docker.io").
@@ -860,6 +960,31 @@ export interface RegistryArgs {
     username?: pulumi.Input<string | undefined>;
 }
 
+export interface SBOMAttestationArgs {
+    /**
+     * When `true` no SBOM will be generated. Defaults to `false`.
+     */
+    disabled?: pulumi.Input<boolean | undefined>;
+    /**
+     * The scanner image used to generate the SBOM.
+     *
+     * Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+     */
+    generator?: pulumi.Input<string | undefined>;
+    /**
+     * Also scan the build context, in addition to the final image.
+     *
+     * Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+     */
+    scanContext?: pulumi.Input<boolean | undefined>;
+    /**
+     * Also scan intermediate build stages, in addition to the final image.
+     *
+     * Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+     */
+    scanStage?: pulumi.Input<boolean | undefined>;
+}
+
 export interface SSHArgs {
     /**
      * Useful for distinguishing different servers that are part of the same
@@ -880,3 +1005,18 @@ export interface SSHArgs {
      */
     paths?: pulumi.Input<pulumi.Input<string>[] | undefined>;
 }
+
+export interface UlimitArgs {
+    /**
+     * The hard limit. Defaults to the soft limit.
+     */
+    hard?: pulumi.Input<number | undefined>;
+    /**
+     * The name of the limit, for example `nofile` or `nproc`.
+     */
+    name: pulumi.Input<string>;
+    /**
+     * The soft limit. Use `-1` for unlimited.
+     */
+    soft: pulumi.Input<number>;
+}
@@ -8,7 +8,62 @@ import * as enums from "../types/enums";
 
 import * as utilities from "../utilities";
 
+export interface Attestations {
+    /**
+     * Generate SLSA provenance for the build.
+     *
+     * Provenance is disabled unless configured here.
+     *
+     * Equivalent to Docker's `--attest type=provenance` flag.
+     */
+    provenance?: outputs.ProvenanceAttestation;
+    /**
+     * Generate a Software Bill of Materials (SBOM) for the image.
+     *
+     * Equivalent to Docker's `--attest type=sbom` flag.
+     */
+    sbom?: outputs.SBOMAttestation;
+}
+/**
+ * attestationsProvideDefaults sets the appropriate defaults for Attestations
+ */
+export function attestationsProvideDefaults(val: Attestations): Attestations {
+    return {
+        ...val,
+        provenance: (val.provenance ? outputs.provenanceAttestationProvideDefaults(val.provenance) : undefined),
+    };
+}
+
+export interface BaseImageLock {
+    /**
+     * A lockfile to record digests in, like `Dockerfile.lock`. Relative
+     * paths are resolved from the Dockerfile's directory, or the context's
+     * for inline Dockerfiles.
+     *
+     * Digests are recorded in the resource's state if this isn't set.
+     */
+    path?: string;
+    /**
+     * An arbitrary value, like a date, which re-resolves every base image
+     * when it changes.
+     */
+    refresh?: string;
+}
+
 export interface BuildContext {
+    /**
+     * Which files in local contexts contribute to the `contextHash` used to
+     * decide whether the image needs to be rebuilt.
+     *
+     * With `referenced`, only the paths copied by `COPY` and `ADD`
+     * instructions are hashed, so changes to other files don't trigger a
+     * rebuild. Every file is hashed if those paths can't be determined
+     * statically -- for example when the Dockerfile is remote or a `RUN`
+     * instruction bind-mounts a context.
+     *
+     * Defaults to `all`.
+     */
+    hashMode?: enums.ContextHashMode;
     /**
      * Resources to use for build context.
      *
@@ -31,6 +86,67 @@ export interface BuildContext {
     named?: {[key: string]: outputs.Context};
 }
 
+export interface BuildMetadata {
+    /**
+     * The buildx history reference for the build, formatted as
+     * `<builder>/<node>/<ref>`.
+     *
+     * This can be passed to `docker buildx history inspect` to debug the
+     * build.
+     */
+    buildRef?: string;
+    /**
+     * The digest of the image's config blob.
+     */
+    configDigest?: string;
+    /**
+     * The media type of the exported image's top-level manifest or index.
+     */
+    descriptorMediaType?: string;
+    /**
+     * The size in bytes of the exported image's top-level manifest or index.
+     */
+    descriptorSize?: number;
+    /**
+     * The comma-separated names the image was exported with.
+     */
+    imageName?: string;
+}
+
+export interface BuildStats {
+    /**
+     * The fraction of steps which were cached, between 0 and 1.
+     */
+    cacheHitRatio: number;
+    /**
+     * The number of steps which were cached.
+     */
+    cachedSteps: number;
+    /**
+     * Wall-clock duration of the build in seconds.
+     */
+    duration: number;
+    /**
+     * The slowest uncached steps, in descending order of duration.
+     */
+    slowestSteps?: outputs.BuildStep[];
+    /**
+     * The number of steps (vertices) solved by the build.
+     */
+    steps: number;
+}
+
+export interface BuildStep {
+    /**
+     * The step's duration in seconds.
+     */
+    duration: number;
+    /**
+     * The step's name, for example "[2/3] RUN make".
+     */
+    name: string;
+}
+
 export interface BuilderConfig {
     /**
      * Name of an existing buildx builder to use.
@@ -845,6 +961,97 @@ export interface ExportTar {
     dest: string;
 }
 
+export interface ImageConfig {
+    /**
+     * The image's "CMD".
+     */
+    cmd?: string[];
+    /**
+     * The image's "ENTRYPOINT".
+     */
+    entrypoint?: string[];
+    /**
+     * Environment variables set by "ENV", as "KEY=value" pairs.
+     */
+    env?: string[];
+    /**
+     * Ports declared by `EXPOSE`, for example `8080/tcp`.
+     */
+    exposedPorts?: string[];
+    /**
+     * The image's final labels, including any inherited from base images.
+     */
+    labels?: {[key: string]: string};
+    /**
+     * The image's "USER".
+     */
+    user?: string;
+    /**
+     * The image's "WORKDIR".
+     */
+    workingDir?: string;
+}
+
+export interface Layer {
+    /**
+     * The layer's content digest.
+     */
+    digest: string;
+    /**
+     * The layer's media type, for example "application/vnd.oci.image.layer.v1.tar+gzip".
+     */
+    mediaType: string;
+    /**
+     * The layer's compressed size in bytes.
+     */
+    size: number;
+}
+
+export interface Lint {
+    /**
+     * Report lint violations as errors instead of warnings.
+     *
+     * Defaults to the Dockerfile's `# check=error=...` directive.
+     */
+    error?: boolean;
+    /**
+     * Names of lint rules to skip, like `StageNameCasing`, or `all` to
+     * disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+     * directive are also skipped.
+     */
+    skip?: string[];
+}
+
+export interface ProvenanceAttestation {
+    /**
+     * An explicit builder ID to record in the provenance, for example the
+     * URL of the CI job performing the build.
+     */
+    builderId?: string;
+    /**
+     * When `true` no provenance will be generated. Defaults to `false`.
+     */
+    disabled?: boolean;
+    /**
+     * Only attach provenance to exporters which embed it in the image
+     * (e.g. `image` and `registry`). Defaults to `false`.
+     */
+    inlineOnly?: boolean;
+    /**
+     * The level of detail to include in the provenance. Defaults to `min`.
+     */
+    mode?: enums.ProvenanceMode;
+}
+/**
+ * provenanceAttestationProvideDefaults sets the appropriate defaults for ProvenanceAttestation
+ */
+export function provenanceAttestationProvideDefaults(val: ProvenanceAttestation): ProvenanceAttestation {
+    return {
+        ...val,
+        mode: (val.mode) ?? "min",
+    };
+}
+
 export interface Registry {
     /**
      * The registry's address (e.g. "docker.io").
@@ -860,6 +1067,31 @@ export interface Registry {
     username?: string;
 }
 
+export interface SBOMAttestation {
+    /**
+     * When `true` no SBOM will be generated. Defaults to `false`.
+     */
+    disabled?: boolean;
+    /**
+     * The scanner image used to generate the SBOM.
+     *
+     * Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+     */
+    generator?: string;
+    /**
+     * Also scan the build context, in addition to the final image.
+     *
+     * Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+     */
+    scanContext?: boolean;
+    /**
+     * Also scan intermediate build stages, in addition to the final image.
+     *
+     * Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+     */
+    scanStage?: boolean;
+}
+
 export interface SSH {
     /**
      * Useful for distinguishing different servers that are part of the same
@@ -881,3 +1113,18 @@ export interface SSH {
     paths?: string[];
 }
 
+export interface Ulimit {
+    /**
+     * The hard limit. Defaults to the soft limit.
+     */
+    hard?: number;
+    /**
+     * The name of the limit, for example `nofile` or `nproc`.
+     */
+    name: string;
+    /**
+     * The soft limit. Use `-1` for unlimited.
+     */
+    soft: number;
+}
+
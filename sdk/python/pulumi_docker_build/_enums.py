@@ -9,8 +9,12 @@ from enum import Enum
 __all__ = [
     'CacheMode',
     'CompressionType',
+    'ContextHashMode',
+    'Entitlement',
     'NetworkMode',
     'Platform',
+    'ProgressMode',
+    'ProvenanceMode',
 ]
 
 
@@ -42,6 +46,30 @@ class CompressionType(_builtins.str, Enum):
     """
 
 
+@pulumi.type_token("docker-build:index:ContextHashMode")
+class ContextHashMode(_builtins.str, Enum):
+    ALL = "all"
+    """
+    Hash every file in the context which isn't excluded by ".dockerignore".
+    """
+    REFERENCED = "referenced"
+    """
+    Hash only the files reachable from "COPY" and "ADD" sources, falling back to every file when they can't be determined statically.
+    """
+
+
+@pulumi.type_token("docker-build:index:Entitlement")
+class Entitlement(_builtins.str, Enum):
+    NETWORK_HOST = "network.host"
+    """
+    Allow "RUN --network=host" and the "host" network mode.
+    """
+    SECURITY_INSECURE = "security.insecure"
+    """
+    Allow "RUN --security=insecure" to run privileged containers.
+    """
+
+
 @pulumi.type_token("docker-build:index:NetworkMode")
 class NetworkMode(_builtins.str, Enum):
     DEFAULT = "default"
@@ -88,3 +116,31 @@ class Platform(_builtins.str, Enum):
     SOLARIS_AMD64 = "solaris/amd64"
     WINDOWS_386 = "windows/386"
     WINDOWS_AMD64 = "windows/amd64"
+
+
+@pulumi.type_token("docker-build:index:ProgressMode")
+class ProgressMode(_builtins.str, Enum):
+    PLAIN = "plain"
+    """
+    Stream plain-text progress as the build runs.
+    """
+    RAWJSON = "rawjson"
+    """
+    Log each build status update as a line of JSON.
+    """
+    QUIET = "quiet"
+    """
+    Only report progress if the build fails.
+    """
+
+
+@pulumi.type_token("docker-build:index:ProvenanceMode")
+class ProvenanceMode(_builtins.str, Enum):
+    MIN = "min"
+    """
+    Only include a minimal description of the build.
+    """
+    MAX = "max"
+    """
+    Include detailed information about the build, including build arguments and source mappings.
+    """
@@ -16,6 +16,10 @@ from . import _utilities
 from ._enums import *
 
 __all__ = [
+    'AttestationsArgs',
+    'AttestationsArgsDict',
+    'BaseImageLockArgs',
+    'BaseImageLockArgsDict',
     'BuildContextArgs',
     'BuildContextArgsDict',
     'BuilderConfigArgs',
@@ -66,12 +70,151 @@ __all__ = [
     'ExportRegistryArgsDict',
     'ExportTarArgs',
     'ExportTarArgsDict',
+    'LintArgs',
+    'LintArgsDict',
+    'ProvenanceAttestationArgs',
+    'ProvenanceAttestationArgsDict',
     'RegistryArgs',
     'RegistryArgsDict',
+    'SBOMAttestationArgs',
+    'SBOMAttestationArgsDict',
     'SSHArgs',
     'SSHArgsDict',
+    'UlimitArgs',
+    'UlimitArgsDict',
 ]
 
+class AttestationsArgsDict(TypedDict):
+    provenance: NotRequired[pulumi.Input[Optional['ProvenanceAttestationArgsDict']]]
+    """
+    Generate SLSA provenance for the build.
+
+    Provenance is disabled unless configured here.
+
+    Equivalent to Docker's `--attest type=provenance` flag.
+    """
+    sbom: NotRequired[pulumi.Input[Optional['SBOMAttestationArgsDict']]]
+    """
+    Generate a Software Bill of Materials (SBOM) for the image.
+
+    Equivalent to Docker's `--attest type=sbom` flag.
+    """
+
+@pulumi.input_type
+class AttestationsArgs:
+    def __init__(__self__, *,
+                 provenance: pulumi.Input[Optional['ProvenanceAttestationArgs']] = None,
+                 sbom: pulumi.Input[Optional['SBOMAttestationArgs']] = None):
+        """
+        :param pulumi.Input['ProvenanceAttestationArgs'] provenance: Generate SLSA provenance for the build.
+               
+               Provenance is disabled unless configured here.
+               
+               Equivalent to Docker's `--attest type=provenance` flag.
+        :param pulumi.Input['SBOMAttestationArgs'] sbom: Generate a Software Bill of Materials (SBOM) for the image.
+               
+               Equivalent to Docker's `--attest type=sbom` flag.
+        """
+        if provenance is not None:
+            pulumi.set(__self__, "provenance", provenance)
+        if sbom is not None:
+            pulumi.set(__self__, "sbom", sbom)
+
+    @_builtins.property
+    @pulumi.getter
+    def provenance(self) -> pulumi.Input[Optional['ProvenanceAttestationArgs']]:
+        """
+        Generate SLSA provenance for the build.
+
+        Provenance is disabled unless configured here.
+
+        Equivalent to Docker's `--attest type=provenance` flag.
+        """
+        return pulumi.get(self, "provenance")
+
+    @provenance.setter
+    def provenance(self, value: pulumi.Input[Optional['ProvenanceAttestationArgs']]):
+        pulumi.set(self, "provenance", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def sbom(self) -> pulumi.Input[Optional['SBOMAttestationArgs']]:
+        """
+        Generate a Software Bill of Materials (SBOM) for the image.
+
+        Equivalent to Docker's `--attest type=sbom` flag.
+        """
+        return pulumi.get(self, "sbom")
+
+    @sbom.setter
+    def sbom(self, value: pulumi.Input[Optional['SBOMAttestationArgs']]):
+        pulumi.set(self, "sbom", value)
+
+
+class BaseImageLockArgsDict(TypedDict):
+    path: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    A lockfile to record digests in, like `Dockerfile.lock`. Relative
+    paths are resolved from the Dockerfile's directory, or the context's
+    for inline Dockerfiles.
+
+    Digests are recorded in the resource's state if this isn't set.
+    """
+    refresh: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    An arbitrary value, like a date, which re-resolves every base image
+    when it changes.
+    """
+
+@pulumi.input_type
+class BaseImageLockArgs:
+    def __init__(__self__, *,
+                 path: pulumi.Input[Optional[_builtins.str]] = None,
+                 refresh: pulumi.Input[Optional[_builtins.str]] = None):
+        """
+        :param pulumi.Input[_builtins.str] path: A lockfile to record digests in, like `Dockerfile.lock`. Relative
+               paths are resolved from the Dockerfile's directory, or the context's
+               for inline Dockerfiles.
+               
+               Digests are recorded in the resource's state if this isn't set.
+        :param pulumi.Input[_builtins.str] refresh: An arbitrary value, like a date, which re-resolves every base image
+               when it changes.
+        """
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+        if refresh is not None:
+            pulumi.set(__self__, "refresh", refresh)
+
+    @_builtins.property
+    @pulumi.getter
+    def path(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        A lockfile to record digests in, like `Dockerfile.lock`. Relative
+        paths are resolved from the Dockerfile's directory, or the context's
+        for inline Dockerfiles.
+
+        Digests are recorded in the resource's state if this isn't set.
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "path", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def refresh(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        An arbitrary value, like a date, which re-resolves every base image
+        when it changes.
+        """
+        return pulumi.get(self, "refresh")
+
+    @refresh.setter
+    def refresh(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "refresh", value)
+
+
 class BuildContextArgsDict(TypedDict):
     location: pulumi.Input[_builtins.str]
     """
@@ -84,6 +227,19 @@ class BuildContextArgsDict(TypedDict):
       (`https://github.com/user/myrepo.git`, `http://server/context.tar.gz`,
       etc.).
     """
+    hash_mode: NotRequired[pulumi.Input[Optional['ContextHashMode']]]
+    """
+    Which files in local contexts contribute to the `contextHash` used to
+    decide whether the image needs to be rebuilt.
+
+    With `referenced`, only the paths copied by `COPY` and `ADD`
+    instructions are hashed, so changes to other files don't trigger a
+    rebuild. Every file is hashed if those paths can't be determined
+    statically -- for example when the Dockerfile is remote or a `RUN`
+    instruction bind-mounts a context.
+
+    Defaults to `all`.
+    """
     named: NotRequired[pulumi.Input[Optional[Mapping[str, pulumi.Input['ContextArgsDict']]]]]
     """
     Additional build contexts to use.
@@ -98,6 +254,7 @@ class BuildContextArgsDict(TypedDict):
 class BuildContextArgs:
     def __init__(__self__, *,
                  location: pulumi.Input[_builtins.str],
+                 hash_mode: pulumi.Input[Optional['ContextHashMode']] = None,
                  named: pulumi.Input[Optional[Mapping[str, pulumi.Input['ContextArgs']]]] = None):
         """
         :param pulumi.Input[_builtins.str] location: Resources to use for build context.
@@ -108,6 +265,16 @@ class BuildContextArgs:
                * A remote URL of a Git repository, tarball, or plain text file
                  (`https://github.com/user/myrepo.git`, `http://server/context.tar.gz`,
                  etc.).
+        :param pulumi.Input['ContextHashMode'] hash_mode: Which files in local contexts contribute to the `contextHash` used to
+               decide whether the image needs to be rebuilt.
+               
+               With `referenced`, only the paths copied by `COPY` and `ADD`
+               instructions are hashed, so changes to other files don't trigger a
+               rebuild. Every file is hashed if those paths can't be determined
+               statically -- for example when the Dockerfile is remote or a `RUN`
+               instruction bind-mounts a context.
+               
+               Defaults to `all`.
         :param pulumi.Input[Mapping[str, pulumi.Input['ContextArgs']]] named: Additional build contexts to use.
                
                These contexts are accessed with `FROM name` or `--from=name`
@@ -116,6 +283,8 @@ class BuildContextArgs:
                Values can be local paths, HTTP URLs, or  `docker-image://` images.
         """
         pulumi.set(__self__, "location", location)
+        if hash_mode is not None:
+            pulumi.set(__self__, "hash_mode", hash_mode)
         if named is not None:
             pulumi.set(__self__, "named", named)
 
@@ -138,6 +307,27 @@ class BuildContextArgs:
     def location(self, value: pulumi.Input[_builtins.str]):
         pulumi.set(self, "location", value)
 
+    @_builtins.property
+    @pulumi.getter(name="hashMode")
+    def hash_mode(self) -> pulumi.Input[Optional['ContextHashMode']]:
+        """
+        Which files in local contexts contribute to the `contextHash` used to
+        decide whether the image needs to be rebuilt.
+
+        With `referenced`, only the paths copied by `COPY` and `ADD`
+        instructions are hashed, so changes to other files don't trigger a
+        rebuild. Every file is hashed if those paths can't be determined
+        statically -- for example when the Dockerfile is remote or a `RUN`
+        instruction bind-mounts a context.
+
+        Defaults to `all`.
+        """
+        return pulumi.get(self, "hash_mode")
+
+    @hash_mode.setter
+    def hash_mode(self, value: pulumi.Input[Optional['ContextHashMode']]):
+        pulumi.set(self, "hash_mode", value)
+
     @_builtins.property
     @pulumi.getter
     def named(self) -> pulumi.Input[Optional[Mapping[str, pulumi.Input['ContextArgs']]]]:
@@ -3134,6 +3324,164 @@ class ExportTarArgs:
         pulumi.set(self, "dest", value)
 
 
+class LintArgsDict(TypedDict):
+    error: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    Report lint violations as errors instead of warnings.
+
+    Defaults to the Dockerfile's `# check=error=...` directive.
+    """
+    skip: NotRequired[pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]]
+    """
+    Names of lint rules to skip, like `StageNameCasing`, or `all` to
+    disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+    directive are also skipped.
+    """
+
+@pulumi.input_type
+class LintArgs:
+    def __init__(__self__, *,
+                 error: pulumi.Input[Optional[_builtins.bool]] = None,
+                 skip: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None):
+        """
+        :param pulumi.Input[_builtins.bool] error: Report lint violations as errors instead of warnings.
+               
+               Defaults to the Dockerfile's `# check=error=...` directive.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] skip: Names of lint rules to skip, like `StageNameCasing`, or `all` to
+               disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+               directive are also skipped.
+        """
+        if error is not None:
+            pulumi.set(__self__, "error", error)
+        if skip is not None:
+            pulumi.set(__self__, "skip", skip)
+
+    @_builtins.property
+    @pulumi.getter
+    def error(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Report lint violations as errors instead of warnings.
+
+        Defaults to the Dockerfile's `# check=error=...` directive.
+        """
+        return pulumi.get(self, "error")
+
+    @error.setter
+    def error(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "error", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def skip(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Names of lint rules to skip, like `StageNameCasing`, or `all` to
+        disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+        directive are also skipped.
+        """
+        return pulumi.get(self, "skip")
+
+    @skip.setter
+    def skip(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "skip", value)
+
+
+class ProvenanceAttestationArgsDict(TypedDict):
+    builder_id: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    An explicit builder ID to record in the provenance, for example the
+    URL of the CI job performing the build.
+    """
+    disabled: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    When `true` no provenance will be generated. Defaults to `false`.
+    """
+    inline_only: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    Only attach provenance to exporters which embed it in the image
+    (e.g. `image` and `registry`). Defaults to `false`.
+    """
+    mode: NotRequired[pulumi.Input[Optional['ProvenanceMode']]]
+    """
+    The level of detail to include in the provenance. Defaults to `min`.
+    """
+
+@pulumi.input_type
+class ProvenanceAttestationArgs:
+    def __init__(__self__, *,
+                 builder_id: pulumi.Input[Optional[_builtins.str]] = None,
+                 disabled: pulumi.Input[Optional[_builtins.bool]] = None,
+                 inline_only: pulumi.Input[Optional[_builtins.bool]] = None,
+                 mode: pulumi.Input[Optional['ProvenanceMode']] = None):
+        """
+        :param pulumi.Input[_builtins.str] builder_id: An explicit builder ID to record in the provenance, for example the
+               URL of the CI job performing the build.
+        :param pulumi.Input[_builtins.bool] disabled: When `true` no provenance will be generated. Defaults to `false`.
+        :param pulumi.Input[_builtins.bool] inline_only: Only attach provenance to exporters which embed it in the image
+               (e.g. `image` and `registry`). Defaults to `false`.
+        :param pulumi.Input['ProvenanceMode'] mode: The level of detail to include in the provenance. Defaults to `min`.
+        """
+        if builder_id is not None:
+            pulumi.set(__self__, "builder_id", builder_id)
+        if disabled is not None:
+            pulumi.set(__self__, "disabled", disabled)
+        if inline_only is not None:
+            pulumi.set(__self__, "inline_only", inline_only)
+        if mode is None:
+            mode = 'min'
+        if mode is not None:
+            pulumi.set(__self__, "mode", mode)
+
+    @_builtins.property
+    @pulumi.getter(name="builderId")
+    def builder_id(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        An explicit builder ID to record in the provenance, for example the
+        URL of the CI job performing the build.
+        """
+        return pulumi.get(self, "builder_id")
+
+    @builder_id.setter
+    def builder_id(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "builder_id", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def disabled(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        When `true` no provenance will be generated. Defaults to `false`.
+        """
+        return pulumi.get(self, "disabled")
+
+    @disabled.setter
+    def disabled(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "disabled", value)
+
+    @_builtins.property
+    @pulumi.getter(name="inlineOnly")
+    def inline_only(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Only attach provenance to exporters which embed it in the image
+        (e.g. `image` and `registry`). Defaults to `false`.
+        """
+        return pulumi.get(self, "inline_only")
+
+    @inline_only.setter
+    def inline_only(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "inline_only", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def mode(self) -> pulumi.Input[Optional['ProvenanceMode']]:
+        """
+        The level of detail to include in the provenance. Defaults to `min`.
+        """
+        return pulumi.get(self, "mode")
+
+    @mode.setter
+    def mode(self, value: pulumi.Input[Optional['ProvenanceMode']]):
+        pulumi.set(self, "mode", value)
+
+
 class RegistryArgsDict(TypedDict):
     address: pulumi.Input[_builtins.str]
     """
@@ -3202,6 +3550,113 @@ class RegistryArgs:
         pulumi.set(self, "username", value)
 
 
+class SBOMAttestationArgsDict(TypedDict):
+    disabled: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    When `true` no SBOM will be generated. Defaults to `false`.
+    """
+    generator: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The scanner image used to generate the SBOM.
+
+    Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+    """
+    scan_context: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    Also scan the build context, in addition to the final image.
+
+    Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+    """
+    scan_stage: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    Also scan intermediate build stages, in addition to the final image.
+
+    Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+    """
+
+@pulumi.input_type
+class SBOMAttestationArgs:
+    def __init__(__self__, *,
+                 disabled: pulumi.Input[Optional[_builtins.bool]] = None,
+                 generator: pulumi.Input[Optional[_builtins.str]] = None,
+                 scan_context: pulumi.Input[Optional[_builtins.bool]] = None,
+                 scan_stage: pulumi.Input[Optional[_builtins.bool]] = None):
+        """
+        :param pulumi.Input[_builtins.bool] disabled: When `true` no SBOM will be generated. Defaults to `false`.
+        :param pulumi.Input[_builtins.str] generator: The scanner image used to generate the SBOM.
+               
+               Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+        :param pulumi.Input[_builtins.bool] scan_context: Also scan the build context, in addition to the final image.
+               
+               Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+        :param pulumi.Input[_builtins.bool] scan_stage: Also scan intermediate build stages, in addition to the final image.
+               
+               Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+        """
+        if disabled is not None:
+            pulumi.set(__self__, "disabled", disabled)
+        if generator is not None:
+            pulumi.set(__self__, "generator", generator)
+        if scan_context is not None:
+            pulumi.set(__self__, "scan_context", scan_context)
+        if scan_stage is not None:
+            pulumi.set(__self__, "scan_stage", scan_stage)
+
+    @_builtins.property
+    @pulumi.getter
+    def disabled(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        When `true` no SBOM will be generated. Defaults to `false`.
+        """
+        return pulumi.get(self, "disabled")
+
+    @disabled.setter
+    def disabled(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "disabled", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def generator(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The scanner image used to generate the SBOM.
+
+        Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+        """
+        return pulumi.get(self, "generator")
+
+    @generator.setter
+    def generator(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "generator", value)
+
+    @_builtins.property
+    @pulumi.getter(name="scanContext")
+    def scan_context(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Also scan the build context, in addition to the final image.
+
+        Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+        """
+        return pulumi.get(self, "scan_context")
+
+    @scan_context.setter
+    def scan_context(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "scan_context", value)
+
+    @_builtins.property
+    @pulumi.getter(name="scanStage")
+    def scan_stage(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Also scan intermediate build stages, in addition to the final image.
+
+        Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+        """
+        return pulumi.get(self, "scan_stage")
+
+    @scan_stage.setter
+    def scan_stage(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "scan_stage", value)
+
+
 class SSHArgsDict(TypedDict):
     id: pulumi.Input[_builtins.str]
     """
@@ -3280,3 +3735,70 @@ class SSHArgs:
         pulumi.set(self, "paths", value)
 
 
+class UlimitArgsDict(TypedDict):
+    name: pulumi.Input[_builtins.str]
+    """
+    The name of the limit, for example `nofile` or `nproc`.
+    """
+    soft: pulumi.Input[_builtins.int]
+    """
+    The soft limit. Use `-1` for unlimited.
+    """
+    hard: NotRequired[pulumi.Input[Optional[_builtins.int]]]
+    """
+    The hard limit. Defaults to the soft limit.
+    """
+
+@pulumi.input_type
+class UlimitArgs:
+    def __init__(__self__, *,
+                 name: pulumi.Input[_builtins.str],
+                 soft: pulumi.Input[_builtins.int],
+                 hard: pulumi.Input[Optional[_builtins.int]] = None):
+        """
+        :param pulumi.Input[_builtins.str] name: The name of the limit, for example `nofile` or `nproc`.
+        :param pulumi.Input[_builtins.int] soft: The soft limit. Use `-1` for unlimited.
+        :param pulumi.Input[_builtins.int] hard: The hard limit. Defaults to the soft limit.
+        """
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "soft", soft)
+        if hard is not None:
+            pulumi.set(__self__, "hard", hard)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[_builtins.str]:
+        """
+        The name of the limit, for example `nofile` or `nproc`.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def soft(self) -> pulumi.Input[_builtins.int]:
+        """
+        The soft limit. Use `-1` for unlimited.
+        """
+        return pulumi.get(self, "soft")
+
+    @soft.setter
+    def soft(self, value: pulumi.Input[_builtins.int]):
+        pulumi.set(self, "soft", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def hard(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The hard limit. Defaults to the soft limit.
+        """
+        return pulumi.get(self, "hard")
+
+    @hard.setter
+    def hard(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "hard", value)
+
+
@@ -13,12 +13,66 @@ if sys.version_info >= (3, 11):
 else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from .. import _utilities
+from .. import _enums as _root_enums
 from .. import outputs as _root_outputs
 
+allowedBaseImages: Optional[str]
+"""
+Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+instructions must match. This applies in addition to each image's own
+`allowedBaseImages`.
+"""
+
+buildLogDir: Optional[str]
+"""
+A directory to write the full log of each image build to, for images
+which don't specify their own `buildLogPath`.
+
+Logs are named after the image's first tag, or the resource's name if
+it has no tags.
+"""
+
+contextHashCacheDir: Optional[str]
+"""
+A directory to cache build context hashes in, so files which haven't
+changed aren't read again on later runs.
+
+Files are considered unchanged if their size, modification time and
+inode are the same. The resulting `contextHash` is unaffected.
+"""
+
 host: str
 """
 The build daemon's address.
 """
 
+otlpEndpoint: str
+"""
+An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+of resource operations and builds to. gRPC endpoints aren't supported.
+
+Spans join the trace in the `TRACEPARENT` environment variable, if set,
+and trace context is propagated to the build daemon.
+"""
+
+progress: Optional[str]
+"""
+How to report build progress for images which don't specify their own
+`progress`. Defaults to `plain`.
+"""
+
 registries: Optional[str]
 
+requirePinnedBaseImages: Optional[bool]
+"""
+Require external `FROM` and `COPY --from` images to be pinned with an
+`@sha256:` digest, for images which don't specify their own
+`requirePinnedBaseImages`.
+"""
+
+warningsAsErrors: Optional[bool]
+"""
+Fail image builds if BuildKit reports any warnings, for images which
+don't specify their own `warningsAsErrors`.
+"""
+
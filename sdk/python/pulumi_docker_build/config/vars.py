@@ -13,6 +13,7 @@ if sys.version_info >= (3, 11):
 else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from .. import _utilities
+from .. import _enums as _root_enums
 from .. import outputs as _root_outputs
 
 import types
@@ -21,6 +22,37 @@ __config__ = pulumi.Config('docker-build')
 
 
 class _ExportableConfig(types.ModuleType):
+    @_builtins.property
+    def allowed_base_images(self) -> Optional[str]:
+        """
+        Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+        instructions must match. This applies in addition to each image's own
+        `allowedBaseImages`.
+        """
+        return __config__.get('allowedBaseImages')
+
+    @_builtins.property
+    def build_log_dir(self) -> Optional[str]:
+        """
+        A directory to write the full log of each image build to, for images
+        which don't specify their own `buildLogPath`.
+
+        Logs are named after the image's first tag, or the resource's name if
+        it has no tags.
+        """
+        return __config__.get('buildLogDir')
+
+    @_builtins.property
+    def context_hash_cache_dir(self) -> Optional[str]:
+        """
+        A directory to cache build context hashes in, so files which haven't
+        changed aren't read again on later runs.
+
+        Files are considered unchanged if their size, modification time and
+        inode are the same. The resulting `contextHash` is unaffected.
+        """
+        return __config__.get('contextHashCacheDir')
+
     @_builtins.property
     def host(self) -> str:
         """
@@ -28,7 +60,43 @@ class _ExportableConfig(types.ModuleType):
         """
         return __config__.get('host') or (_utilities.get_env('DOCKER_HOST') or '')
 
+    @_builtins.property
+    def otlp_endpoint(self) -> str:
+        """
+        An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+        of resource operations and builds to. gRPC endpoints aren't supported.
+
+        Spans join the trace in the `TRACEPARENT` environment variable, if set,
+        and trace context is propagated to the build daemon.
+        """
+        return __config__.get('otlpEndpoint') or (_utilities.get_env('PULUMI_DOCKER_BUILD_OTLP_ENDPOINT') or '')
+
+    @_builtins.property
+    def progress(self) -> Optional[str]:
+        """
+        How to report build progress for images which don't specify their own
+        `progress`. Defaults to `plain`.
+        """
+        return __config__.get('progress')
+
     @_builtins.property
     def registries(self) -> Optional[str]:
         return __config__.get('registries')
 
+    @_builtins.property
+    def require_pinned_base_images(self) -> Optional[bool]:
+        """
+        Require external `FROM` and `COPY --from` images to be pinned with an
+        `@sha256:` digest, for images which don't specify their own
+        `requirePinnedBaseImages`.
+        """
+        return __config__.get_bool('requirePinnedBaseImages')
+
+    @_builtins.property
+    def warnings_as_errors(self) -> Optional[bool]:
+        """
+        Fail image builds if BuildKit reports any warnings, for images which
+        don't specify their own `warningsAsErrors`.
+        """
+        return __config__.get_bool('warningsAsErrors')
+
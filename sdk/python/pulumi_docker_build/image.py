@@ -24,27 +24,42 @@ class ImageArgs:
     def __init__(__self__, *,
                  push: pulumi.Input[_builtins.bool],
                  add_hosts: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 allow: pulumi.Input[Optional[Sequence[pulumi.Input['Entitlement']]]] = None,
+                 allowed_base_images: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 annotations: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 attestations: pulumi.Input[Optional['AttestationsArgs']] = None,
+                 base_image_lock: pulumi.Input[Optional['BaseImageLockArgs']] = None,
                  build_args: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 build_log_path: pulumi.Input[Optional[_builtins.str]] = None,
                  build_on_preview: pulumi.Input[Optional[_builtins.bool]] = None,
+                 build_record_dir: pulumi.Input[Optional[_builtins.str]] = None,
                  builder: pulumi.Input[Optional['BuilderConfigArgs']] = None,
                  cache_from: pulumi.Input[Optional[Sequence[pulumi.Input['CacheFromArgs']]]] = None,
                  cache_to: pulumi.Input[Optional[Sequence[pulumi.Input['CacheToArgs']]]] = None,
+                 cgroup_parent: pulumi.Input[Optional[_builtins.str]] = None,
                  context: pulumi.Input[Optional['BuildContextArgs']] = None,
                  dockerfile: pulumi.Input[Optional['DockerfileArgs']] = None,
                  exec_: pulumi.Input[Optional[_builtins.bool]] = None,
                  exports: pulumi.Input[Optional[Sequence[pulumi.Input['ExportArgs']]]] = None,
                  ignore_secrets_in_diff_calculation: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 ignore_warnings: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
                  labels: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 lint: pulumi.Input[Optional['LintArgs']] = None,
                  load: pulumi.Input[Optional[_builtins.bool]] = None,
                  network: pulumi.Input[Optional['NetworkMode']] = None,
                  no_cache: pulumi.Input[Optional[_builtins.bool]] = None,
                  platforms: pulumi.Input[Optional[Sequence[pulumi.Input['Platform']]]] = None,
+                 progress: pulumi.Input[Optional['ProgressMode']] = None,
                  pull: pulumi.Input[Optional[_builtins.bool]] = None,
                  registries: pulumi.Input[Optional[Sequence[pulumi.Input['RegistryArgs']]]] = None,
+                 require_pinned_base_images: pulumi.Input[Optional[_builtins.bool]] = None,
                  secrets: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 shm_size: pulumi.Input[Optional[_builtins.str]] = None,
                  ssh: pulumi.Input[Optional[Sequence[pulumi.Input['SSHArgs']]]] = None,
                  tags: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
-                 target: pulumi.Input[Optional[_builtins.str]] = None):
+                 target: pulumi.Input[Optional[_builtins.str]] = None,
+                 ulimits: pulumi.Input[Optional[Sequence[pulumi.Input['UlimitArgs']]]] = None,
+                 warnings_as_errors: pulumi.Input[Optional[_builtins.bool]] = None):
         """
         The set of arguments for constructing a Image resource.
 
@@ -56,6 +71,49 @@ class ImageArgs:
         :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] add_hosts: Custom `host:ip` mappings to use during the build.
                
                Equivalent to Docker's `--add-host` flag.
+        :param pulumi.Input[Sequence[pulumi.Input['Entitlement']]] allow: Extra privileges to grant to the build.
+               
+               The builder must also be configured to permit these entitlements,
+               for example with `--allow-insecure-entitlement`.
+               
+               Equivalent to Docker's `--allow` flag.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] allowed_base_images: Glob patterns, like `docker.io/library/*` or
+               `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+               are matched against fully-qualified references such as
+               `docker.io/library/alpine:latest` and their repository names, and `*`
+               doesn't match `/`.
+               
+               ARGs are substituted from `buildArgs`. References to other stages and
+               to `named` contexts are not restricted.
+               
+               Images must also match the provider's `allowedBaseImages`, if set.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] annotations: Attach arbitrary key/value annotations to the image's manifests.
+               
+               Keys may be prefixed with the level to annotate -- `manifest`,
+               `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+               qualified with a platform, for example
+               `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+               levels can be separated by commas. Defaults to `manifest`.
+               
+               Annotations are applied to every `image`, `registry`, `oci` and
+               `docker` export. Annotations set directly on an export take
+               precedence.
+               
+               Equivalent to Docker's `--annotation` flag.
+        :param pulumi.Input['AttestationsArgs'] attestations: Attestations to attach to the image, such as an SBOM or SLSA
+               provenance.
+               
+               Attestations are only exported with `image`, `registry` or `oci`
+               exports, and require a builder which supports them (the legacy
+               `docker` driver does not).
+               
+               Equivalent to Docker's `--attest` flag.
+        :param pulumi.Input['BaseImageLockArgs'] base_image_lock: Pin every external `FROM` and `COPY --from` image to the digest it
+               resolved to on the first build.
+               
+               Later builds substitute `docker-image://<ref>@<digest>` named
+               contexts for those images, so they don't change until the lock's
+               `refresh` value does.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] build_args: `ARG` names and values to set during the build.
                
                These variables are accessed like environment variables inside `RUN`
@@ -65,6 +123,11 @@ class ImageArgs:
                if these arguments are sensitive.
                
                Equivalent to Docker's `--build-arg` flag.
+        :param pulumi.Input[_builtins.str] build_log_path: A file to write the build's full log to, including anything written to
+               stderr. The log is written whether or not the build succeeds, which is
+               useful for archiving logs from CI.
+               
+               Defaults to a file in the provider's `buildLogDir`, if set.
         :param pulumi.Input[_builtins.bool] build_on_preview: Setting this to `false` will always skip image builds during previews,
                and setting it to `true` will always build images during previews.
                
@@ -76,6 +139,12 @@ class ImageArgs:
                
                Defaults to `true` as a safeguard against broken images merging as part
                of CI pipelines.
+        :param pulumi.Input[_builtins.str] build_record_dir: A directory to export the build's history record to after it succeeds
+               or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+               `buildRecordPath`.
+               
+               Equivalent to running `docker buildx history export` after the build.
+               Records of failed builds are not available when `exec` is `true`.
         :param pulumi.Input['BuilderConfigArgs'] builder: Builder configuration.
         :param pulumi.Input[Sequence[pulumi.Input['CacheFromArgs']]] cache_from: Cache export configuration.
                
@@ -83,6 +152,9 @@ class ImageArgs:
         :param pulumi.Input[Sequence[pulumi.Input['CacheToArgs']]] cache_to: Cache import configuration.
                
                Equivalent to Docker's `--cache-to` flag.
+        :param pulumi.Input[_builtins.str] cgroup_parent: Set the parent cgroup for `RUN` instructions.
+               
+               Equivalent to Docker's `--cgroup-parent` flag.
         :param pulumi.Input['BuildContextArgs'] context: Build context settings. Defaults to the current directory.
                
                Equivalent to Docker's `PATH | URL | -` positional argument.
@@ -124,9 +196,18 @@ class ImageArgs:
                are changed. Note: only applicable if the secret is present in both the old and the new state.
                
                This is useful when you want to avoid unnecessary rebuilds caused by short-lived secrets that change on every run.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] ignore_warnings: Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+               warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+               These warnings are still logged.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] labels: Attach arbitrary key/value metadata to the image.
                
                Equivalent to Docker's `--label` flag.
+        :param pulumi.Input['LintArgs'] lint: Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+               `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+               building. Violations are reported as warnings unless configured to be
+               errors.
+               
+               Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
         :param pulumi.Input[_builtins.bool] load: When `true` the build will automatically include a `docker` export.
                
                Defaults to `false`.
@@ -136,6 +217,9 @@ class ImageArgs:
                
                For custom networks, configure your builder with `--driver-opt network=...`.
                
+               The `host` network mode requires the `network.host` entitlement to be
+               included in `allow`.
+               
                Equivalent to Docker's `--network` flag.
         :param pulumi.Input[_builtins.bool] no_cache: Do not import cache manifests when building the image.
                
@@ -143,8 +227,15 @@ class ImageArgs:
         :param pulumi.Input[Sequence[pulumi.Input['Platform']]] platforms: Set target platform(s) for the build. Defaults to the host's platform.
                
                Equivalent to Docker's `--platform` flag.
+        :param pulumi.Input['ProgressMode'] progress: How to report build progress. Defaults to the provider's `progress`
+               setting.
+               
+               Equivalent to Docker's `--progress` flag.
         :param pulumi.Input[_builtins.bool] pull: Always pull referenced images.
                
+               Images with `pull` are only rebuilt when one of their
+               `baseImageDigests` has moved, or always if those aren't known.
+               
                Equivalent to Docker's `--pull` flag.
         :param pulumi.Input[Sequence[pulumi.Input['RegistryArgs']]] registries: Registry credentials. Required if reading or exporting to private
                repositories.
@@ -153,6 +244,12 @@ class ImageArgs:
                credentials on the host.
                
                Similar to `docker login`.
+        :param pulumi.Input[_builtins.bool] require_pinned_base_images: Require every external `FROM` and `COPY --from` image to be pinned
+               with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+               Failures suggest the image's current digest when it can be looked up
+               with the configured registry credentials.
+               
+               Defaults to the provider's `requirePinnedBaseImages` setting.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] secrets: A mapping of secret names to their corresponding values.
                
                Unlike the Docker CLI, these can be passed by value and do not need to
@@ -162,6 +259,9 @@ class ImageArgs:
                image, so you should use this for sensitive values.
                
                Similar to Docker's `--secret` flag.
+        :param pulumi.Input[_builtins.str] shm_size: Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+               
+               Equivalent to Docker's `--shm-size` flag.
         :param pulumi.Input[Sequence[pulumi.Input['SSHArgs']]] ssh: SSH agent socket or keys to expose to the build.
                
                Equivalent to Docker's `--ssh` flag.
@@ -176,22 +276,46 @@ class ImageArgs:
                If not specified all targets will be built by default.
                
                Equivalent to Docker's `--target` flag.
+        :param pulumi.Input[Sequence[pulumi.Input['UlimitArgs']]] ulimits: Resource limits for `RUN` instructions.
+               
+               Equivalent to Docker's `--ulimit` flag.
+        :param pulumi.Input[_builtins.bool] warnings_as_errors: Fail the build if BuildKit reports any warnings, like Dockerfile lint
+               rule violations or deprecated syntax. Warnings from rules listed in
+               `ignoreWarnings` are exempt.
+               
+               Defaults to the provider's `warningsAsErrors` setting.
         """
         pulumi.set(__self__, "push", push)
         if add_hosts is not None:
             pulumi.set(__self__, "add_hosts", add_hosts)
+        if allow is not None:
+            pulumi.set(__self__, "allow", allow)
+        if allowed_base_images is not None:
+            pulumi.set(__self__, "allowed_base_images", allowed_base_images)
+        if annotations is not None:
+            pulumi.set(__self__, "annotations", annotations)
+        if attestations is not None:
+            pulumi.set(__self__, "attestations", attestations)
+        if base_image_lock is not None:
+            pulumi.set(__self__, "base_image_lock", base_image_lock)
         if build_args is not None:
             pulumi.set(__self__, "build_args", build_args)
+        if build_log_path is not None:
+            pulumi.set(__self__, "build_log_path", build_log_path)
         if build_on_preview is None:
             build_on_preview = True
         if build_on_preview is not None:
             pulumi.set(__self__, "build_on_preview", build_on_preview)
+        if build_record_dir is not None:
+            pulumi.set(__self__, "build_record_dir", build_record_dir)
         if builder is not None:
             pulumi.set(__self__, "builder", builder)
         if cache_from is not None:
             pulumi.set(__self__, "cache_from", cache_from)
         if cache_to is not None:
             pulumi.set(__self__, "cache_to", cache_to)
+        if cgroup_parent is not None:
+            pulumi.set(__self__, "cgroup_parent", cgroup_parent)
         if context is not None:
             pulumi.set(__self__, "context", context)
         if dockerfile is not None:
@@ -202,8 +326,12 @@ class ImageArgs:
             pulumi.set(__self__, "exports", exports)
         if ignore_secrets_in_diff_calculation is not None:
             pulumi.set(__self__, "ignore_secrets_in_diff_calculation", ignore_secrets_in_diff_calculation)
+        if ignore_warnings is not None:
+            pulumi.set(__self__, "ignore_warnings", ignore_warnings)
         if labels is not None:
             pulumi.set(__self__, "labels", labels)
+        if lint is not None:
+            pulumi.set(__self__, "lint", lint)
         if load is not None:
             pulumi.set(__self__, "load", load)
         if network is None:
@@ -214,18 +342,28 @@ class ImageArgs:
             pulumi.set(__self__, "no_cache", no_cache)
         if platforms is not None:
             pulumi.set(__self__, "platforms", platforms)
+        if progress is not None:
+            pulumi.set(__self__, "progress", progress)
         if pull is not None:
             pulumi.set(__self__, "pull", pull)
         if registries is not None:
             pulumi.set(__self__, "registries", registries)
+        if require_pinned_base_images is not None:
+            pulumi.set(__self__, "require_pinned_base_images", require_pinned_base_images)
         if secrets is not None:
             pulumi.set(__self__, "secrets", secrets)
+        if shm_size is not None:
+            pulumi.set(__self__, "shm_size", shm_size)
         if ssh is not None:
             pulumi.set(__self__, "ssh", ssh)
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
         if target is not None:
             pulumi.set(__self__, "target", target)
+        if ulimits is not None:
+            pulumi.set(__self__, "ulimits", ulimits)
+        if warnings_as_errors is not None:
+            pulumi.set(__self__, "warnings_as_errors", warnings_as_errors)
 
     @_builtins.property
     @pulumi.getter
@@ -257,6 +395,104 @@ class ImageArgs:
     def add_hosts(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "add_hosts", value)
 
+    @_builtins.property
+    @pulumi.getter
+    def allow(self) -> pulumi.Input[Optional[Sequence[pulumi.Input['Entitlement']]]]:
+        """
+        Extra privileges to grant to the build.
+
+        The builder must also be configured to permit these entitlements,
+        for example with `--allow-insecure-entitlement`.
+
+        Equivalent to Docker's `--allow` flag.
+        """
+        return pulumi.get(self, "allow")
+
+    @allow.setter
+    def allow(self, value: pulumi.Input[Optional[Sequence[pulumi.Input['Entitlement']]]]):
+        pulumi.set(self, "allow", value)
+
+    @_builtins.property
+    @pulumi.getter(name="allowedBaseImages")
+    def allowed_base_images(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Glob patterns, like `docker.io/library/*` or
+        `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+        are matched against fully-qualified references such as
+        `docker.io/library/alpine:latest` and their repository names, and `*`
+        doesn't match `/`.
+
+        ARGs are substituted from `buildArgs`. References to other stages and
+        to `named` contexts are not restricted.
+
+        Images must also match the provider's `allowedBaseImages`, if set.
+        """
+        return pulumi.get(self, "allowed_base_images")
+
+    @allowed_base_images.setter
+    def allowed_base_images(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "allowed_base_images", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def annotations(self) -> pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Attach arbitrary key/value annotations to the image's manifests.
+
+        Keys may be prefixed with the level to annotate -- `manifest`,
+        `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+        qualified with a platform, for example
+        `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+        levels can be separated by commas. Defaults to `manifest`.
+
+        Annotations are applied to every `image`, `registry`, `oci` and
+        `docker` export. Annotations set directly on an export take
+        precedence.
+
+        Equivalent to Docker's `--annotation` flag.
+        """
+        return pulumi.get(self, "annotations")
+
+    @annotations.setter
+    def annotations(self, value: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "annotations", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def attestations(self) -> pulumi.Input[Optional['AttestationsArgs']]:
+        """
+        Attestations to attach to the image, such as an SBOM or SLSA
+        provenance.
+
+        Attestations are only exported with `image`, `registry` or `oci`
+        exports, and require a builder which supports them (the legacy
+        `docker` driver does not).
+
+        Equivalent to Docker's `--attest` flag.
+        """
+        return pulumi.get(self, "attestations")
+
+    @attestations.setter
+    def attestations(self, value: pulumi.Input[Optional['AttestationsArgs']]):
+        pulumi.set(self, "attestations", value)
+
+    @_builtins.property
+    @pulumi.getter(name="baseImageLock")
+    def base_image_lock(self) -> pulumi.Input[Optional['BaseImageLockArgs']]:
+        """
+        Pin every external `FROM` and `COPY --from` image to the digest it
+        resolved to on the first build.
+
+        Later builds substitute `docker-image://<ref>@<digest>` named
+        contexts for those images, so they don't change until the lock's
+        `refresh` value does.
+        """
+        return pulumi.get(self, "base_image_lock")
+
+    @base_image_lock.setter
+    def base_image_lock(self, value: pulumi.Input[Optional['BaseImageLockArgs']]):
+        pulumi.set(self, "base_image_lock", value)
+
     @_builtins.property
     @pulumi.getter(name="buildArgs")
     def build_args(self) -> pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]:
@@ -277,6 +513,22 @@ class ImageArgs:
     def build_args(self, value: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "build_args", value)
 
+    @_builtins.property
+    @pulumi.getter(name="buildLogPath")
+    def build_log_path(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        A file to write the build's full log to, including anything written to
+        stderr. The log is written whether or not the build succeeds, which is
+        useful for archiving logs from CI.
+
+        Defaults to a file in the provider's `buildLogDir`, if set.
+        """
+        return pulumi.get(self, "build_log_path")
+
+    @build_log_path.setter
+    def build_log_path(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "build_log_path", value)
+
     @_builtins.property
     @pulumi.getter(name="buildOnPreview")
     def build_on_preview(self) -> pulumi.Input[Optional[_builtins.bool]]:
@@ -299,6 +551,23 @@ class ImageArgs:
     def build_on_preview(self, value: pulumi.Input[Optional[_builtins.bool]]):
         pulumi.set(self, "build_on_preview", value)
 
+    @_builtins.property
+    @pulumi.getter(name="buildRecordDir")
+    def build_record_dir(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        A directory to export the build's history record to after it succeeds
+        or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+        `buildRecordPath`.
+
+        Equivalent to running `docker buildx history export` after the build.
+        Records of failed builds are not available when `exec` is `true`.
+        """
+        return pulumi.get(self, "build_record_dir")
+
+    @build_record_dir.setter
+    def build_record_dir(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "build_record_dir", value)
+
     @_builtins.property
     @pulumi.getter
     def builder(self) -> pulumi.Input[Optional['BuilderConfigArgs']]:
@@ -339,6 +608,20 @@ class ImageArgs:
     def cache_to(self, value: pulumi.Input[Optional[Sequence[pulumi.Input['CacheToArgs']]]]):
         pulumi.set(self, "cache_to", value)
 
+    @_builtins.property
+    @pulumi.getter(name="cgroupParent")
+    def cgroup_parent(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        Set the parent cgroup for `RUN` instructions.
+
+        Equivalent to Docker's `--cgroup-parent` flag.
+        """
+        return pulumi.get(self, "cgroup_parent")
+
+    @cgroup_parent.setter
+    def cgroup_parent(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "cgroup_parent", value)
+
     @_builtins.property
     @pulumi.getter
     def context(self) -> pulumi.Input[Optional['BuildContextArgs']]:
@@ -435,6 +718,20 @@ class ImageArgs:
     def ignore_secrets_in_diff_calculation(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "ignore_secrets_in_diff_calculation", value)
 
+    @_builtins.property
+    @pulumi.getter(name="ignoreWarnings")
+    def ignore_warnings(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+        warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+        These warnings are still logged.
+        """
+        return pulumi.get(self, "ignore_warnings")
+
+    @ignore_warnings.setter
+    def ignore_warnings(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "ignore_warnings", value)
+
     @_builtins.property
     @pulumi.getter
     def labels(self) -> pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]:
@@ -449,6 +746,23 @@ class ImageArgs:
     def labels(self, value: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "labels", value)
 
+    @_builtins.property
+    @pulumi.getter
+    def lint(self) -> pulumi.Input[Optional['LintArgs']]:
+        """
+        Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+        `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+        building. Violations are reported as warnings unless configured to be
+        errors.
+
+        Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+        """
+        return pulumi.get(self, "lint")
+
+    @lint.setter
+    def lint(self, value: pulumi.Input[Optional['LintArgs']]):
+        pulumi.set(self, "lint", value)
+
     @_builtins.property
     @pulumi.getter
     def load(self) -> pulumi.Input[Optional[_builtins.bool]]:
@@ -473,6 +787,9 @@ class ImageArgs:
 
         For custom networks, configure your builder with `--driver-opt network=...`.
 
+        The `host` network mode requires the `network.host` entitlement to be
+        included in `allow`.
+
         Equivalent to Docker's `--network` flag.
         """
         return pulumi.get(self, "network")
@@ -509,12 +826,30 @@ class ImageArgs:
     def platforms(self, value: pulumi.Input[Optional[Sequence[pulumi.Input['Platform']]]]):
         pulumi.set(self, "platforms", value)
 
+    @_builtins.property
+    @pulumi.getter
+    def progress(self) -> pulumi.Input[Optional['ProgressMode']]:
+        """
+        How to report build progress. Defaults to the provider's `progress`
+        setting.
+
+        Equivalent to Docker's `--progress` flag.
+        """
+        return pulumi.get(self, "progress")
+
+    @progress.setter
+    def progress(self, value: pulumi.Input[Optional['ProgressMode']]):
+        pulumi.set(self, "progress", value)
+
     @_builtins.property
     @pulumi.getter
     def pull(self) -> pulumi.Input[Optional[_builtins.bool]]:
         """
         Always pull referenced images.
 
+        Images with `pull` are only rebuilt when one of their
+        `baseImageDigests` has moved, or always if those aren't known.
+
         Equivalent to Docker's `--pull` flag.
         """
         return pulumi.get(self, "pull")
@@ -541,6 +876,23 @@ class ImageArgs:
     def registries(self, value: pulumi.Input[Optional[Sequence[pulumi.Input['RegistryArgs']]]]):
         pulumi.set(self, "registries", value)
 
+    @_builtins.property
+    @pulumi.getter(name="requirePinnedBaseImages")
+    def require_pinned_base_images(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Require every external `FROM` and `COPY --from` image to be pinned
+        with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+        Failures suggest the image's current digest when it can be looked up
+        with the configured registry credentials.
+
+        Defaults to the provider's `requirePinnedBaseImages` setting.
+        """
+        return pulumi.get(self, "require_pinned_base_images")
+
+    @require_pinned_base_images.setter
+    def require_pinned_base_images(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "require_pinned_base_images", value)
+
     @_builtins.property
     @pulumi.getter
     def secrets(self) -> pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]:
@@ -561,6 +913,20 @@ class ImageArgs:
     def secrets(self, value: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "secrets", value)
 
+    @_builtins.property
+    @pulumi.getter(name="shmSize")
+    def shm_size(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+
+        Equivalent to Docker's `--shm-size` flag.
+        """
+        return pulumi.get(self, "shm_size")
+
+    @shm_size.setter
+    def shm_size(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "shm_size", value)
+
     @_builtins.property
     @pulumi.getter
     def ssh(self) -> pulumi.Input[Optional[Sequence[pulumi.Input['SSHArgs']]]]:
@@ -608,6 +974,36 @@ class ImageArgs:
     def target(self, value: pulumi.Input[Optional[_builtins.str]]):
         pulumi.set(self, "target", value)
 
+    @_builtins.property
+    @pulumi.getter
+    def ulimits(self) -> pulumi.Input[Optional[Sequence[pulumi.Input['UlimitArgs']]]]:
+        """
+        Resource limits for `RUN` instructions.
+
+        Equivalent to Docker's `--ulimit` flag.
+        """
+        return pulumi.get(self, "ulimits")
+
+    @ulimits.setter
+    def ulimits(self, value: pulumi.Input[Optional[Sequence[pulumi.Input['UlimitArgs']]]]):
+        pulumi.set(self, "ulimits", value)
+
+    @_builtins.property
+    @pulumi.getter(name="warningsAsErrors")
+    def warnings_as_errors(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Fail the build if BuildKit reports any warnings, like Dockerfile lint
+        rule violations or deprecated syntax. Warnings from rules listed in
+        `ignoreWarnings` are exempt.
+
+        Defaults to the provider's `warningsAsErrors` setting.
+        """
+        return pulumi.get(self, "warnings_as_errors")
+
+    @warnings_as_errors.setter
+    def warnings_as_errors(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "warnings_as_errors", value)
+
 
 @pulumi.type_token("docker-build:index:Image")
 class Image(pulumi.CustomResource):
@@ -616,28 +1012,43 @@ class Image(pulumi.CustomResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  add_hosts: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 allow: pulumi.Input[Optional[Sequence[pulumi.Input['Entitlement']]]] = None,
+                 allowed_base_images: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 annotations: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 attestations: pulumi.Input[Optional[Union['AttestationsArgs', 'AttestationsArgsDict']]] = None,
+                 base_image_lock: pulumi.Input[Optional[Union['BaseImageLockArgs', 'BaseImageLockArgsDict']]] = None,
                  build_args: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 build_log_path: pulumi.Input[Optional[_builtins.str]] = None,
                  build_on_preview: pulumi.Input[Optional[_builtins.bool]] = None,
+                 build_record_dir: pulumi.Input[Optional[_builtins.str]] = None,
                  builder: pulumi.Input[Optional[Union['BuilderConfigArgs', 'BuilderConfigArgsDict']]] = None,
                  cache_from: pulumi.Input[Optional[Sequence[pulumi.Input[Union['CacheFromArgs', 'CacheFromArgsDict']]]]] = None,
                  cache_to: pulumi.Input[Optional[Sequence[pulumi.Input[Union['CacheToArgs', 'CacheToArgsDict']]]]] = None,
+                 cgroup_parent: pulumi.Input[Optional[_builtins.str]] = None,
                  context: pulumi.Input[Optional[Union['BuildContextArgs', 'BuildContextArgsDict']]] = None,
                  dockerfile: pulumi.Input[Optional[Union['DockerfileArgs', 'DockerfileArgsDict']]] = None,
                  exec_: pulumi.Input[Optional[_builtins.bool]] = None,
                  exports: pulumi.Input[Optional[Sequence[pulumi.Input[Union['ExportArgs', 'ExportArgsDict']]]]] = None,
                  ignore_secrets_in_diff_calculation: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 ignore_warnings: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
                  labels: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 lint: pulumi.Input[Optional[Union['LintArgs', 'LintArgsDict']]] = None,
                  load: pulumi.Input[Optional[_builtins.bool]] = None,
                  network: pulumi.Input[Optional['NetworkMode']] = None,
                  no_cache: pulumi.Input[Optional[_builtins.bool]] = None,
                  platforms: pulumi.Input[Optional[Sequence[pulumi.Input['Platform']]]] = None,
+                 progress: pulumi.Input[Optional['ProgressMode']] = None,
                  pull: pulumi.Input[Optional[_builtins.bool]] = None,
                  push: pulumi.Input[Optional[_builtins.bool]] = None,
                  registries: pulumi.Input[Optional[Sequence[pulumi.Input[Union['RegistryArgs', 'RegistryArgsDict']]]]] = None,
+                 require_pinned_base_images: pulumi.Input[Optional[_builtins.bool]] = None,
                  secrets: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 shm_size: pulumi.Input[Optional[_builtins.str]] = None,
                  ssh: pulumi.Input[Optional[Sequence[pulumi.Input[Union['SSHArgs', 'SSHArgsDict']]]]] = None,
                  tags: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
                  target: pulumi.Input[Optional[_builtins.str]] = None,
+                 ulimits: pulumi.Input[Optional[Sequence[pulumi.Input[Union['UlimitArgs', 'UlimitArgsDict']]]]] = None,
+                 warnings_as_errors: pulumi.Input[Optional[_builtins.bool]] = None,
                  __props__=None):
         """
         A Docker image built using buildx -- Docker's interface to the improved
@@ -951,6 +1362,49 @@ class Image(pulumi.CustomResource):
         :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] add_hosts: Custom `host:ip` mappings to use during the build.
                
                Equivalent to Docker's `--add-host` flag.
+        :param pulumi.Input[Sequence[pulumi.Input['Entitlement']]] allow: Extra privileges to grant to the build.
+               
+               The builder must also be configured to permit these entitlements,
+               for example with `--allow-insecure-entitlement`.
+               
+               Equivalent to Docker's `--allow` flag.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] allowed_base_images: Glob patterns, like `docker.io/library/*` or
+               `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+               are matched against fully-qualified references such as
+               `docker.io/library/alpine:latest` and their repository names, and `*`
+               doesn't match `/`.
+               
+               ARGs are substituted from `buildArgs`. References to other stages and
+               to `named` contexts are not restricted.
+               
+               Images must also match the provider's `allowedBaseImages`, if set.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] annotations: Attach arbitrary key/value annotations to the image's manifests.
+               
+               Keys may be prefixed with the level to annotate -- `manifest`,
+               `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+               qualified with a platform, for example
+               `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+               levels can be separated by commas. Defaults to `manifest`.
+               
+               Annotations are applied to every `image`, `registry`, `oci` and
+               `docker` export. Annotations set directly on an export take
+               precedence.
+               
+               Equivalent to Docker's `--annotation` flag.
+        :param pulumi.Input[Union['AttestationsArgs', 'AttestationsArgsDict']] attestations: Attestations to attach to the image, such as an SBOM or SLSA
+               provenance.
+               
+               Attestations are only exported with `image`, `registry` or `oci`
+               exports, and require a builder which supports them (the legacy
+               `docker` driver does not).
+               
+               Equivalent to Docker's `--attest` flag.
+        :param pulumi.Input[Union['BaseImageLockArgs', 'BaseImageLockArgsDict']] base_image_lock: Pin every external `FROM` and `COPY --from` image to the digest it
+               resolved to on the first build.
+               
+               Later builds substitute `docker-image://<ref>@<digest>` named
+               contexts for those images, so they don't change until the lock's
+               `refresh` value does.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] build_args: `ARG` names and values to set during the build.
                
                These variables are accessed like environment variables inside `RUN`
@@ -960,6 +1414,11 @@ class Image(pulumi.CustomResource):
                if these arguments are sensitive.
                
                Equivalent to Docker's `--build-arg` flag.
+        :param pulumi.Input[_builtins.str] build_log_path: A file to write the build's full log to, including anything written to
+               stderr. The log is written whether or not the build succeeds, which is
+               useful for archiving logs from CI.
+               
+               Defaults to a file in the provider's `buildLogDir`, if set.
         :param pulumi.Input[_builtins.bool] build_on_preview: Setting this to `false` will always skip image builds during previews,
                and setting it to `true` will always build images during previews.
                
@@ -971,6 +1430,12 @@ class Image(pulumi.CustomResource):
                
                Defaults to `true` as a safeguard against broken images merging as part
                of CI pipelines.
+        :param pulumi.Input[_builtins.str] build_record_dir: A directory to export the build's history record to after it succeeds
+               or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+               `buildRecordPath`.
+               
+               Equivalent to running `docker buildx history export` after the build.
+               Records of failed builds are not available when `exec` is `true`.
         :param pulumi.Input[Union['BuilderConfigArgs', 'BuilderConfigArgsDict']] builder: Builder configuration.
         :param pulumi.Input[Sequence[pulumi.Input[Union['CacheFromArgs', 'CacheFromArgsDict']]]] cache_from: Cache export configuration.
                
@@ -978,6 +1443,9 @@ class Image(pulumi.CustomResource):
         :param pulumi.Input[Sequence[pulumi.Input[Union['CacheToArgs', 'CacheToArgsDict']]]] cache_to: Cache import configuration.
                
                Equivalent to Docker's `--cache-to` flag.
+        :param pulumi.Input[_builtins.str] cgroup_parent: Set the parent cgroup for `RUN` instructions.
+               
+               Equivalent to Docker's `--cgroup-parent` flag.
         :param pulumi.Input[Union['BuildContextArgs', 'BuildContextArgsDict']] context: Build context settings. Defaults to the current directory.
                
                Equivalent to Docker's `PATH | URL | -` positional argument.
@@ -1019,9 +1487,18 @@ class Image(pulumi.CustomResource):
                are changed. Note: only applicable if the secret is present in both the old and the new state.
                
                This is useful when you want to avoid unnecessary rebuilds caused by short-lived secrets that change on every run.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] ignore_warnings: Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+               warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+               These warnings are still logged.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] labels: Attach arbitrary key/value metadata to the image.
                
                Equivalent to Docker's `--label` flag.
+        :param pulumi.Input[Union['LintArgs', 'LintArgsDict']] lint: Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+               `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+               building. Violations are reported as warnings unless configured to be
+               errors.
+               
+               Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
         :param pulumi.Input[_builtins.bool] load: When `true` the build will automatically include a `docker` export.
                
                Defaults to `false`.
@@ -1031,6 +1508,9 @@ class Image(pulumi.CustomResource):
                
                For custom networks, configure your builder with `--driver-opt network=...`.
                
+               The `host` network mode requires the `network.host` entitlement to be
+               included in `allow`.
+               
                Equivalent to Docker's `--network` flag.
         :param pulumi.Input[_builtins.bool] no_cache: Do not import cache manifests when building the image.
                
@@ -1038,8 +1518,15 @@ class Image(pulumi.CustomResource):
         :param pulumi.Input[Sequence[pulumi.Input['Platform']]] platforms: Set target platform(s) for the build. Defaults to the host's platform.
                
                Equivalent to Docker's `--platform` flag.
+        :param pulumi.Input['ProgressMode'] progress: How to report build progress. Defaults to the provider's `progress`
+               setting.
+               
+               Equivalent to Docker's `--progress` flag.
         :param pulumi.Input[_builtins.bool] pull: Always pull referenced images.
                
+               Images with `pull` are only rebuilt when one of their
+               `baseImageDigests` has moved, or always if those aren't known.
+               
                Equivalent to Docker's `--pull` flag.
         :param pulumi.Input[_builtins.bool] push: When `true` the build will automatically include a `registry` export.
                
@@ -1053,6 +1540,12 @@ class Image(pulumi.CustomResource):
                credentials on the host.
                
                Similar to `docker login`.
+        :param pulumi.Input[_builtins.bool] require_pinned_base_images: Require every external `FROM` and `COPY --from` image to be pinned
+               with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+               Failures suggest the image's current digest when it can be looked up
+               with the configured registry credentials.
+               
+               Defaults to the provider's `requirePinnedBaseImages` setting.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] secrets: A mapping of secret names to their corresponding values.
                
                Unlike the Docker CLI, these can be passed by value and do not need to
@@ -1062,6 +1555,9 @@ class Image(pulumi.CustomResource):
                image, so you should use this for sensitive values.
                
                Similar to Docker's `--secret` flag.
+        :param pulumi.Input[_builtins.str] shm_size: Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+               
+               Equivalent to Docker's `--shm-size` flag.
         :param pulumi.Input[Sequence[pulumi.Input[Union['SSHArgs', 'SSHArgsDict']]]] ssh: SSH agent socket or keys to expose to the build.
                
                Equivalent to Docker's `--ssh` flag.
@@ -1076,6 +1572,14 @@ class Image(pulumi.CustomResource):
                If not specified all targets will be built by default.
                
                Equivalent to Docker's `--target` flag.
+        :param pulumi.Input[Sequence[pulumi.Input[Union['UlimitArgs', 'UlimitArgsDict']]]] ulimits: Resource limits for `RUN` instructions.
+               
+               Equivalent to Docker's `--ulimit` flag.
+        :param pulumi.Input[_builtins.bool] warnings_as_errors: Fail the build if BuildKit reports any warnings, like Dockerfile lint
+               rule violations or deprecated syntax. Warnings from rules listed in
+               `ignoreWarnings` are exempt.
+               
+               Defaults to the provider's `warningsAsErrors` setting.
         """
         ...
     @overload
@@ -1406,28 +1910,43 @@ class Image(pulumi.CustomResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  add_hosts: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 allow: pulumi.Input[Optional[Sequence[pulumi.Input['Entitlement']]]] = None,
+                 allowed_base_images: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 annotations: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 attestations: pulumi.Input[Optional[Union['AttestationsArgs', 'AttestationsArgsDict']]] = None,
+                 base_image_lock: pulumi.Input[Optional[Union['BaseImageLockArgs', 'BaseImageLockArgsDict']]] = None,
                  build_args: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 build_log_path: pulumi.Input[Optional[_builtins.str]] = None,
                  build_on_preview: pulumi.Input[Optional[_builtins.bool]] = None,
+                 build_record_dir: pulumi.Input[Optional[_builtins.str]] = None,
                  builder: pulumi.Input[Optional[Union['BuilderConfigArgs', 'BuilderConfigArgsDict']]] = None,
                  cache_from: pulumi.Input[Optional[Sequence[pulumi.Input[Union['CacheFromArgs', 'CacheFromArgsDict']]]]] = None,
                  cache_to: pulumi.Input[Optional[Sequence[pulumi.Input[Union['CacheToArgs', 'CacheToArgsDict']]]]] = None,
+                 cgroup_parent: pulumi.Input[Optional[_builtins.str]] = None,
                  context: pulumi.Input[Optional[Union['BuildContextArgs', 'BuildContextArgsDict']]] = None,
                  dockerfile: pulumi.Input[Optional[Union['DockerfileArgs', 'DockerfileArgsDict']]] = None,
                  exec_: pulumi.Input[Optional[_builtins.bool]] = None,
                  exports: pulumi.Input[Optional[Sequence[pulumi.Input[Union['ExportArgs', 'ExportArgsDict']]]]] = None,
                  ignore_secrets_in_diff_calculation: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 ignore_warnings: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
                  labels: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 lint: pulumi.Input[Optional[Union['LintArgs', 'LintArgsDict']]] = None,
                  load: pulumi.Input[Optional[_builtins.bool]] = None,
                  network: pulumi.Input[Optional['NetworkMode']] = None,
                  no_cache: pulumi.Input[Optional[_builtins.bool]] = None,
                  platforms: pulumi.Input[Optional[Sequence[pulumi.Input['Platform']]]] = None,
+                 progress: pulumi.Input[Optional['ProgressMode']] = None,
                  pull: pulumi.Input[Optional[_builtins.bool]] = None,
                  push: pulumi.Input[Optional[_builtins.bool]] = None,
                  registries: pulumi.Input[Optional[Sequence[pulumi.Input[Union['RegistryArgs', 'RegistryArgsDict']]]]] = None,
+                 require_pinned_base_images: pulumi.Input[Optional[_builtins.bool]] = None,
                  secrets: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 shm_size: pulumi.Input[Optional[_builtins.str]] = None,
                  ssh: pulumi.Input[Optional[Sequence[pulumi.Input[Union['SSHArgs', 'SSHArgsDict']]]]] = None,
                  tags: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
                  target: pulumi.Input[Optional[_builtins.str]] = None,
+                 ulimits: pulumi.Input[Optional[Sequence[pulumi.Input[Union['UlimitArgs', 'UlimitArgsDict']]]]] = None,
+                 warnings_as_errors: pulumi.Input[Optional[_builtins.bool]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -1438,36 +1957,61 @@ class Image(pulumi.CustomResource):
             __props__ = ImageArgs.__new__(ImageArgs)
 
             __props__.__dict__["add_hosts"] = add_hosts
+            __props__.__dict__["allow"] = allow
+            __props__.__dict__["allowed_base_images"] = allowed_base_images
+            __props__.__dict__["annotations"] = annotations
+            __props__.__dict__["attestations"] = attestations
+            __props__.__dict__["base_image_lock"] = base_image_lock
             __props__.__dict__["build_args"] = build_args
+            __props__.__dict__["build_log_path"] = build_log_path
             if build_on_preview is None:
                 build_on_preview = True
             __props__.__dict__["build_on_preview"] = build_on_preview
+            __props__.__dict__["build_record_dir"] = build_record_dir
             __props__.__dict__["builder"] = builder
             __props__.__dict__["cache_from"] = cache_from
             __props__.__dict__["cache_to"] = cache_to
+            __props__.__dict__["cgroup_parent"] = cgroup_parent
             __props__.__dict__["context"] = context
             __props__.__dict__["dockerfile"] = dockerfile
             __props__.__dict__["exec_"] = exec_
             __props__.__dict__["exports"] = exports
             __props__.__dict__["ignore_secrets_in_diff_calculation"] = ignore_secrets_in_diff_calculation
+            __props__.__dict__["ignore_warnings"] = ignore_warnings
             __props__.__dict__["labels"] = labels
+            __props__.__dict__["lint"] = lint
             __props__.__dict__["load"] = load
             if network is None:
                 network = 'default'
             __props__.__dict__["network"] = network
             __props__.__dict__["no_cache"] = no_cache
             __props__.__dict__["platforms"] = platforms
+            __props__.__dict__["progress"] = progress
             __props__.__dict__["pull"] = pull
             if push is None and not opts.urn:
                 raise TypeError("Missing required property 'push'")
             __props__.__dict__["push"] = push
             __props__.__dict__["registries"] = registries
+            __props__.__dict__["require_pinned_base_images"] = require_pinned_base_images
             __props__.__dict__["secrets"] = None if secrets is None else pulumi.Output.secret(secrets)
+            __props__.__dict__["shm_size"] = shm_size
             __props__.__dict__["ssh"] = ssh
             __props__.__dict__["tags"] = tags
             __props__.__dict__["target"] = target
+            __props__.__dict__["ulimits"] = ulimits
+            __props__.__dict__["warnings_as_errors"] = warnings_as_errors
+            __props__.__dict__["base_image_digests"] = None
+            __props__.__dict__["build_metadata"] = None
+            __props__.__dict__["build_record_path"] = None
+            __props__.__dict__["build_stats"] = None
+            __props__.__dict__["compressed_size"] = None
+            __props__.__dict__["config"] = None
             __props__.__dict__["context_hash"] = None
+            __props__.__dict__["context_manifest"] = None
             __props__.__dict__["digest"] = None
+            __props__.__dict__["layers"] = None
+            __props__.__dict__["locked_base_images"] = None
+            __props__.__dict__["platform_digests"] = None
             __props__.__dict__["ref"] = None
         secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["secrets"])
         opts = pulumi.ResourceOptions.merge(opts, secret_opts)
@@ -1494,31 +2038,56 @@ class Image(pulumi.CustomResource):
         __props__ = ImageArgs.__new__(ImageArgs)
 
         __props__.__dict__["add_hosts"] = None
+        __props__.__dict__["allow"] = None
+        __props__.__dict__["allowed_base_images"] = None
+        __props__.__dict__["annotations"] = None
+        __props__.__dict__["attestations"] = None
+        __props__.__dict__["base_image_digests"] = None
+        __props__.__dict__["base_image_lock"] = None
         __props__.__dict__["build_args"] = None
+        __props__.__dict__["build_log_path"] = None
+        __props__.__dict__["build_metadata"] = None
         __props__.__dict__["build_on_preview"] = None
+        __props__.__dict__["build_record_dir"] = None
+        __props__.__dict__["build_record_path"] = None
+        __props__.__dict__["build_stats"] = None
         __props__.__dict__["builder"] = None
         __props__.__dict__["cache_from"] = None
         __props__.__dict__["cache_to"] = None
+        __props__.__dict__["cgroup_parent"] = None
+        __props__.__dict__["compressed_size"] = None
+        __props__.__dict__["config"] = None
         __props__.__dict__["context"] = None
         __props__.__dict__["context_hash"] = None
+        __props__.__dict__["context_manifest"] = None
         __props__.__dict__["digest"] = None
         __props__.__dict__["dockerfile"] = None
         __props__.__dict__["exec_"] = None
         __props__.__dict__["exports"] = None
         __props__.__dict__["ignore_secrets_in_diff_calculation"] = None
+        __props__.__dict__["ignore_warnings"] = None
         __props__.__dict__["labels"] = None
+        __props__.__dict__["layers"] = None
+        __props__.__dict__["lint"] = None
         __props__.__dict__["load"] = None
+        __props__.__dict__["locked_base_images"] = None
         __props__.__dict__["network"] = None
         __props__.__dict__["no_cache"] = None
+        __props__.__dict__["platform_digests"] = None
         __props__.__dict__["platforms"] = None
+        __props__.__dict__["progress"] = None
         __props__.__dict__["pull"] = None
         __props__.__dict__["push"] = None
         __props__.__dict__["ref"] = None
         __props__.__dict__["registries"] = None
+        __props__.__dict__["require_pinned_base_images"] = None
         __props__.__dict__["secrets"] = None
+        __props__.__dict__["shm_size"] = None
         __props__.__dict__["ssh"] = None
         __props__.__dict__["tags"] = None
         __props__.__dict__["target"] = None
+        __props__.__dict__["ulimits"] = None
+        __props__.__dict__["warnings_as_errors"] = None
         return Image(resource_name, opts=opts, __props__=__props__)
 
     @_builtins.property
@@ -1531,6 +2100,96 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "add_hosts")
 
+    @_builtins.property
+    @pulumi.getter
+    def allow(self) -> pulumi.Output[Optional[Sequence['Entitlement']]]:
+        """
+        Extra privileges to grant to the build.
+
+        The builder must also be configured to permit these entitlements,
+        for example with `--allow-insecure-entitlement`.
+
+        Equivalent to Docker's `--allow` flag.
+        """
+        return pulumi.get(self, "allow")
+
+    @_builtins.property
+    @pulumi.getter(name="allowedBaseImages")
+    def allowed_base_images(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        Glob patterns, like `docker.io/library/*` or
+        `ghcr.io/my-org/base:*`, that every `FROM` image must match. Patterns
+        are matched against fully-qualified references such as
+        `docker.io/library/alpine:latest` and their repository names, and `*`
+        doesn't match `/`.
+
+        ARGs are substituted from `buildArgs`. References to other stages and
+        to `named` contexts are not restricted.
+
+        Images must also match the provider's `allowedBaseImages`, if set.
+        """
+        return pulumi.get(self, "allowed_base_images")
+
+    @_builtins.property
+    @pulumi.getter
+    def annotations(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
+        """
+        Attach arbitrary key/value annotations to the image's manifests.
+
+        Keys may be prefixed with the level to annotate -- `manifest`,
+        `index`, `manifest-descriptor` or `index-descriptor` -- optionally
+        qualified with a platform, for example
+        `manifest[linux/amd64]:org.opencontainers.image.title`. Multiple
+        levels can be separated by commas. Defaults to `manifest`.
+
+        Annotations are applied to every `image`, `registry`, `oci` and
+        `docker` export. Annotations set directly on an export take
+        precedence.
+
+        Equivalent to Docker's `--annotation` flag.
+        """
+        return pulumi.get(self, "annotations")
+
+    @_builtins.property
+    @pulumi.getter
+    def attestations(self) -> pulumi.Output[Optional['outputs.Attestations']]:
+        """
+        Attestations to attach to the image, such as an SBOM or SLSA
+        provenance.
+
+        Attestations are only exported with `image`, `registry` or `oci`
+        exports, and require a builder which supports them (the legacy
+        `docker` driver does not).
+
+        Equivalent to Docker's `--attest` flag.
+        """
+        return pulumi.get(self, "attestations")
+
+    @_builtins.property
+    @pulumi.getter(name="baseImageDigests")
+    def base_image_digests(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
+        """
+        The digest each external `FROM` image resolved to when the image was
+        built, keyed by its fully-qualified reference.
+
+        Only recorded for images with `pull`, which are rebuilt when any of
+        these digests has moved.
+        """
+        return pulumi.get(self, "base_image_digests")
+
+    @_builtins.property
+    @pulumi.getter(name="baseImageLock")
+    def base_image_lock(self) -> pulumi.Output[Optional['outputs.BaseImageLock']]:
+        """
+        Pin every external `FROM` and `COPY --from` image to the digest it
+        resolved to on the first build.
+
+        Later builds substitute `docker-image://<ref>@<digest>` named
+        contexts for those images, so they don't change until the lock's
+        `refresh` value does.
+        """
+        return pulumi.get(self, "base_image_lock")
+
     @_builtins.property
     @pulumi.getter(name="buildArgs")
     def build_args(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
@@ -1547,6 +2206,27 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "build_args")
 
+    @_builtins.property
+    @pulumi.getter(name="buildLogPath")
+    def build_log_path(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        A file to write the build's full log to, including anything written to
+        stderr. The log is written whether or not the build succeeds, which is
+        useful for archiving logs from CI.
+
+        Defaults to a file in the provider's `buildLogDir`, if set.
+        """
+        return pulumi.get(self, "build_log_path")
+
+    @_builtins.property
+    @pulumi.getter(name="buildMetadata")
+    def build_metadata(self) -> pulumi.Output[Optional['outputs.BuildMetadata']]:
+        """
+        Metadata returned by buildkit after the build, useful for tracing an
+        image back to its buildx history.
+        """
+        return pulumi.get(self, "build_metadata")
+
     @_builtins.property
     @pulumi.getter(name="buildOnPreview")
     def build_on_preview(self) -> pulumi.Output[Optional[_builtins.bool]]:
@@ -1565,6 +2245,42 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "build_on_preview")
 
+    @_builtins.property
+    @pulumi.getter(name="buildRecordDir")
+    def build_record_dir(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        A directory to export the build's history record to after it succeeds
+        or fails, as a `.dockerbuild` bundle. The bundle's path is reported by
+        `buildRecordPath`.
+
+        Equivalent to running `docker buildx history export` after the build.
+        Records of failed builds are not available when `exec` is `true`.
+        """
+        return pulumi.get(self, "build_record_dir")
+
+    @_builtins.property
+    @pulumi.getter(name="buildRecordPath")
+    def build_record_path(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The path of the most recent build's exported history record, if
+        `buildRecordDir` is set.
+
+        The record can be opened with Docker Desktop's Builds view or imported
+        with `docker buildx history import`.
+        """
+        return pulumi.get(self, "build_record_path")
+
+    @_builtins.property
+    @pulumi.getter(name="buildStats")
+    def build_stats(self) -> pulumi.Output[Optional['outputs.BuildStats']]:
+        """
+        Statistics about the most recent build, like its duration and how many
+        steps were cached.
+
+        Not available when `exec` is `true`.
+        """
+        return pulumi.get(self, "build_stats")
+
     @_builtins.property
     @pulumi.getter
     def builder(self) -> pulumi.Output[Optional['outputs.BuilderConfig']]:
@@ -1593,6 +2309,39 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "cache_to")
 
+    @_builtins.property
+    @pulumi.getter(name="cgroupParent")
+    def cgroup_parent(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        Set the parent cgroup for `RUN` instructions.
+
+        Equivalent to Docker's `--cgroup-parent` flag.
+        """
+        return pulumi.get(self, "cgroup_parent")
+
+    @_builtins.property
+    @pulumi.getter(name="compressedSize")
+    def compressed_size(self) -> pulumi.Output[Optional[Mapping[str, _builtins.int]]]:
+        """
+        The total compressed size in bytes of each platform's pushed layers,
+        keyed by platform in the same way as `platformDigests`.
+
+        Empty if the image was not pushed to a registry.
+        """
+        return pulumi.get(self, "compressed_size")
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> pulumi.Output[Optional[Mapping[str, 'outputs.ImageConfig']]]:
+        """
+        Runtime configuration recorded in each platform's pushed image, such
+        as its entrypoint, environment and exposed ports. Keyed by platform
+        in the same way as `platformDigests`.
+
+        Empty if the image was not pushed to a registry.
+        """
+        return pulumi.get(self, "config")
+
     @_builtins.property
     @pulumi.getter
     def context(self) -> pulumi.Output[Optional['outputs.BuildContext']]:
@@ -1613,6 +2362,17 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "context_hash")
 
+    @_builtins.property
+    @pulumi.getter(name="contextManifest")
+    def context_manifest(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        A compressed record of each file contributing to `contextHash`, used
+        to explain which files changed when the image needs to be re-built.
+
+        Empty for contexts with more than 5000 files.
+        """
+        return pulumi.get(self, "context_manifest")
+
     @_builtins.property
     @pulumi.getter
     def digest(self) -> pulumi.Output[_builtins.str]:
@@ -1693,6 +2453,16 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "ignore_secrets_in_diff_calculation")
 
+    @_builtins.property
+    @pulumi.getter(name="ignoreWarnings")
+    def ignore_warnings(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        Names of rules, like `StageNameCasing` or `JSONArgsRecommended`, whose
+        warnings shouldn't fail the build when `warningsAsErrors` is enabled.
+        These warnings are still logged.
+        """
+        return pulumi.get(self, "ignore_warnings")
+
     @_builtins.property
     @pulumi.getter
     def labels(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
@@ -1703,6 +2473,30 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "labels")
 
+    @_builtins.property
+    @pulumi.getter
+    def layers(self) -> pulumi.Output[Optional[Mapping[str, Sequence['outputs.Layer']]]]:
+        """
+        The layers of each platform's pushed image, keyed by platform in the
+        same way as `platformDigests`.
+
+        Empty if the image was not pushed to a registry.
+        """
+        return pulumi.get(self, "layers")
+
+    @_builtins.property
+    @pulumi.getter
+    def lint(self) -> pulumi.Output[Optional['outputs.Lint']]:
+        """
+        Run BuildKit's Dockerfile lint rules, like `StageNameCasing` or
+        `SecretsUsedInArgOrEnv`, against local and inline Dockerfiles before
+        building. Violations are reported as warnings unless configured to be
+        errors.
+
+        Lint settings in the Dockerfile, like `# check=skip=...`, are honored.
+        """
+        return pulumi.get(self, "lint")
+
     @_builtins.property
     @pulumi.getter
     def load(self) -> pulumi.Output[Optional[_builtins.bool]]:
@@ -1715,6 +2509,15 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "load")
 
+    @_builtins.property
+    @pulumi.getter(name="lockedBaseImages")
+    def locked_base_images(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
+        """
+        The digests each base image is pinned to by `baseImageLock`, keyed by
+        its fully-qualified reference.
+        """
+        return pulumi.get(self, "locked_base_images")
+
     @_builtins.property
     @pulumi.getter
     def network(self) -> pulumi.Output[Optional['NetworkMode']]:
@@ -1723,6 +2526,9 @@ class Image(pulumi.CustomResource):
 
         For custom networks, configure your builder with `--driver-opt network=...`.
 
+        The `host` network mode requires the `network.host` entitlement to be
+        included in `allow`.
+
         Equivalent to Docker's `--network` flag.
         """
         return pulumi.get(self, "network")
@@ -1737,6 +2543,22 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "no_cache")
 
+    @_builtins.property
+    @pulumi.getter(name="platformDigests")
+    def platform_digests(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
+        """
+        The digest of each platform-specific manifest pushed to a registry,
+        keyed by platform (for example `linux/arm64`).
+
+        This is useful when a downstream consumer requires a single-platform
+        image. Attestation manifests are not included. Single-platform images
+        are keyed by the requested platform, or by the platform recorded in
+        their config if `platforms` wasn't specified.
+
+        Empty if the image was not pushed to a registry.
+        """
+        return pulumi.get(self, "platform_digests")
+
     @_builtins.property
     @pulumi.getter
     def platforms(self) -> pulumi.Output[Optional[Sequence['Platform']]]:
@@ -1747,12 +2569,26 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "platforms")
 
+    @_builtins.property
+    @pulumi.getter
+    def progress(self) -> pulumi.Output[Optional['ProgressMode']]:
+        """
+        How to report build progress. Defaults to the provider's `progress`
+        setting.
+
+        Equivalent to Docker's `--progress` flag.
+        """
+        return pulumi.get(self, "progress")
+
     @_builtins.property
     @pulumi.getter
     def pull(self) -> pulumi.Output[Optional[_builtins.bool]]:
         """
         Always pull referenced images.
 
+        Images with `pull` are only rebuilt when one of their
+        `baseImageDigests` has moved, or always if those aren't known.
+
         Equivalent to Docker's `--pull` flag.
         """
         return pulumi.get(self, "pull")
@@ -1804,6 +2640,19 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "registries")
 
+    @_builtins.property
+    @pulumi.getter(name="requirePinnedBaseImages")
+    def require_pinned_base_images(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        Require every external `FROM` and `COPY --from` image to be pinned
+        with an `@sha256:` digest, after substituting ARGs from `buildArgs`.
+        Failures suggest the image's current digest when it can be looked up
+        with the configured registry credentials.
+
+        Defaults to the provider's `requirePinnedBaseImages` setting.
+        """
+        return pulumi.get(self, "require_pinned_base_images")
+
     @_builtins.property
     @pulumi.getter
     def secrets(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
@@ -1820,6 +2669,16 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "secrets")
 
+    @_builtins.property
+    @pulumi.getter(name="shmSize")
+    def shm_size(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        Size of `/dev/shm` for `RUN` instructions, for example `2g`.
+
+        Equivalent to Docker's `--shm-size` flag.
+        """
+        return pulumi.get(self, "shm_size")
+
     @_builtins.property
     @pulumi.getter
     def ssh(self) -> pulumi.Output[Optional[Sequence['outputs.SSH']]]:
@@ -1855,3 +2714,25 @@ class Image(pulumi.CustomResource):
         """
         return pulumi.get(self, "target")
 
+    @_builtins.property
+    @pulumi.getter
+    def ulimits(self) -> pulumi.Output[Optional[Sequence['outputs.Ulimit']]]:
+        """
+        Resource limits for `RUN` instructions.
+
+        Equivalent to Docker's `--ulimit` flag.
+        """
+        return pulumi.get(self, "ulimits")
+
+    @_builtins.property
+    @pulumi.getter(name="warningsAsErrors")
+    def warnings_as_errors(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        Fail the build if BuildKit reports any warnings, like Dockerfile lint
+        rule violations or deprecated syntax. Warnings from rules listed in
+        `ignoreWarnings` are exempt.
+
+        Defaults to the provider's `warningsAsErrors` setting.
+        """
+        return pulumi.get(self, "warnings_as_errors")
+
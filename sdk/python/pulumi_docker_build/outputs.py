@@ -17,7 +17,12 @@ from . import outputs
 from ._enums import *
 
 __all__ = [
+    'Attestations',
+    'BaseImageLock',
     'BuildContext',
+    'BuildMetadata',
+    'BuildStats',
+    'BuildStep',
     'BuilderConfig',
     'CacheFrom',
     'CacheFromAzureBlob',
@@ -42,14 +47,122 @@ __all__ = [
     'ExportOCI',
     'ExportRegistry',
     'ExportTar',
+    'ImageConfig',
+    'Layer',
+    'Lint',
+    'ProvenanceAttestation',
     'Registry',
+    'SBOMAttestation',
     'SSH',
+    'Ulimit',
 ]
 
+@pulumi.output_type
+class Attestations(dict):
+    def __init__(__self__, *,
+                 provenance: Optional['outputs.ProvenanceAttestation'] = None,
+                 sbom: Optional['outputs.SBOMAttestation'] = None):
+        """
+        :param 'ProvenanceAttestation' provenance: Generate SLSA provenance for the build.
+               
+               Provenance is disabled unless configured here.
+               
+               Equivalent to Docker's `--attest type=provenance` flag.
+        :param 'SBOMAttestation' sbom: Generate a Software Bill of Materials (SBOM) for the image.
+               
+               Equivalent to Docker's `--attest type=sbom` flag.
+        """
+        if provenance is not None:
+            pulumi.set(__self__, "provenance", provenance)
+        if sbom is not None:
+            pulumi.set(__self__, "sbom", sbom)
+
+    @_builtins.property
+    @pulumi.getter
+    def provenance(self) -> Optional['outputs.ProvenanceAttestation']:
+        """
+        Generate SLSA provenance for the build.
+
+        Provenance is disabled unless configured here.
+
+        Equivalent to Docker's `--attest type=provenance` flag.
+        """
+        return pulumi.get(self, "provenance")
+
+    @_builtins.property
+    @pulumi.getter
+    def sbom(self) -> Optional['outputs.SBOMAttestation']:
+        """
+        Generate a Software Bill of Materials (SBOM) for the image.
+
+        Equivalent to Docker's `--attest type=sbom` flag.
+        """
+        return pulumi.get(self, "sbom")
+
+
+@pulumi.output_type
+class BaseImageLock(dict):
+    def __init__(__self__, *,
+                 path: Optional[_builtins.str] = None,
+                 refresh: Optional[_builtins.str] = None):
+        """
+        :param _builtins.str path: A lockfile to record digests in, like `Dockerfile.lock`. Relative
+               paths are resolved from the Dockerfile's directory, or the context's
+               for inline Dockerfiles.
+               
+               Digests are recorded in the resource's state if this isn't set.
+        :param _builtins.str refresh: An arbitrary value, like a date, which re-resolves every base image
+               when it changes.
+        """
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+        if refresh is not None:
+            pulumi.set(__self__, "refresh", refresh)
+
+    @_builtins.property
+    @pulumi.getter
+    def path(self) -> Optional[_builtins.str]:
+        """
+        A lockfile to record digests in, like `Dockerfile.lock`. Relative
+        paths are resolved from the Dockerfile's directory, or the context's
+        for inline Dockerfiles.
+
+        Digests are recorded in the resource's state if this isn't set.
+        """
+        return pulumi.get(self, "path")
+
+    @_builtins.property
+    @pulumi.getter
+    def refresh(self) -> Optional[_builtins.str]:
+        """
+        An arbitrary value, like a date, which re-resolves every base image
+        when it changes.
+        """
+        return pulumi.get(self, "refresh")
+
+
 @pulumi.output_type
 class BuildContext(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "hashMode":
+            suggest = "hash_mode"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in BuildContext. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        BuildContext.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        BuildContext.__key_warning(key)
+        return super().get(key, default)
+
     def __init__(__self__, *,
                  location: _builtins.str,
+                 hash_mode: Optional['ContextHashMode'] = None,
                  named: Optional[Mapping[str, 'outputs.Context']] = None):
         """
         :param _builtins.str location: Resources to use for build context.
@@ -60,6 +173,16 @@ class BuildContext(dict):
                * A remote URL of a Git repository, tarball, or plain text file
                  (`https://github.com/user/myrepo.git`, `http://server/context.tar.gz`,
                  etc.).
+        :param 'ContextHashMode' hash_mode: Which files in local contexts contribute to the `contextHash` used to
+               decide whether the image needs to be rebuilt.
+               
+               With `referenced`, only the paths copied by `COPY` and `ADD`
+               instructions are hashed, so changes to other files don't trigger a
+               rebuild. Every file is hashed if those paths can't be determined
+               statically -- for example when the Dockerfile is remote or a `RUN`
+               instruction bind-mounts a context.
+               
+               Defaults to `all`.
         :param Mapping[str, 'Context'] named: Additional build contexts to use.
                
                These contexts are accessed with `FROM name` or `--from=name`
@@ -68,6 +191,8 @@ class BuildContext(dict):
                Values can be local paths, HTTP URLs, or  `docker-image://` images.
         """
         pulumi.set(__self__, "location", location)
+        if hash_mode is not None:
+            pulumi.set(__self__, "hash_mode", hash_mode)
         if named is not None:
             pulumi.set(__self__, "named", named)
 
@@ -86,6 +211,23 @@ class BuildContext(dict):
         """
         return pulumi.get(self, "location")
 
+    @_builtins.property
+    @pulumi.getter(name="hashMode")
+    def hash_mode(self) -> Optional['ContextHashMode']:
+        """
+        Which files in local contexts contribute to the `contextHash` used to
+        decide whether the image needs to be rebuilt.
+
+        With `referenced`, only the paths copied by `COPY` and `ADD`
+        instructions are hashed, so changes to other files don't trigger a
+        rebuild. Every file is hashed if those paths can't be determined
+        statically -- for example when the Dockerfile is remote or a `RUN`
+        instruction bind-mounts a context.
+
+        Defaults to `all`.
+        """
+        return pulumi.get(self, "hash_mode")
+
     @_builtins.property
     @pulumi.getter
     def named(self) -> Optional[Mapping[str, 'outputs.Context']]:
@@ -100,6 +242,219 @@ class BuildContext(dict):
         return pulumi.get(self, "named")
 
 
+@pulumi.output_type
+class BuildMetadata(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "buildRef":
+            suggest = "build_ref"
+        elif key == "configDigest":
+            suggest = "config_digest"
+        elif key == "descriptorMediaType":
+            suggest = "descriptor_media_type"
+        elif key == "descriptorSize":
+            suggest = "descriptor_size"
+        elif key == "imageName":
+            suggest = "image_name"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in BuildMetadata. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        BuildMetadata.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        BuildMetadata.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 build_ref: Optional[_builtins.str] = None,
+                 config_digest: Optional[_builtins.str] = None,
+                 descriptor_media_type: Optional[_builtins.str] = None,
+                 descriptor_size: Optional[_builtins.int] = None,
+                 image_name: Optional[_builtins.str] = None):
+        """
+        :param _builtins.str build_ref: The buildx history reference for the build, formatted as
+               `<builder>/<node>/<ref>`.
+               
+               This can be passed to `docker buildx history inspect` to debug the
+               build.
+        :param _builtins.str config_digest: The digest of the image's config blob.
+        :param _builtins.str descriptor_media_type: The media type of the exported image's top-level manifest or index.
+        :param _builtins.int descriptor_size: The size in bytes of the exported image's top-level manifest or index.
+        :param _builtins.str image_name: The comma-separated names the image was exported with.
+        """
+        if build_ref is not None:
+            pulumi.set(__self__, "build_ref", build_ref)
+        if config_digest is not None:
+            pulumi.set(__self__, "config_digest", config_digest)
+        if descriptor_media_type is not None:
+            pulumi.set(__self__, "descriptor_media_type", descriptor_media_type)
+        if descriptor_size is not None:
+            pulumi.set(__self__, "descriptor_size", descriptor_size)
+        if image_name is not None:
+            pulumi.set(__self__, "image_name", image_name)
+
+    @_builtins.property
+    @pulumi.getter(name="buildRef")
+    def build_ref(self) -> Optional[_builtins.str]:
+        """
+        The buildx history reference for the build, formatted as
+        `<builder>/<node>/<ref>`.
+
+        This can be passed to `docker buildx history inspect` to debug the
+        build.
+        """
+        return pulumi.get(self, "build_ref")
+
+    @_builtins.property
+    @pulumi.getter(name="configDigest")
+    def config_digest(self) -> Optional[_builtins.str]:
+        """
+        The digest of the image's config blob.
+        """
+        return pulumi.get(self, "config_digest")
+
+    @_builtins.property
+    @pulumi.getter(name="descriptorMediaType")
+    def descriptor_media_type(self) -> Optional[_builtins.str]:
+        """
+        The media type of the exported image's top-level manifest or index.
+        """
+        return pulumi.get(self, "descriptor_media_type")
+
+    @_builtins.property
+    @pulumi.getter(name="descriptorSize")
+    def descriptor_size(self) -> Optional[_builtins.int]:
+        """
+        The size in bytes of the exported image's top-level manifest or index.
+        """
+        return pulumi.get(self, "descriptor_size")
+
+    @_builtins.property
+    @pulumi.getter(name="imageName")
+    def image_name(self) -> Optional[_builtins.str]:
+        """
+        The comma-separated names the image was exported with.
+        """
+        return pulumi.get(self, "image_name")
+
+
+@pulumi.output_type
+class BuildStats(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "cacheHitRatio":
+            suggest = "cache_hit_ratio"
+        elif key == "cachedSteps":
+            suggest = "cached_steps"
+        elif key == "slowestSteps":
+            suggest = "slowest_steps"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in BuildStats. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        BuildStats.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        BuildStats.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 cache_hit_ratio: _builtins.float,
+                 cached_steps: _builtins.int,
+                 duration: _builtins.float,
+                 steps: _builtins.int,
+                 slowest_steps: Optional[Sequence['outputs.BuildStep']] = None):
+        """
+        :param _builtins.float cache_hit_ratio: The fraction of steps which were cached, between 0 and 1.
+        :param _builtins.int cached_steps: The number of steps which were cached.
+        :param _builtins.float duration: Wall-clock duration of the build in seconds.
+        :param _builtins.int steps: The number of steps (vertices) solved by the build.
+        :param Sequence['BuildStep'] slowest_steps: The slowest uncached steps, in descending order of duration.
+        """
+        pulumi.set(__self__, "cache_hit_ratio", cache_hit_ratio)
+        pulumi.set(__self__, "cached_steps", cached_steps)
+        pulumi.set(__self__, "duration", duration)
+        pulumi.set(__self__, "steps", steps)
+        if slowest_steps is not None:
+            pulumi.set(__self__, "slowest_steps", slowest_steps)
+
+    @_builtins.property
+    @pulumi.getter(name="cacheHitRatio")
+    def cache_hit_ratio(self) -> _builtins.float:
+        """
+        The fraction of steps which were cached, between 0 and 1.
+        """
+        return pulumi.get(self, "cache_hit_ratio")
+
+    @_builtins.property
+    @pulumi.getter(name="cachedSteps")
+    def cached_steps(self) -> _builtins.int:
+        """
+        The number of steps which were cached.
+        """
+        return pulumi.get(self, "cached_steps")
+
+    @_builtins.property
+    @pulumi.getter
+    def duration(self) -> _builtins.float:
+        """
+        Wall-clock duration of the build in seconds.
+        """
+        return pulumi.get(self, "duration")
+
+    @_builtins.property
+    @pulumi.getter
+    def steps(self) -> _builtins.int:
+        """
+        The number of steps (vertices) solved by the build.
+        """
+        return pulumi.get(self, "steps")
+
+    @_builtins.property
+    @pulumi.getter(name="slowestSteps")
+    def slowest_steps(self) -> Optional[Sequence['outputs.BuildStep']]:
+        """
+        The slowest uncached steps, in descending order of duration.
+        """
+        return pulumi.get(self, "slowest_steps")
+
+
+@pulumi.output_type
+class BuildStep(dict):
+    def __init__(__self__, *,
+                 duration: _builtins.float,
+                 name: _builtins.str):
+        """
+        :param _builtins.float duration: The step's duration in seconds.
+        :param _builtins.str name: The step's name, for example "[2/3] RUN make".
+        """
+        pulumi.set(__self__, "duration", duration)
+        pulumi.set(__self__, "name", name)
+
+    @_builtins.property
+    @pulumi.getter
+    def duration(self) -> _builtins.float:
+        """
+        The step's duration in seconds.
+        """
+        return pulumi.get(self, "duration")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        """
+        The step's name, for example "[2/3] RUN make".
+        """
+        return pulumi.get(self, "name")
+
+
 @pulumi.output_type
 class BuilderConfig(dict):
     def __init__(__self__, *,
@@ -2240,6 +2595,292 @@ class ExportTar(dict):
         return pulumi.get(self, "dest")
 
 
+@pulumi.output_type
+class ImageConfig(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "exposedPorts":
+            suggest = "exposed_ports"
+        elif key == "workingDir":
+            suggest = "working_dir"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in ImageConfig. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        ImageConfig.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        ImageConfig.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 cmd: Optional[Sequence[_builtins.str]] = None,
+                 entrypoint: Optional[Sequence[_builtins.str]] = None,
+                 env: Optional[Sequence[_builtins.str]] = None,
+                 exposed_ports: Optional[Sequence[_builtins.str]] = None,
+                 labels: Optional[Mapping[str, _builtins.str]] = None,
+                 user: Optional[_builtins.str] = None,
+                 working_dir: Optional[_builtins.str] = None):
+        """
+        :param Sequence[_builtins.str] cmd: The image's "CMD".
+        :param Sequence[_builtins.str] entrypoint: The image's "ENTRYPOINT".
+        :param Sequence[_builtins.str] env: Environment variables set by "ENV", as "KEY=value" pairs.
+        :param Sequence[_builtins.str] exposed_ports: Ports declared by `EXPOSE`, for example `8080/tcp`.
+        :param Mapping[str, _builtins.str] labels: The image's final labels, including any inherited from base images.
+        :param _builtins.str user: The image's "USER".
+        :param _builtins.str working_dir: The image's "WORKDIR".
+        """
+        if cmd is not None:
+            pulumi.set(__self__, "cmd", cmd)
+        if entrypoint is not None:
+            pulumi.set(__self__, "entrypoint", entrypoint)
+        if env is not None:
+            pulumi.set(__self__, "env", env)
+        if exposed_ports is not None:
+            pulumi.set(__self__, "exposed_ports", exposed_ports)
+        if labels is not None:
+            pulumi.set(__self__, "labels", labels)
+        if user is not None:
+            pulumi.set(__self__, "user", user)
+        if working_dir is not None:
+            pulumi.set(__self__, "working_dir", working_dir)
+
+    @_builtins.property
+    @pulumi.getter
+    def cmd(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        The image's "CMD".
+        """
+        return pulumi.get(self, "cmd")
+
+    @_builtins.property
+    @pulumi.getter
+    def entrypoint(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        The image's "ENTRYPOINT".
+        """
+        return pulumi.get(self, "entrypoint")
+
+    @_builtins.property
+    @pulumi.getter
+    def env(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        Environment variables set by "ENV", as "KEY=value" pairs.
+        """
+        return pulumi.get(self, "env")
+
+    @_builtins.property
+    @pulumi.getter(name="exposedPorts")
+    def exposed_ports(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        Ports declared by `EXPOSE`, for example `8080/tcp`.
+        """
+        return pulumi.get(self, "exposed_ports")
+
+    @_builtins.property
+    @pulumi.getter
+    def labels(self) -> Optional[Mapping[str, _builtins.str]]:
+        """
+        The image's final labels, including any inherited from base images.
+        """
+        return pulumi.get(self, "labels")
+
+    @_builtins.property
+    @pulumi.getter
+    def user(self) -> Optional[_builtins.str]:
+        """
+        The image's "USER".
+        """
+        return pulumi.get(self, "user")
+
+    @_builtins.property
+    @pulumi.getter(name="workingDir")
+    def working_dir(self) -> Optional[_builtins.str]:
+        """
+        The image's "WORKDIR".
+        """
+        return pulumi.get(self, "working_dir")
+
+
+@pulumi.output_type
+class Layer(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "mediaType":
+            suggest = "media_type"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in Layer. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        Layer.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        Layer.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 digest: _builtins.str,
+                 media_type: _builtins.str,
+                 size: _builtins.int):
+        """
+        :param _builtins.str digest: The layer's content digest.
+        :param _builtins.str media_type: The layer's media type, for example "application/vnd.oci.image.layer.v1.tar+gzip".
+        :param _builtins.int size: The layer's compressed size in bytes.
+        """
+        pulumi.set(__self__, "digest", digest)
+        pulumi.set(__self__, "media_type", media_type)
+        pulumi.set(__self__, "size", size)
+
+    @_builtins.property
+    @pulumi.getter
+    def digest(self) -> _builtins.str:
+        """
+        The layer's content digest.
+        """
+        return pulumi.get(self, "digest")
+
+    @_builtins.property
+    @pulumi.getter(name="mediaType")
+    def media_type(self) -> _builtins.str:
+        """
+        The layer's media type, for example "application/vnd.oci.image.layer.v1.tar+gzip".
+        """
+        return pulumi.get(self, "media_type")
+
+    @_builtins.property
+    @pulumi.getter
+    def size(self) -> _builtins.int:
+        """
+        The layer's compressed size in bytes.
+        """
+        return pulumi.get(self, "size")
+
+
+@pulumi.output_type
+class Lint(dict):
+    def __init__(__self__, *,
+                 error: Optional[_builtins.bool] = None,
+                 skip: Optional[Sequence[_builtins.str]] = None):
+        """
+        :param _builtins.bool error: Report lint violations as errors instead of warnings.
+               
+               Defaults to the Dockerfile's `# check=error=...` directive.
+        :param Sequence[_builtins.str] skip: Names of lint rules to skip, like `StageNameCasing`, or `all` to
+               disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+               directive are also skipped.
+        """
+        if error is not None:
+            pulumi.set(__self__, "error", error)
+        if skip is not None:
+            pulumi.set(__self__, "skip", skip)
+
+    @_builtins.property
+    @pulumi.getter
+    def error(self) -> Optional[_builtins.bool]:
+        """
+        Report lint violations as errors instead of warnings.
+
+        Defaults to the Dockerfile's `# check=error=...` directive.
+        """
+        return pulumi.get(self, "error")
+
+    @_builtins.property
+    @pulumi.getter
+    def skip(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        Names of lint rules to skip, like `StageNameCasing`, or `all` to
+        disable linting. Rules skipped by the Dockerfile's `# check=skip=...`
+        directive are also skipped.
+        """
+        return pulumi.get(self, "skip")
+
+
+@pulumi.output_type
+class ProvenanceAttestation(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "builderId":
+            suggest = "builder_id"
+        elif key == "inlineOnly":
+            suggest = "inline_only"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in ProvenanceAttestation. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        ProvenanceAttestation.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        ProvenanceAttestation.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 builder_id: Optional[_builtins.str] = None,
+                 disabled: Optional[_builtins.bool] = None,
+                 inline_only: Optional[_builtins.bool] = None,
+                 mode: Optional['ProvenanceMode'] = None):
+        """
+        :param _builtins.str builder_id: An explicit builder ID to record in the provenance, for example the
+               URL of the CI job performing the build.
+        :param _builtins.bool disabled: When `true` no provenance will be generated. Defaults to `false`.
+        :param _builtins.bool inline_only: Only attach provenance to exporters which embed it in the image
+               (e.g. `image` and `registry`). Defaults to `false`.
+        :param 'ProvenanceMode' mode: The level of detail to include in the provenance. Defaults to `min`.
+        """
+        if builder_id is not None:
+            pulumi.set(__self__, "builder_id", builder_id)
+        if disabled is not None:
+            pulumi.set(__self__, "disabled", disabled)
+        if inline_only is not None:
+            pulumi.set(__self__, "inline_only", inline_only)
+        if mode is None:
+            mode = 'min'
+        if mode is not None:
+            pulumi.set(__self__, "mode", mode)
+
+    @_builtins.property
+    @pulumi.getter(name="builderId")
+    def builder_id(self) -> Optional[_builtins.str]:
+        """
+        An explicit builder ID to record in the provenance, for example the
+        URL of the CI job performing the build.
+        """
+        return pulumi.get(self, "builder_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def disabled(self) -> Optional[_builtins.bool]:
+        """
+        When `true` no provenance will be generated. Defaults to `false`.
+        """
+        return pulumi.get(self, "disabled")
+
+    @_builtins.property
+    @pulumi.getter(name="inlineOnly")
+    def inline_only(self) -> Optional[_builtins.bool]:
+        """
+        Only attach provenance to exporters which embed it in the image
+        (e.g. `image` and `registry`). Defaults to `false`.
+        """
+        return pulumi.get(self, "inline_only")
+
+    @_builtins.property
+    @pulumi.getter
+    def mode(self) -> Optional['ProvenanceMode']:
+        """
+        The level of detail to include in the provenance. Defaults to `min`.
+        """
+        return pulumi.get(self, "mode")
+
+
 @pulumi.output_type
 class Registry(dict):
     def __init__(__self__, *,
@@ -2282,6 +2923,92 @@ class Registry(dict):
         return pulumi.get(self, "username")
 
 
+@pulumi.output_type
+class SBOMAttestation(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "scanContext":
+            suggest = "scan_context"
+        elif key == "scanStage":
+            suggest = "scan_stage"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in SBOMAttestation. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        SBOMAttestation.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        SBOMAttestation.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 disabled: Optional[_builtins.bool] = None,
+                 generator: Optional[_builtins.str] = None,
+                 scan_context: Optional[_builtins.bool] = None,
+                 scan_stage: Optional[_builtins.bool] = None):
+        """
+        :param _builtins.bool disabled: When `true` no SBOM will be generated. Defaults to `false`.
+        :param _builtins.str generator: The scanner image used to generate the SBOM.
+               
+               Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+        :param _builtins.bool scan_context: Also scan the build context, in addition to the final image.
+               
+               Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+        :param _builtins.bool scan_stage: Also scan intermediate build stages, in addition to the final image.
+               
+               Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+        """
+        if disabled is not None:
+            pulumi.set(__self__, "disabled", disabled)
+        if generator is not None:
+            pulumi.set(__self__, "generator", generator)
+        if scan_context is not None:
+            pulumi.set(__self__, "scan_context", scan_context)
+        if scan_stage is not None:
+            pulumi.set(__self__, "scan_stage", scan_stage)
+
+    @_builtins.property
+    @pulumi.getter
+    def disabled(self) -> Optional[_builtins.bool]:
+        """
+        When `true` no SBOM will be generated. Defaults to `false`.
+        """
+        return pulumi.get(self, "disabled")
+
+    @_builtins.property
+    @pulumi.getter
+    def generator(self) -> Optional[_builtins.str]:
+        """
+        The scanner image used to generate the SBOM.
+
+        Defaults to BuildKit's `docker/buildkit-syft-scanner`.
+        """
+        return pulumi.get(self, "generator")
+
+    @_builtins.property
+    @pulumi.getter(name="scanContext")
+    def scan_context(self) -> Optional[_builtins.bool]:
+        """
+        Also scan the build context, in addition to the final image.
+
+        Equivalent to setting the `BUILDKIT_SBOM_SCAN_CONTEXT` build argument.
+        """
+        return pulumi.get(self, "scan_context")
+
+    @_builtins.property
+    @pulumi.getter(name="scanStage")
+    def scan_stage(self) -> Optional[_builtins.bool]:
+        """
+        Also scan intermediate build stages, in addition to the final image.
+
+        Equivalent to setting the `BUILDKIT_SBOM_SCAN_STAGE` build argument.
+        """
+        return pulumi.get(self, "scan_stage")
+
+
 @pulumi.output_type
 class SSH(dict):
     def __init__(__self__, *,
@@ -2332,3 +3059,44 @@ class SSH(dict):
         return pulumi.get(self, "paths")
 
 
+@pulumi.output_type
+class Ulimit(dict):
+    def __init__(__self__, *,
+                 name: _builtins.str,
+                 soft: _builtins.int,
+                 hard: Optional[_builtins.int] = None):
+        """
+        :param _builtins.str name: The name of the limit, for example `nofile` or `nproc`.
+        :param _builtins.int soft: The soft limit. Use `-1` for unlimited.
+        :param _builtins.int hard: The hard limit. Defaults to the soft limit.
+        """
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "soft", soft)
+        if hard is not None:
+            pulumi.set(__self__, "hard", hard)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        """
+        The name of the limit, for example `nofile` or `nproc`.
+        """
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def soft(self) -> _builtins.int:
+        """
+        The soft limit. Use `-1` for unlimited.
+        """
+        return pulumi.get(self, "soft")
+
+    @_builtins.property
+    @pulumi.getter
+    def hard(self) -> Optional[_builtins.int]:
+        """
+        The hard limit. Defaults to the soft limit.
+        """
+        return pulumi.get(self, "hard")
+
+
@@ -13,6 +13,7 @@ if sys.version_info >= (3, 11):
 else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from . import _utilities
+from ._enums import *
 from ._inputs import *
 
 __all__ = ['ProviderArgs', 'Provider']
@@ -20,19 +21,113 @@ __all__ = ['ProviderArgs', 'Provider']
 @pulumi.input_type
 class ProviderArgs:
     def __init__(__self__, *,
+                 allowed_base_images: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 build_log_dir: pulumi.Input[Optional[_builtins.str]] = None,
+                 context_hash_cache_dir: pulumi.Input[Optional[_builtins.str]] = None,
                  host: pulumi.Input[Optional[_builtins.str]] = None,
-                 registries: pulumi.Input[Optional[Sequence[pulumi.Input['RegistryArgs']]]] = None):
+                 otlp_endpoint: pulumi.Input[Optional[_builtins.str]] = None,
+                 progress: pulumi.Input[Optional['ProgressMode']] = None,
+                 registries: pulumi.Input[Optional[Sequence[pulumi.Input['RegistryArgs']]]] = None,
+                 require_pinned_base_images: pulumi.Input[Optional[_builtins.bool]] = None,
+                 warnings_as_errors: pulumi.Input[Optional[_builtins.bool]] = None):
         """
         The set of arguments for constructing a Provider resource.
 
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] allowed_base_images: Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+               instructions must match. This applies in addition to each image's own
+               `allowedBaseImages`.
+        :param pulumi.Input[_builtins.str] build_log_dir: A directory to write the full log of each image build to, for images
+               which don't specify their own `buildLogPath`.
+               
+               Logs are named after the image's first tag, or the resource's name if
+               it has no tags.
+        :param pulumi.Input[_builtins.str] context_hash_cache_dir: A directory to cache build context hashes in, so files which haven't
+               changed aren't read again on later runs.
+               
+               Files are considered unchanged if their size, modification time and
+               inode are the same. The resulting `contextHash` is unaffected.
         :param pulumi.Input[_builtins.str] host: The build daemon's address.
+        :param pulumi.Input[_builtins.str] otlp_endpoint: An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+               of resource operations and builds to. gRPC endpoints aren't supported.
+               
+               Spans join the trace in the `TRACEPARENT` environment variable, if set,
+               and trace context is propagated to the build daemon.
+        :param pulumi.Input['ProgressMode'] progress: How to report build progress for images which don't specify their own
+               `progress`. Defaults to `plain`.
+        :param pulumi.Input[_builtins.bool] require_pinned_base_images: Require external `FROM` and `COPY --from` images to be pinned with an
+               `@sha256:` digest, for images which don't specify their own
+               `requirePinnedBaseImages`.
+        :param pulumi.Input[_builtins.bool] warnings_as_errors: Fail image builds if BuildKit reports any warnings, for images which
+               don't specify their own `warningsAsErrors`.
         """
+        if allowed_base_images is not None:
+            pulumi.set(__self__, "allowed_base_images", allowed_base_images)
+        if build_log_dir is not None:
+            pulumi.set(__self__, "build_log_dir", build_log_dir)
+        if context_hash_cache_dir is not None:
+            pulumi.set(__self__, "context_hash_cache_dir", context_hash_cache_dir)
         if host is None:
             host = (_utilities.get_env('DOCKER_HOST') or '')
         if host is not None:
             pulumi.set(__self__, "host", host)
+        if otlp_endpoint is None:
+            otlp_endpoint = (_utilities.get_env('PULUMI_DOCKER_BUILD_OTLP_ENDPOINT') or '')
+        if otlp_endpoint is not None:
+            pulumi.set(__self__, "otlp_endpoint", otlp_endpoint)
+        if progress is not None:
+            pulumi.set(__self__, "progress", progress)
         if registries is not None:
             pulumi.set(__self__, "registries", registries)
+        if require_pinned_base_images is not None:
+            pulumi.set(__self__, "require_pinned_base_images", require_pinned_base_images)
+        if warnings_as_errors is not None:
+            pulumi.set(__self__, "warnings_as_errors", warnings_as_errors)
+
+    @_builtins.property
+    @pulumi.getter(name="allowedBaseImages")
+    def allowed_base_images(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+        instructions must match. This applies in addition to each image's own
+        `allowedBaseImages`.
+        """
+        return pulumi.get(self, "allowed_base_images")
+
+    @allowed_base_images.setter
+    def allowed_base_images(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "allowed_base_images", value)
+
+    @_builtins.property
+    @pulumi.getter(name="buildLogDir")
+    def build_log_dir(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        A directory to write the full log of each image build to, for images
+        which don't specify their own `buildLogPath`.
+
+        Logs are named after the image's first tag, or the resource's name if
+        it has no tags.
+        """
+        return pulumi.get(self, "build_log_dir")
+
+    @build_log_dir.setter
+    def build_log_dir(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "build_log_dir", value)
+
+    @_builtins.property
+    @pulumi.getter(name="contextHashCacheDir")
+    def context_hash_cache_dir(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        A directory to cache build context hashes in, so files which haven't
+        changed aren't read again on later runs.
+
+        Files are considered unchanged if their size, modification time and
+        inode are the same. The resulting `contextHash` is unaffected.
+        """
+        return pulumi.get(self, "context_hash_cache_dir")
+
+    @context_hash_cache_dir.setter
+    def context_hash_cache_dir(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "context_hash_cache_dir", value)
 
     @_builtins.property
     @pulumi.getter
@@ -46,6 +141,35 @@ class ProviderArgs:
     def host(self, value: pulumi.Input[Optional[_builtins.str]]):
         pulumi.set(self, "host", value)
 
+    @_builtins.property
+    @pulumi.getter(name="otlpEndpoint")
+    def otlp_endpoint(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+        of resource operations and builds to. gRPC endpoints aren't supported.
+
+        Spans join the trace in the `TRACEPARENT` environment variable, if set,
+        and trace context is propagated to the build daemon.
+        """
+        return pulumi.get(self, "otlp_endpoint")
+
+    @otlp_endpoint.setter
+    def otlp_endpoint(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "otlp_endpoint", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def progress(self) -> pulumi.Input[Optional['ProgressMode']]:
+        """
+        How to report build progress for images which don't specify their own
+        `progress`. Defaults to `plain`.
+        """
+        return pulumi.get(self, "progress")
+
+    @progress.setter
+    def progress(self, value: pulumi.Input[Optional['ProgressMode']]):
+        pulumi.set(self, "progress", value)
+
     @_builtins.property
     @pulumi.getter
     def registries(self) -> pulumi.Input[Optional[Sequence[pulumi.Input['RegistryArgs']]]]:
@@ -55,6 +179,33 @@ class ProviderArgs:
     def registries(self, value: pulumi.Input[Optional[Sequence[pulumi.Input['RegistryArgs']]]]):
         pulumi.set(self, "registries", value)
 
+    @_builtins.property
+    @pulumi.getter(name="requirePinnedBaseImages")
+    def require_pinned_base_images(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Require external `FROM` and `COPY --from` images to be pinned with an
+        `@sha256:` digest, for images which don't specify their own
+        `requirePinnedBaseImages`.
+        """
+        return pulumi.get(self, "require_pinned_base_images")
+
+    @require_pinned_base_images.setter
+    def require_pinned_base_images(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "require_pinned_base_images", value)
+
+    @_builtins.property
+    @pulumi.getter(name="warningsAsErrors")
+    def warnings_as_errors(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Fail image builds if BuildKit reports any warnings, for images which
+        don't specify their own `warningsAsErrors`.
+        """
+        return pulumi.get(self, "warnings_as_errors")
+
+    @warnings_as_errors.setter
+    def warnings_as_errors(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "warnings_as_errors", value)
+
 
 @pulumi.type_token("pulumi:providers:docker-build")
 class Provider(pulumi.ProviderResource):
@@ -62,15 +213,47 @@ class Provider(pulumi.ProviderResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allowed_base_images: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 build_log_dir: pulumi.Input[Optional[_builtins.str]] = None,
+                 context_hash_cache_dir: pulumi.Input[Optional[_builtins.str]] = None,
                  host: pulumi.Input[Optional[_builtins.str]] = None,
+                 otlp_endpoint: pulumi.Input[Optional[_builtins.str]] = None,
+                 progress: pulumi.Input[Optional['ProgressMode']] = None,
                  registries: pulumi.Input[Optional[Sequence[pulumi.Input[Union['RegistryArgs', 'RegistryArgsDict']]]]] = None,
+                 require_pinned_base_images: pulumi.Input[Optional[_builtins.bool]] = None,
+                 warnings_as_errors: pulumi.Input[Optional[_builtins.bool]] = None,
                  __props__=None):
         """
         Create a Docker-build resource with the given unique name, props, and options.
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] allowed_base_images: Glob patterns, like `docker.io/library/*`, that every image's `FROM`
+               instructions must match. This applies in addition to each image's own
+               `allowedBaseImages`.
+        :param pulumi.Input[_builtins.str] build_log_dir: A directory to write the full log of each image build to, for images
+               which don't specify their own `buildLogPath`.
+               
+               Logs are named after the image's first tag, or the resource's name if
+               it has no tags.
+        :param pulumi.Input[_builtins.str] context_hash_cache_dir: A directory to cache build context hashes in, so files which haven't
+               changed aren't read again on later runs.
+               
+               Files are considered unchanged if their size, modification time and
+               inode are the same. The resulting `contextHash` is unaffected.
         :param pulumi.Input[_builtins.str] host: The build daemon's address.
+        :param pulumi.Input[_builtins.str] otlp_endpoint: An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+               of resource operations and builds to. gRPC endpoints aren't supported.
+               
+               Spans join the trace in the `TRACEPARENT` environment variable, if set,
+               and trace context is propagated to the build daemon.
+        :param pulumi.Input['ProgressMode'] progress: How to report build progress for images which don't specify their own
+               `progress`. Defaults to `plain`.
+        :param pulumi.Input[_builtins.bool] require_pinned_base_images: Require external `FROM` and `COPY --from` images to be pinned with an
+               `@sha256:` digest, for images which don't specify their own
+               `requirePinnedBaseImages`.
+        :param pulumi.Input[_builtins.bool] warnings_as_errors: Fail image builds if BuildKit reports any warnings, for images which
+               don't specify their own `warningsAsErrors`.
         """
         ...
     @overload
@@ -96,8 +279,15 @@ class Provider(pulumi.ProviderResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allowed_base_images: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 build_log_dir: pulumi.Input[Optional[_builtins.str]] = None,
+                 context_hash_cache_dir: pulumi.Input[Optional[_builtins.str]] = None,
                  host: pulumi.Input[Optional[_builtins.str]] = None,
+                 otlp_endpoint: pulumi.Input[Optional[_builtins.str]] = None,
+                 progress: pulumi.Input[Optional['ProgressMode']] = None,
                  registries: pulumi.Input[Optional[Sequence[pulumi.Input[Union['RegistryArgs', 'RegistryArgsDict']]]]] = None,
+                 require_pinned_base_images: pulumi.Input[Optional[_builtins.bool]] = None,
+                 warnings_as_errors: pulumi.Input[Optional[_builtins.bool]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -107,16 +297,49 @@ class Provider(pulumi.ProviderResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = ProviderArgs.__new__(ProviderArgs)
 
+            __props__.__dict__["allowed_base_images"] = pulumi.Output.from_input(allowed_base_images).apply(pulumi.runtime.to_json) if allowed_base_images is not None else None
+            __props__.__dict__["build_log_dir"] = build_log_dir
+            __props__.__dict__["context_hash_cache_dir"] = context_hash_cache_dir
             if host is None:
                 host = (_utilities.get_env('DOCKER_HOST') or '')
             __props__.__dict__["host"] = host
+            if otlp_endpoint is None:
+                otlp_endpoint = (_utilities.get_env('PULUMI_DOCKER_BUILD_OTLP_ENDPOINT') or '')
+            __props__.__dict__["otlp_endpoint"] = otlp_endpoint
+            __props__.__dict__["progress"] = progress
             __props__.__dict__["registries"] = pulumi.Output.from_input(registries).apply(pulumi.runtime.to_json) if registries is not None else None
+            __props__.__dict__["require_pinned_base_images"] = pulumi.Output.from_input(require_pinned_base_images).apply(pulumi.runtime.to_json) if require_pinned_base_images is not None else None
+            __props__.__dict__["warnings_as_errors"] = pulumi.Output.from_input(warnings_as_errors).apply(pulumi.runtime.to_json) if warnings_as_errors is not None else None
         super(Provider, __self__).__init__(
             'docker-build',
             resource_name,
             __props__,
             opts)
 
+    @_builtins.property
+    @pulumi.getter(name="buildLogDir")
+    def build_log_dir(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        A directory to write the full log of each image build to, for images
+        which don't specify their own `buildLogPath`.
+
+        Logs are named after the image's first tag, or the resource's name if
+        it has no tags.
+        """
+        return pulumi.get(self, "build_log_dir")
+
+    @_builtins.property
+    @pulumi.getter(name="contextHashCacheDir")
+    def context_hash_cache_dir(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        A directory to cache build context hashes in, so files which haven't
+        changed aren't read again on later runs.
+
+        Files are considered unchanged if their size, modification time and
+        inode are the same. The resulting `contextHash` is unaffected.
+        """
+        return pulumi.get(self, "context_hash_cache_dir")
+
     @_builtins.property
     @pulumi.getter
     def host(self) -> pulumi.Output[Optional[_builtins.str]]:
@@ -125,3 +348,24 @@ class Provider(pulumi.ProviderResource):
         """
         return pulumi.get(self, "host")
 
+    @_builtins.property
+    @pulumi.getter(name="otlpEndpoint")
+    def otlp_endpoint(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        An OTLP/HTTP endpoint, like `http://localhost:4318`, to export traces
+        of resource operations and builds to. gRPC endpoints aren't supported.
+
+        Spans join the trace in the `TRACEPARENT` environment variable, if set,
+        and trace context is propagated to the build daemon.
+        """
+        return pulumi.get(self, "otlp_endpoint")
+
+    @_builtins.property
+    @pulumi.getter
+    def progress(self) -> pulumi.Output[Optional['ProgressMode']]:
+        """
+        How to report build progress for images which don't specify their own
+        `progress`. Defaults to `plain`.
+        """
+        return pulumi.get(self, "progress")
+